@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func (s Server) GetGamesIdAchievementStats(w http.ResponseWriter, r *http.Request, id int32) {
+
+	stats, err := mongo.GetAppAchievementStats(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.AchievementStatsResponse{Error: "no achievement stats"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.AchievementStatsResponse{Error: err.Error()})
+		return
+	}
+
+	schema := generated.AchievementStatsSchema{
+		AppId:                int32(stats.AppID),
+		Players:              int32(stats.Players),
+		Completionists:       int32(stats.Completionists),
+		CompletionistPercent: stats.CompletionistPercent,
+		SamplePlayers:        int32(stats.SamplePlayers),
+		MedianCompletionSecondsSinceFirstAchievement: stats.MedianCompletionSecondsSinceFirstAchievement,
+		Hardest:     []string{},
+		UnlockOrder: []string{},
+		UpdatedAt:   stats.UpdatedAt.Unix(),
+	}
+
+	for _, v := range stats.Rarity {
+		schema.Rarity = append(schema.Rarity, struct {
+			Count int32  `json:"count"`
+			Name  string `json:"name"`
+		}{Count: int32(v.Count), Name: v.Name})
+	}
+
+	for _, v := range stats.GetHardest(10) {
+		schema.Hardest = append(schema.Hardest, v.Key)
+	}
+
+	for _, v := range stats.GetUnlockOrder() {
+		schema.UnlockOrder = append(schema.UnlockOrder, v.Key)
+	}
+
+	returnResponse(w, r, http.StatusOK, generated.AchievementStatsResponse{Stats: schema})
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetGamesIdItems(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdItemsParams) {
+
+	_, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.ItemsResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ItemsResponse{Error: err.Error()})
+		return
+	}
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	var filter = bson.D{{Key: "app_id", Value: int(id)}}
+
+	items, err := mongo.GetAppItems(offset, limit, filter, nil)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ItemsResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionAppItems, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.ItemsResponse{Items: []generated.ItemSchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, item := range items {
+		result.Items = append(result.Items, getItemSchema(item))
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetGamesIdItemsItem(w http.ResponseWriter, r *http.Request, id int32, itemDefID int32) {
+
+	item, err := mongo.GetAppItem(int(id), int(itemDefID))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.ItemResponse{Error: "item not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ItemResponse{Error: err.Error()})
+		return
+	}
+
+	returnResponse(w, r, http.StatusOK, generated.ItemResponse{Item: getItemSchema(item)})
+}
+
+func getItemSchema(item mongo.AppItem) generated.ItemSchema {
+
+	schema := generated.ItemSchema{
+		AppId:       int32(item.AppID),
+		ItemDefId:   int32(item.ItemDefID),
+		Name:        item.Name,
+		Description: item.ShortDescription(),
+		Type:        item.Type,
+		Quality:     item.ItemQuality,
+		Tags:        []string{},
+		Tradable:    item.Tradable,
+		Marketable:  item.Marketable,
+		Commodity:   item.Commodity,
+		Icon:        item.IconURL,
+		MarketLink:  item.Link(),
+		Prices: generated.ItemSchema_Prices{
+			AdditionalProperties: map[string]int32{},
+		},
+		Recipes: []generated.ItemRecipeSchema{},
+	}
+
+	schema.Tags = append(schema.Tags, item.GetTagStrings()...)
+
+	for k, v := range item.GetPrices() {
+		schema.Prices.AdditionalProperties[string(k)] = int32(v)
+	}
+
+	for _, recipe := range item.GetRecipes() {
+
+		recipeSchema := generated.ItemRecipeSchema{Inputs: []generated.ItemRecipeInputSchema{}}
+		for _, input := range recipe.Inputs {
+			recipeSchema.Inputs = append(recipeSchema.Inputs, generated.ItemRecipeInputSchema{
+				ItemDefId: int32(input.ItemDefID),
+				Tag:       input.Tag,
+				Quantity:  int32(input.Quantity),
+			})
+		}
+
+		schema.Recipes = append(schema.Recipes, recipeSchema)
+	}
+
+	return schema
+}
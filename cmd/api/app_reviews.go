@@ -0,0 +1,75 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetGamesIdReviews(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdReviewsParams) {
+
+	_, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.ReviewHistoryResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ReviewHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	histories, err := mongo.GetAppReviewHistories(int(id), offset, limit, nil)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ReviewHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionAppReviewHistory, bson.D{{Key: "app_id", Value: int(id)}}, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.ReviewHistoryResponse{History: []generated.ReviewHistorySchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, v := range histories {
+
+		history := generated.ReviewHistorySchema{
+			Date:        v.Date.Unix(),
+			Positive:    int32(v.Positive),
+			Negative:    int32(v.Negative),
+			Score:       v.Score,
+			NewPositive: int32(v.NewPositive),
+			NewNegative: int32(v.NewNegative),
+			Days:        int32(v.GetDays()),
+			ReviewBomb:  v.ReviewBomb,
+			Languages:   []generated.ReviewLanguageSchema{},
+		}
+
+		for _, language := range v.Languages {
+			history.Languages = append(history.Languages, generated.ReviewLanguageSchema{
+				Language: language.Language,
+				Positive: int32(language.Positive),
+				Negative: int32(language.Negative),
+			})
+		}
+
+		result.History = append(result.History, history)
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func (s Server) GetGamesCoop(w http.ResponseWriter, r *http.Request, params generated.GetGamesCoopParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.CoopGamesResponse{Error: "invalid cc"})
+		return
+	}
+
+	var limit = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int(*params.Limit)
+	}
+
+	if len(params.Players) == 0 || len(params.Players) > 10 {
+		returnResponse(w, r, http.StatusBadRequest, generated.CoopGamesResponse{Error: "between 1 and 10 players are allowed"})
+		return
+	}
+
+	var playerIDs []int64
+	for _, v := range params.Players {
+
+		playerID, err := helpers.IsValidPlayerID(v)
+		if err != nil {
+			returnResponse(w, r, http.StatusBadRequest, generated.CoopGamesResponse{Error: "invalid player id"})
+			return
+		}
+
+		playerIDs = append(playerIDs, playerID)
+	}
+
+	games, err := mongo.GetCoopGames(playerIDs, cc, limit)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.CoopGamesResponse{Error: err.Error()})
+		return
+	}
+
+	result := generated.CoopGamesResponse{Games: []generated.CoopGameSchema{}}
+
+	for _, game := range games {
+
+		result.Games = append(result.Games, generated.CoopGameSchema{
+			Id:            int32(game.App.ID),
+			Name:          game.App.GetName(),
+			Icon:          game.App.GetIcon(),
+			Path:          config.C.GlobalSteamDomain + game.App.GetPath(),
+			Score:         game.Score,
+			Owners:        game.Owners,
+			Missing:       append([]int64{}, game.Missing...),
+			CoopTags:      game.Tags,
+			RecentMinutes: int32(game.RecentMinutes),
+			ReviewsScore:  game.App.ReviewsScore,
+			Price:         int32(game.Price.Final),
+			MissingPrice:  int32(game.MissingPrice),
+		})
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
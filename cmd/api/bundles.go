@@ -0,0 +1,180 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetBundles(w http.ResponseWriter, r *http.Request, params generated.GetBundlesParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.BundlesResponse{Error: "invalid cc"})
+		return
+	}
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	var sort = "_id"
+	if params.Sort != nil {
+		switch *params.Sort {
+		case "name":
+			sort = "name"
+		case "discount":
+			sort = "discount_sale"
+		case "price":
+			sort = "prices_sale." + string(cc)
+		case "savings":
+			sort = "savings." + string(cc)
+		case "apps":
+			sort = "apps"
+		case "created_at":
+			sort = "created_at"
+		default:
+			sort = "_id"
+		}
+	}
+
+	var order = -1
+	if params.Order != nil {
+		switch *params.Order {
+		case "1", "asc", "ascending":
+			order = 1
+		default:
+			order = -1
+		}
+	}
+
+	filter := bson.D{}
+
+	if params.Type != nil {
+		filter = append(filter, bson.E{Key: "type", Value: string(*params.Type)})
+	}
+
+	bundles, err := mongo.GetBundles(offset, limit, bson.D{{Key: sort, Value: order}}, filter, nil)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.BundlesResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionBundles, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.BundlesResponse{Bundles: []generated.BundleSchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, bundle := range bundles {
+		result.Bundles = append(result.Bundles, getBundleSchema(bundle, cc))
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetBundlesId(w http.ResponseWriter, r *http.Request, id int32, params generated.GetBundlesIdParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.BundleResponse{Error: "invalid cc"})
+		return
+	}
+
+	bundle, err := mongo.GetBundle(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.BundleResponse{Error: "bundle not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.BundleResponse{Error: err.Error()})
+		return
+	}
+
+	packages, err := mongo.GetPackagesByID(bundle.Packages, bson.M{"_id": 1, "apps": 1, "prices": 1})
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.BundleResponse{Error: err.Error()})
+		return
+	}
+
+	// Owned apps, for complete the set prices
+	var ownedApps = map[int]bool{}
+	if params.PlayerId != nil {
+
+		_, err = mongo.GetPlayer(*params.PlayerId)
+		if err == mongo.ErrNoDocuments {
+			returnResponse(w, r, http.StatusNotFound, generated.BundleResponse{Error: "player not found"})
+			return
+		} else if err != nil {
+			log.ErrS(err)
+			returnResponse(w, r, http.StatusInternalServerError, generated.BundleResponse{Error: err.Error()})
+			return
+		}
+
+		playerApps, err := mongo.GetPlayerAppsByPlayer(*params.PlayerId, 0, 0, nil, bson.M{"app_id": 1}, bson.D{{Key: "app_id", Value: bson.M{"$in": bundle.Apps}}})
+		if err != nil {
+			log.ErrS(err)
+			returnResponse(w, r, http.StatusInternalServerError, generated.BundleResponse{Error: err.Error()})
+			return
+		}
+
+		for _, v := range playerApps {
+			ownedApps[v.AppID] = true
+		}
+	}
+
+	value := bundle.GetValue(cc, packages, ownedApps)
+
+	result := generated.BundleResponse{
+		Bundle: getBundleSchema(bundle, cc),
+		Value: generated.BundleValueSchema{
+			Cc:             string(cc),
+			Currency:       string(value.Currency),
+			Price:          int32(value.Price),
+			Individual:     int32(value.Individual),
+			Saving:         int32(value.Saving),
+			SavingPercent:  int32(value.SavingPercent),
+			CompleteTheSet: int32(value.CompleteTheSet),
+			Packages:       int32(value.Packages),
+			PackagesOwned:  int32(value.PackagesOwned),
+		},
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func getBundleSchema(bundle mongo.Bundle, cc steamapi.ProductCC) generated.BundleSchema {
+
+	return generated.BundleSchema{
+		Id:              int32(bundle.ID),
+		Name:            bundle.GetName(),
+		Type:            generated.BundleSchemaType(bundle.Type),
+		Giftable:        bundle.Giftable,
+		Apps:            helpers.IntsToInt32s(bundle.Apps),
+		Packages:        helpers.IntsToInt32s(bundle.Packages),
+		Discount:        int32(bundle.DiscountSale),
+		Price:           int32(bundle.Prices[cc]),
+		PriceSale:       int32(bundle.PricesSale[cc]),
+		PriceIndividual: int32(bundle.PricesIndividual[cc]),
+		SavingPercent:   int32(bundle.GetSavings()[cc]),
+		Image:           bundle.Image,
+		Link:            bundle.GetStoreLink(),
+		CreatedAt:       bundle.CreatedAt.Unix(),
+		UpdatedAt:       bundle.UpdatedAt.Unix(),
+	}
+}
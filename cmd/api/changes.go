@@ -0,0 +1,420 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	changesPollInterval      = time.Second
+	changesKeepAlive         = time.Second * 30
+	changesPageSize          = 1_000
+	changesMaxResume         = 100_000 // Change numbers behind the latest a stream can resume from
+	changesLookBack          = 50      // Change numbers are not always inserted in order
+	changesMaxStreamsPerUser = 2
+)
+
+var changesUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+	CheckOrigin: func(r *http.Request) bool {
+		return true // Authed with an API key
+	},
+}
+
+// Polls Mongo for new changes and fans them out to every open stream
+var changesHub = &changesBroadcaster{
+	subscribers: map[*changesSubscriber]bool{},
+	userStreams: map[int]int{},
+	sent:        map[int]bool{},
+}
+
+type changesSubscriber struct {
+	userID int
+	appIDs map[int]bool
+	ch     chan mongo.Change
+}
+
+func (s *changesSubscriber) matches(change mongo.Change) bool {
+
+	if len(s.appIDs) == 0 {
+		return true
+	}
+
+	for _, v := range change.Apps {
+		if s.appIDs[v] {
+			return true
+		}
+	}
+	return false
+}
+
+type changesBroadcaster struct {
+	subscribers map[*changesSubscriber]bool
+	userStreams map[int]int
+	sent        map[int]bool
+	lastID      int
+	once        sync.Once
+	sync.Mutex
+}
+
+func (b *changesBroadcaster) subscribe(userID int, appIDs []int) (sub *changesSubscriber, ok bool) {
+
+	b.once.Do(func() {
+		go b.poll()
+	})
+
+	b.Lock()
+	defer b.Unlock()
+
+	if b.userStreams[userID] >= changesMaxStreamsPerUser {
+		return nil, false
+	}
+
+	sub = &changesSubscriber{
+		userID: userID,
+		appIDs: map[int]bool{},
+		ch:     make(chan mongo.Change, changesPageSize),
+	}
+
+	for _, v := range appIDs {
+		sub.appIDs[v] = true
+	}
+
+	b.subscribers[sub] = true
+	b.userStreams[userID]++
+
+	return sub, true
+}
+
+func (b *changesBroadcaster) unsubscribe(sub *changesSubscriber) {
+
+	b.Lock()
+	defer b.Unlock()
+
+	b.removeLocked(sub)
+}
+
+func (b *changesBroadcaster) removeLocked(sub *changesSubscriber) {
+
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(b.subscribers, sub)
+	close(sub.ch)
+
+	b.userStreams[sub.userID]--
+	if b.userStreams[sub.userID] <= 0 {
+		delete(b.userStreams, sub.userID)
+	}
+}
+
+func (b *changesBroadcaster) poll() {
+
+	var idle = true
+
+	for {
+
+		b.Lock()
+		var count = len(b.subscribers)
+		b.Unlock()
+
+		if count == 0 {
+			idle = true
+			time.Sleep(changesPollInterval)
+			continue
+		}
+
+		// Start from the latest change, streams load their own backlogs
+		if idle {
+
+			lastID, err := mongo.GetLatestChangeID()
+			if err != nil {
+				log.ErrS(err)
+				time.Sleep(changesPollInterval)
+				continue
+			}
+
+			b.Lock()
+			b.lastID = lastID
+			b.sent = map[int]bool{}
+			b.Unlock()
+
+			idle = false
+		}
+
+		time.Sleep(changesPollInterval)
+
+		changes, err := mongo.GetChangesAfter(b.lastID-changesLookBack, changesPageSize, nil)
+		if err != nil {
+			log.ErrS(err)
+			continue
+		}
+
+		b.broadcast(changes)
+	}
+}
+
+func (b *changesBroadcaster) broadcast(changes []mongo.Change) {
+
+	b.Lock()
+	defer b.Unlock()
+
+	for _, change := range changes {
+
+		if b.sent[change.ID] || change.ID <= b.lastID-changesLookBack {
+			continue
+		}
+
+		b.sent[change.ID] = true
+		if change.ID > b.lastID {
+			b.lastID = change.ID
+		}
+
+		for sub := range b.subscribers {
+
+			if !sub.matches(change) {
+				continue
+			}
+
+			// Drop streams that can't keep up, they can resume from the last ID they got
+			select {
+			case sub.ch <- change:
+			default:
+				b.removeLocked(sub)
+			}
+		}
+	}
+
+	for k := range b.sent {
+		if k <= b.lastID-changesLookBack {
+			delete(b.sent, k)
+		}
+	}
+}
+
+func (s Server) GetChangesStream(w http.ResponseWriter, r *http.Request, params generated.GetChangesStreamParams) {
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		returnResponse(w, r, http.StatusInternalServerError, generated.MessageResponse{Error: "streaming not supported"})
+		return
+	}
+
+	// Resume from the header browsers send on reconnect
+	if params.Since == nil {
+		if i, err := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 32); err == nil {
+			var since = int32(i)
+			params.Since = &since
+		}
+	}
+
+	var headersSent bool
+
+	streamChanges(w, r, params.Since, params.AppIds, func(change *mongo.Change) error {
+
+		if !headersSent {
+			w.Header().Set("Content-Type", "text/event-stream")
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("Connection", "keep-alive")
+			w.Header().Set("X-Accel-Buffering", "no")
+			w.WriteHeader(http.StatusOK)
+			headersSent = true
+		}
+
+		var err error
+		if change == nil {
+			_, err = w.Write([]byte(": ping\n\n"))
+		} else {
+
+			var b []byte
+			b, err = json.Marshal(changeSchema(*change))
+			if err != nil {
+				return err
+			}
+
+			_, err = w.Write([]byte("id: " + strconv.Itoa(change.ID) + "\nevent: change\ndata: " + string(b) + "\n\n"))
+		}
+
+		if err == nil {
+			flusher.Flush()
+		}
+		return err
+	})
+}
+
+func (s Server) GetChangesWs(w http.ResponseWriter, r *http.Request, params generated.GetChangesWsParams) {
+
+	var conn *websocket.Conn
+	var closed = make(chan struct{})
+
+	defer func() {
+		if conn != nil {
+			err := conn.Close()
+			if err != nil {
+				log.ErrS(err)
+			}
+		}
+	}()
+
+	streamChanges(w, r, params.Since, params.AppIds, func(change *mongo.Change) (err error) {
+
+		if conn == nil {
+
+			conn, err = changesUpgrader.Upgrade(w, r, nil)
+			if err != nil {
+				if !strings.Contains(err.Error(), "not a websocket handshake") {
+					log.ErrS(err)
+				}
+				return err
+			}
+
+			// Reads are only needed to notice the client leaving
+			go func() {
+				defer close(closed)
+				for {
+					if _, _, err := conn.NextReader(); err != nil {
+						return
+					}
+				}
+			}()
+		}
+
+		select {
+		case <-closed:
+			return websocket.ErrCloseSent
+		default:
+		}
+
+		if change == nil {
+			return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(time.Second*10))
+		}
+
+		return conn.WriteJSON(changeSchema(*change))
+	})
+}
+
+// Sends any changes since the given change number, then new changes as they come in.
+// send is called with nil to keep the connection alive, the first call should open the stream.
+func streamChanges(w http.ResponseWriter, r *http.Request, sinceParam *int32, appIDsParam *[]int32, send func(change *mongo.Change) error) {
+
+	userID, _ := r.Context().Value(ctxUserIDField).(int)
+
+	var appIDs []int
+	if appIDsParam != nil {
+		for _, v := range *appIDsParam {
+			appIDs = append(appIDs, int(v))
+		}
+	}
+
+	var since = -1
+	if sinceParam != nil {
+
+		since = int(*sinceParam)
+
+		latest, err := mongo.GetLatestChangeID()
+		if err != nil {
+			log.ErrS(err)
+			returnResponse(w, r, http.StatusInternalServerError, generated.MessageResponse{Error: err.Error()})
+			return
+		}
+
+		if latest-since > changesMaxResume {
+			returnResponse(w, r, http.StatusBadRequest, generated.MessageResponse{Error: "since must be within " + strconv.Itoa(changesMaxResume) + " of the latest change"})
+			return
+		}
+	}
+
+	// Subscribe before the backlog is loaded, so nothing is missed in between
+	sub, ok := changesHub.subscribe(userID, appIDs)
+	if !ok {
+		returnResponse(w, r, http.StatusTooManyRequests, generated.MessageResponse{Error: "too many open streams, max " + strconv.Itoa(changesMaxStreamsPerUser)})
+		return
+	}
+
+	defer changesHub.unsubscribe(sub)
+
+	// Open the stream
+	if send(nil) != nil {
+		return
+	}
+
+	var backlogSent = since
+
+	// Backlog
+	if since >= 0 {
+		for {
+
+			changes, err := mongo.GetChangesAfter(backlogSent, changesPageSize, appIDs)
+			if err != nil {
+				log.ErrS(err)
+				return
+			}
+
+			for k := range changes {
+				if send(&changes[k]) != nil {
+					return
+				}
+				backlogSent = changes[k].ID
+			}
+
+			if len(changes) < changesPageSize {
+				break
+			}
+		}
+	}
+
+	// Live
+	ticker := time.NewTicker(changesKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if send(nil) != nil {
+				return
+			}
+		case change, ok := <-sub.ch:
+			if !ok {
+				return // Dropped for being too slow
+			}
+			if change.ID <= backlogSent {
+				continue // Already sent in the backlog
+			}
+			if send(&change) != nil {
+				return
+			}
+		}
+	}
+}
+
+func changeSchema(change mongo.Change) generated.ChangeSchema {
+
+	var schema = generated.ChangeSchema{
+		Id:        int32(change.ID),
+		CreatedAt: change.CreatedAt.Unix(),
+		Apps:      []int32{},
+		Packages:  []int32{},
+	}
+
+	for _, v := range change.Apps {
+		schema.Apps = append(schema.Apps, int32(v))
+	}
+	for _, v := range change.Packages {
+		schema.Packages = append(schema.Packages, int32(v))
+	}
+
+	return schema
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetGamesIdDepots(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdDepotsParams) {
+
+	_, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.DepotHistoryResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.DepotHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	filter := bson.D{{Key: "app_id", Value: int(id)}}
+
+	if params.Branch != nil && *params.Branch != "" {
+		filter = append(filter, bson.E{Key: "branch", Value: *params.Branch})
+	}
+
+	returnDepotHistory(w, r, params.Offset, params.Limit, filter)
+}
+
+func (s Server) GetDepotsId(w http.ResponseWriter, r *http.Request, id int32, params generated.GetDepotsIdParams) {
+
+	returnDepotHistory(w, r, params.Offset, params.Limit, bson.D{{Key: "depot_id", Value: int(id)}})
+}
+
+func returnDepotHistory(w http.ResponseWriter, r *http.Request, offsetParam *generated.OffsetParam, limitParam *generated.LimitParam, filter bson.D) {
+
+	var limit int64 = 10
+	if limitParam != nil && *limitParam >= 1 && *limitParam <= 1000 {
+		limit = int64(*limitParam)
+	}
+
+	var offset int64 = 0
+	if offsetParam != nil {
+		offset = int64(*offsetParam)
+	}
+
+	histories, err := mongo.GetAppDepotHistories(offset, limit, filter)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.DepotHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionAppDepotHistory, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.DepotHistoryResponse{History: []generated.DepotHistorySchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, v := range histories {
+		result.History = append(result.History, generated.DepotHistorySchema{
+			AppId:        int32(v.AppID),
+			ChangeNumber: int32(v.ChangeNumber),
+			CreatedAt:    v.CreatedAt.Unix(),
+			Type:         generated.DepotHistorySchemaType(v.Type),
+			Branch:       v.Branch,
+			DepotId:      int32(v.DepotID),
+			DepotName:    v.DepotName,
+			Before:       v.Before,
+			After:        v.After,
+			TimeUpdated:  v.TimeUpdated,
+		})
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func (s Server) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+
+	rates, err := mongo.GetLatestExchangeRates()
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ExchangeRatesResponse{Error: err.Error()})
+		return
+	}
+
+	result := generated.ExchangeRatesResponse{Rates: []generated.ExchangeRateSchema{}}
+
+	for _, rate := range rates {
+		result.Rates = append(result.Rates, generated.ExchangeRateSchema{
+			Currency:  string(rate.Currency),
+			Rate:      rate.Rate,
+			CreatedAt: rate.CreatedAt.Unix(),
+		})
+	}
+
+	sort.Slice(result.Rates, func(i, j int) bool {
+		return result.Rates[i].Currency < result.Rates[j].Currency
+	})
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetExchangeRatesCurrency(w http.ResponseWriter, r *http.Request, currency string, params generated.GetExchangeRatesCurrencyParams) {
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	rates, err := mongo.GetExchangeRateHistory(steamapi.CurrencyCode(strings.ToUpper(currency)), limit)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.ExchangeRatesResponse{Error: err.Error()})
+		return
+	}
+
+	if len(rates) == 0 {
+		returnResponse(w, r, http.StatusNotFound, generated.ExchangeRatesResponse{Error: "currency not found"})
+		return
+	}
+
+	result := generated.ExchangeRatesResponse{Rates: []generated.ExchangeRateSchema{}}
+
+	for _, rate := range rates {
+		result.Rates = append(result.Rates, generated.ExchangeRateSchema{
+			Currency:  string(rate.Currency),
+			Rate:      rate.Rate,
+			CreatedAt: rate.CreatedAt.Unix(),
+		})
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetGamesIdRegions(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdRegionsParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.RegionPricesResponse{Error: "invalid cc"})
+		return
+	}
+
+	app, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.RegionPricesResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.RegionPricesResponse{Error: err.Error()})
+		return
+	}
+
+	returnRegionPrices(w, r, app.GetPrices(), cc)
+}
+
+func (s Server) GetPackagesIdRegions(w http.ResponseWriter, r *http.Request, id int32, params generated.GetPackagesIdRegionsParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.RegionPricesResponse{Error: "invalid cc"})
+		return
+	}
+
+	pack, err := mongo.GetPackage(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.RegionPricesResponse{Error: "package not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.RegionPricesResponse{Error: err.Error()})
+		return
+	}
+
+	returnRegionPrices(w, r, pack.Prices, cc)
+}
+
+func returnRegionPrices(w http.ResponseWriter, r *http.Request, prices helpers.ProductPrices, cc steamapi.ProductCC) {
+
+	rates, err := mongo.GetExchangeRates()
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.RegionPricesResponse{Error: err.Error()})
+		return
+	}
+
+	result := generated.RegionPricesResponse{Regions: []generated.RegionPriceSchema{}}
+
+	for _, v := range prices.RegionPrices(rates, cc) {
+		result.Regions = append(result.Regions, generated.RegionPriceSchema{
+			Rank:               int32(v.Rank),
+			Cc:                 string(v.ProductCC),
+			Currency:           string(v.Price.Currency),
+			Price:              int32(v.Price.Final),
+			Normalised:         int32(v.Normalised),
+			NormalisedCurrency: string(v.Currency),
+			DifferencePercent:  v.DifferencePercent,
+		})
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetFranchises(w http.ResponseWriter, r *http.Request, params generated.GetFranchisesParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.FranchisesResponse{Error: "invalid cc"})
+		return
+	}
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	var sort = "players_week"
+	if params.Sort != nil {
+		switch *params.Sort {
+		case "name":
+			sort = "name"
+		case "apps":
+			sort = "apps"
+		case "owners":
+			sort = "owners"
+		case "score":
+			sort = "reviews_score"
+		default:
+			sort = "players_week"
+		}
+	}
+
+	var order = -1
+	if params.Order != nil {
+		switch *params.Order {
+		case "1", "asc", "ascending":
+			order = 1
+		default:
+			order = -1
+		}
+	}
+
+	franchises, err := mongo.GetFranchises(offset, limit, bson.D{}, bson.D{{Key: sort, Value: order}})
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.FranchisesResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionFranchises, nil, 0)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.FranchisesResponse{}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, franchise := range franchises {
+		result.Franchises = append(result.Franchises, franchiseSchema(franchise, cc))
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetFranchisesId(w http.ResponseWriter, r *http.Request, id string, params generated.GetFranchisesIdParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.FranchiseResponse{Error: "invalid cc"})
+		return
+	}
+
+	franchise, err := mongo.GetFranchise(id)
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.FranchiseResponse{Error: "franchise not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.FranchiseResponse{Error: err.Error()})
+		return
+	}
+
+	returnResponse(w, r, http.StatusOK, generated.FranchiseResponse{Franchise: franchiseSchema(franchise, cc)})
+}
+
+func franchiseSchema(franchise mongo.Franchise, cc steamapi.ProductCC) generated.FranchiseSchema {
+
+	schema := generated.FranchiseSchema{
+		Id:              franchise.ID,
+		Name:            franchise.Name,
+		Path:            config.C.GlobalSteamDomain + franchise.GetPath(),
+		Icon:            franchise.GetIcon(),
+		Apps:            int32(franchise.Apps),
+		AppIds:          []int32{},
+		Publishers:      []int32{},
+		Developers:      []int32{},
+		PlayersWeek:     int32(franchise.PlayersWeek),
+		PlayersAlltime:  int32(franchise.PlayersAllTime),
+		Owners:          franchise.Owners,
+		ReviewsPositive: int32(franchise.ReviewsPositive),
+		ReviewsNegative: int32(franchise.ReviewsNegative),
+		ReviewsScore:    franchise.ReviewsScore,
+		Price:           int32(franchise.Prices[cc]),
+	}
+
+	for _, v := range franchise.AppIDs {
+		schema.AppIds = append(schema.AppIds, int32(v))
+	}
+	for _, v := range franchise.Publishers {
+		schema.Publishers = append(schema.Publishers, int32(v))
+	}
+	for _, v := range franchise.Developers {
+		schema.Developers = append(schema.Developers, int32(v))
+	}
+
+	return schema
+}
@@ -26,6 +26,46 @@ const (
 	KeyQueryScopes  = "keyQuery.Scopes"
 )
 
+// Defines values for BundleSchemaType.
+const (
+	BundleSchemaTypeCts BundleSchemaType = "cts"
+
+	BundleSchemaTypePt BundleSchemaType = "pt"
+)
+
+// Defines values for DepotHistorySchemaType.
+const (
+	DepotHistorySchemaTypeBuild DepotHistorySchemaType = "build"
+
+	DepotHistorySchemaTypeManifest DepotHistorySchemaType = "manifest"
+)
+
+// Defines values for PicsHistorySchemaAction.
+const (
+	PicsHistorySchemaActionAdded PicsHistorySchemaAction = "added"
+
+	PicsHistorySchemaActionModified PicsHistorySchemaAction = "modified"
+
+	PicsHistorySchemaActionRemoved PicsHistorySchemaAction = "removed"
+)
+
+// Defines values for PicsHistorySchemaField.
+const (
+	PicsHistorySchemaFieldCommon PicsHistorySchemaField = "common"
+
+	PicsHistorySchemaFieldConfig PicsHistorySchemaField = "config"
+
+	PicsHistorySchemaFieldDepots PicsHistorySchemaField = "depots"
+
+	PicsHistorySchemaFieldExtended PicsHistorySchemaField = "extended"
+
+	PicsHistorySchemaFieldLaunch PicsHistorySchemaField = "launch"
+
+	PicsHistorySchemaFieldPackage PicsHistorySchemaField = "package"
+
+	PicsHistorySchemaFieldUfs PicsHistorySchemaField = "ufs"
+)
+
 // Defines values for OrderParamDesc.
 const (
 	Asc OrderParamDesc = "asc"
@@ -33,6 +73,25 @@ const (
 	Desc OrderParamDesc = "desc"
 )
 
+// AchievementStatsSchema defines model for achievement-stats-schema.
+type AchievementStatsSchema struct {
+	AppId                int32    `json:"app_id"`
+	CompletionistPercent float64  `json:"completionist_percent"`
+	Completionists       int32    `json:"completionists"`
+	Hardest              []string `json:"hardest"`
+
+	// From the first to the last achievement, Steam does not record when a game was first played
+	MedianCompletionSecondsSinceFirstAchievement int64 `json:"median_completion_seconds_since_first_achievement"`
+	Players                                      int32 `json:"players"`
+	Rarity                                       []struct {
+		Count int32  `json:"count"`
+		Name  string `json:"name"`
+	} `json:"rarity"`
+	SamplePlayers int32    `json:"sample_players"`
+	UnlockOrder   []string `json:"unlock_order"`
+	UpdatedAt     int64    `json:"updated_at"`
+}
+
 // ArticleSchema defines model for article-schema.
 type ArticleSchema struct {
 	AppIcon   string `json:"app_icon"`
@@ -49,6 +108,108 @@ type ArticleSchema struct {
 	Url       string `json:"url"`
 }
 
+// BundleSchema defines model for bundle-schema.
+type BundleSchema struct {
+	Apps            []int32          `json:"apps"`
+	CreatedAt       int64            `json:"created_at"`
+	Discount        int32            `json:"discount"`
+	Giftable        bool             `json:"giftable"`
+	Id              int32            `json:"id"`
+	Image           string           `json:"image"`
+	Link            string           `json:"link"`
+	Name            string           `json:"name"`
+	Packages        []int32          `json:"packages"`
+	Price           int32            `json:"price"`
+	PriceIndividual int32            `json:"price_individual"`
+	PriceSale       int32            `json:"price_sale"`
+	SavingPercent   int32            `json:"saving_percent"`
+	Type            BundleSchemaType `json:"type"`
+	UpdatedAt       int64            `json:"updated_at"`
+}
+
+// BundleSchemaType defines model for BundleSchema.Type.
+type BundleSchemaType string
+
+// BundleValueSchema defines model for bundle-value-schema.
+type BundleValueSchema struct {
+	Cc             string `json:"cc"`
+	CompleteTheSet int32  `json:"complete_the_set"`
+	Currency       string `json:"currency"`
+	Individual     int32  `json:"individual"`
+	Packages       int32  `json:"packages"`
+	PackagesOwned  int32  `json:"packages_owned"`
+	Price          int32  `json:"price"`
+	Saving         int32  `json:"saving"`
+	SavingPercent  int32  `json:"saving_percent"`
+}
+
+// ChangeSchema defines model for change-schema.
+type ChangeSchema struct {
+	Apps      []int32 `json:"apps"`
+	CreatedAt int64   `json:"created_at"`
+	Id        int32   `json:"id"`
+	Packages  []int32 `json:"packages"`
+}
+
+// CoopGameSchema defines model for coop-game-schema.
+type CoopGameSchema struct {
+	CoopTags      []string `json:"coop_tags"`
+	Icon          string   `json:"icon"`
+	Id            int32    `json:"id"`
+	Missing       []int64  `json:"missing"`
+	MissingPrice  int32    `json:"missing_price"`
+	Name          string   `json:"name"`
+	Owners        []int64  `json:"owners"`
+	Path          string   `json:"path"`
+	Price         int32    `json:"price"`
+	RecentMinutes int32    `json:"recent_minutes"`
+	ReviewsScore  float64  `json:"reviews_score"`
+	Score         float64  `json:"score"`
+}
+
+// DepotHistorySchema defines model for depot-history-schema.
+type DepotHistorySchema struct {
+	After        string                 `json:"after"`
+	AppId        int32                  `json:"app_id"`
+	Before       string                 `json:"before"`
+	Branch       string                 `json:"branch"`
+	ChangeNumber int32                  `json:"change_number"`
+	CreatedAt    int64                  `json:"created_at"`
+	DepotId      int32                  `json:"depot_id"`
+	DepotName    string                 `json:"depot_name"`
+	TimeUpdated  int64                  `json:"time_updated"`
+	Type         DepotHistorySchemaType `json:"type"`
+}
+
+// DepotHistorySchemaType defines model for DepotHistorySchema.Type.
+type DepotHistorySchemaType string
+
+// ExchangeRateSchema defines model for exchange-rate-schema.
+type ExchangeRateSchema struct {
+	CreatedAt int64   `json:"created_at"`
+	Currency  string  `json:"currency"`
+	Rate      float64 `json:"rate"`
+}
+
+// FranchiseSchema defines model for franchise-schema.
+type FranchiseSchema struct {
+	AppIds          []int32 `json:"app_ids"`
+	Apps            int32   `json:"apps"`
+	Developers      []int32 `json:"developers"`
+	Icon            string  `json:"icon"`
+	Id              string  `json:"id"`
+	Name            string  `json:"name"`
+	Owners          int64   `json:"owners"`
+	Path            string  `json:"path"`
+	PlayersAlltime  int32   `json:"players_alltime"`
+	PlayersWeek     int32   `json:"players_week"`
+	Price           int32   `json:"price"`
+	Publishers      []int32 `json:"publishers"`
+	ReviewsNegative int32   `json:"reviews_negative"`
+	ReviewsPositive int32   `json:"reviews_positive"`
+	ReviewsScore    float64 `json:"reviews_score"`
+}
+
 // GameSchema defines model for game-schema.
 type GameSchema struct {
 	Categories      []StatSchema      `json:"categories"`
@@ -92,6 +253,41 @@ type GroupSchema struct {
 	Url           string  `json:"url"`
 }
 
+// ItemRecipeInputSchema defines model for item-recipe-input-schema.
+type ItemRecipeInputSchema struct {
+	ItemDefId int32  `json:"item_def_id"`
+	Quantity  int32  `json:"quantity"`
+	Tag       string `json:"tag"`
+}
+
+// ItemRecipeSchema defines model for item-recipe-schema.
+type ItemRecipeSchema struct {
+	Inputs []ItemRecipeInputSchema `json:"inputs"`
+}
+
+// ItemSchema defines model for item-schema.
+type ItemSchema struct {
+	AppId       int32              `json:"app_id"`
+	Commodity   bool               `json:"commodity"`
+	Description string             `json:"description"`
+	Icon        string             `json:"icon"`
+	ItemDefId   int32              `json:"item_def_id"`
+	MarketLink  string             `json:"market_link"`
+	Marketable  bool               `json:"marketable"`
+	Name        string             `json:"name"`
+	Prices      ItemSchema_Prices  `json:"prices"`
+	Quality     string             `json:"quality"`
+	Recipes     []ItemRecipeSchema `json:"recipes"`
+	Tags        []string           `json:"tags"`
+	Tradable    bool               `json:"tradable"`
+	Type        string             `json:"type"`
+}
+
+// ItemSchema_Prices defines model for ItemSchema.Prices.
+type ItemSchema_Prices struct {
+	AdditionalProperties map[string]int32 `json:"-"`
+}
+
 // MessageSchema defines model for message-schema.
 type MessageSchema struct {
 	Error   string `json:"error"`
@@ -135,6 +331,37 @@ type PaginationSchema struct {
 	Total        int64 `json:"total"`
 }
 
+// PicsHistorySchema defines model for pics-history-schema.
+type PicsHistorySchema struct {
+	Action       PicsHistorySchemaAction `json:"action"`
+	After        string                  `json:"after"`
+	AppId        int32                   `json:"app_id"`
+	Before       string                  `json:"before"`
+	ChangeNumber int32                   `json:"change_number"`
+	CreatedAt    int64                   `json:"created_at"`
+	Field        PicsHistorySchemaField  `json:"field"`
+	Key          string                  `json:"key"`
+	PackageId    int32                   `json:"package_id"`
+}
+
+// PicsHistorySchemaAction defines model for PicsHistorySchema.Action.
+type PicsHistorySchemaAction string
+
+// PicsHistorySchemaField defines model for PicsHistorySchema.Field.
+type PicsHistorySchemaField string
+
+// PlayerHistorySchema defines model for player-history-schema.
+type PlayerHistorySchema struct {
+	After     string `json:"after"`
+	Before    string `json:"before"`
+	CreatedAt int64  `json:"created_at"`
+	ItemId    int64  `json:"item_id"`
+	ItemName  string `json:"item_name"`
+	ItemPath  string `json:"item_path"`
+	Text      string `json:"text"`
+	Type      string `json:"type"`
+}
+
 // PlayerSchema defines model for player-schema.
 type PlayerSchema struct {
 	Avatar    string `json:"avatar"`
@@ -151,6 +378,37 @@ type PlayerSchema struct {
 	VanityUrl string `json:"vanity_url"`
 }
 
+// PriceChangeSchema defines model for price-change-schema.
+type PriceChangeSchema struct {
+	AppId             int32   `json:"app_id"`
+	Cc                string  `json:"cc"`
+	CreatedAt         int64   `json:"created_at"`
+	Currency          string  `json:"currency"`
+	Difference        int32   `json:"difference"`
+	DifferencePercent float64 `json:"difference_percent"`
+	Icon              string  `json:"icon"`
+	Name              string  `json:"name"`
+	PackageId         int32   `json:"package_id"`
+	PriceAfter        int32   `json:"price_after"`
+	PriceBefore       int32   `json:"price_before"`
+}
+
+// PriceStatsSchema defines model for price-stats-schema.
+type PriceStatsSchema struct {
+	AllTimeLow          int32   `json:"all_time_low"`
+	AllTimeLowAt        int64   `json:"all_time_low_at"`
+	AverageDiscount     float64 `json:"average_discount"`
+	Cc                  string  `json:"cc"`
+	Currency            string  `json:"currency"`
+	CurrentPrice        int32   `json:"current_price"`
+	DealRating          string  `json:"deal_rating"`
+	DealScore           int32   `json:"deal_score"`
+	DiscountCadenceDays float64 `json:"discount_cadence_days"`
+	DiscountCount       int32   `json:"discount_count"`
+	RecentLow           int32   `json:"recent_low"`
+	RegularPrice        int32   `json:"regular_price"`
+}
+
 // ProductPriceSchema defines model for product-price-schema.
 type ProductPriceSchema struct {
 	Currency        string `json:"currency"`
@@ -161,6 +419,67 @@ type ProductPriceSchema struct {
 	Initial         int32  `json:"initial"`
 }
 
+// RegionPriceSchema defines model for region-price-schema.
+type RegionPriceSchema struct {
+	Cc                 string  `json:"cc"`
+	Currency           string  `json:"currency"`
+	DifferencePercent  float64 `json:"difference_percent"`
+	Normalised         int32   `json:"normalised"`
+	NormalisedCurrency string  `json:"normalised_currency"`
+	Price              int32   `json:"price"`
+	Rank               int32   `json:"rank"`
+}
+
+// ReviewHistorySchema defines model for review-history-schema.
+type ReviewHistorySchema struct {
+	Date int64 `json:"date"`
+	Days int32 `json:"days"`
+
+	// From a sample of the latest reviews in all languages, not totals
+	Languages   []ReviewLanguageSchema `json:"languages"`
+	Negative    int32                  `json:"negative"`
+	NewNegative int32                  `json:"new_negative"`
+	NewPositive int32                  `json:"new_positive"`
+	Positive    int32                  `json:"positive"`
+	ReviewBomb  bool                   `json:"review_bomb"`
+	Score       float64                `json:"score"`
+}
+
+// ReviewLanguageSchema defines model for review-language-schema.
+type ReviewLanguageSchema struct {
+	Language string `json:"language"`
+	Negative int32  `json:"negative"`
+	Positive int32  `json:"positive"`
+}
+
+// SaleSchema defines model for sale-schema.
+type SaleSchema struct {
+	AppIcon         string                  `json:"app_icon"`
+	AppId           int32                   `json:"app_id"`
+	AppName         string                  `json:"app_name"`
+	AppRating       float64                 `json:"app_rating"`
+	AppType         string                  `json:"app_type"`
+	LowestPrices    SaleSchema_LowestPrices `json:"lowest_prices"`
+	Prices          SaleSchema_Prices       `json:"prices"`
+	SaleEnd         int64                   `json:"sale_end"`
+	SaleEndEstimate bool                    `json:"sale_end_estimate"`
+	SaleName        string                  `json:"sale_name"`
+	SalePercent     int32                   `json:"sale_percent"`
+	SaleStart       int64                   `json:"sale_start"`
+	SaleType        string                  `json:"sale_type"`
+	SubId           int32                   `json:"sub_id"`
+}
+
+// SaleSchema_LowestPrices defines model for SaleSchema.LowestPrices.
+type SaleSchema_LowestPrices struct {
+	AdditionalProperties map[string]int32 `json:"-"`
+}
+
+// SaleSchema_Prices defines model for SaleSchema.Prices.
+type SaleSchema_Prices struct {
+	AdditionalProperties map[string]int32 `json:"-"`
+}
+
 // SimilarGameSchema defines model for similar-game-schema.
 type SimilarGameSchema struct {
 	AppId  int `json:"app_id"`
@@ -175,6 +494,9 @@ type StatSchema struct {
 	Name string `json:"name"`
 }
 
+// CcParam defines model for cc-param.
+type CcParam string
+
 // LimitParam defines model for limit-param.
 type LimitParam int
 
@@ -184,6 +506,12 @@ type OffsetParam int
 // OrderParamDesc defines model for order-param-desc.
 type OrderParamDesc string
 
+// AchievementStatsResponse defines model for achievement-stats-response.
+type AchievementStatsResponse struct {
+	Error string                 `json:"error"`
+	Stats AchievementStatsSchema `json:"stats"`
+}
+
 // List of articles
 type ArticlesResponse struct {
 	Articles   []ArticleSchema  `json:"articles"`
@@ -191,6 +519,52 @@ type ArticlesResponse struct {
 	Pagination PaginationSchema `json:"pagination"`
 }
 
+// BundleResponse defines model for bundle-response.
+type BundleResponse struct {
+	Bundle BundleSchema      `json:"bundle"`
+	Error  string            `json:"error"`
+	Value  BundleValueSchema `json:"value"`
+}
+
+// BundlesResponse defines model for bundles-response.
+type BundlesResponse struct {
+	Bundles    []BundleSchema   `json:"bundles"`
+	Error      string           `json:"error"`
+	Pagination PaginationSchema `json:"pagination"`
+}
+
+// CoopGamesResponse defines model for coop-games-response.
+type CoopGamesResponse struct {
+	Error string           `json:"error"`
+	Games []CoopGameSchema `json:"games"`
+}
+
+// DepotHistoryResponse defines model for depot-history-response.
+type DepotHistoryResponse struct {
+	Error      string               `json:"error"`
+	History    []DepotHistorySchema `json:"history"`
+	Pagination PaginationSchema     `json:"pagination"`
+}
+
+// ExchangeRatesResponse defines model for exchange-rates-response.
+type ExchangeRatesResponse struct {
+	Error string               `json:"error"`
+	Rates []ExchangeRateSchema `json:"rates"`
+}
+
+// FranchiseResponse defines model for franchise-response.
+type FranchiseResponse struct {
+	Error     string          `json:"error"`
+	Franchise FranchiseSchema `json:"franchise"`
+}
+
+// FranchisesResponse defines model for franchises-response.
+type FranchisesResponse struct {
+	Error      string            `json:"error"`
+	Franchises []FranchiseSchema `json:"franchises"`
+	Pagination PaginationSchema  `json:"pagination"`
+}
+
 // GameResponse defines model for game-response.
 type GameResponse struct {
 	Error string     `json:"error"`
@@ -211,6 +585,19 @@ type GroupsResponse struct {
 	Pagination PaginationSchema `json:"pagination"`
 }
 
+// ItemResponse defines model for item-response.
+type ItemResponse struct {
+	Error string     `json:"error"`
+	Item  ItemSchema `json:"item"`
+}
+
+// ItemsResponse defines model for items-response.
+type ItemsResponse struct {
+	Error      string           `json:"error"`
+	Items      []ItemSchema     `json:"items"`
+	Pagination PaginationSchema `json:"pagination"`
+}
+
 // MessageResponse defines model for message-response.
 type MessageResponse MessageSchema
 
@@ -221,6 +608,20 @@ type PackagesResponse struct {
 	Pagination PaginationSchema `json:"pagination"`
 }
 
+// PicsHistoryResponse defines model for pics-history-response.
+type PicsHistoryResponse struct {
+	Error      string              `json:"error"`
+	History    []PicsHistorySchema `json:"history"`
+	Pagination PaginationSchema    `json:"pagination"`
+}
+
+// PlayerHistoryResponse defines model for player-history-response.
+type PlayerHistoryResponse struct {
+	Error      string                `json:"error"`
+	Events     []PlayerHistorySchema `json:"events"`
+	Pagination PaginationSchema      `json:"pagination"`
+}
+
 // PlayerResponse defines model for player-response.
 type PlayerResponse struct {
 	Error  string       `json:"error"`
@@ -234,6 +635,39 @@ type PlayersResponse struct {
 	Players    []PlayerSchema   `json:"players"`
 }
 
+// PriceChangesResponse defines model for price-changes-response.
+type PriceChangesResponse struct {
+	Error      string              `json:"error"`
+	Pagination PaginationSchema    `json:"pagination"`
+	Prices     []PriceChangeSchema `json:"prices"`
+}
+
+// PriceStatsResponse defines model for price-stats-response.
+type PriceStatsResponse struct {
+	Error string           `json:"error"`
+	Stats PriceStatsSchema `json:"stats"`
+}
+
+// RegionPricesResponse defines model for region-prices-response.
+type RegionPricesResponse struct {
+	Error   string              `json:"error"`
+	Regions []RegionPriceSchema `json:"regions"`
+}
+
+// ReviewHistoryResponse defines model for review-history-response.
+type ReviewHistoryResponse struct {
+	Error      string                `json:"error"`
+	History    []ReviewHistorySchema `json:"history"`
+	Pagination PaginationSchema      `json:"pagination"`
+}
+
+// SalesResponse defines model for sales-response.
+type SalesResponse struct {
+	Error      string           `json:"error"`
+	Pagination PaginationSchema `json:"pagination"`
+	Sales      []SaleSchema     `json:"sales"`
+}
+
 // List of apps, with pagination
 type SimilarGamesResponse struct {
 	Error string              `json:"error"`
@@ -254,6 +688,86 @@ type GetArticlesParams struct {
 // GetArticlesParamsOrder defines parameters for GetArticles.
 type GetArticlesParamsOrder string
 
+// GetBundlesParams defines parameters for GetBundles.
+type GetBundlesParams struct {
+	Offset *OffsetParam           `json:"offset,omitempty"`
+	Limit  *LimitParam            `json:"limit,omitempty"`
+	Order  *GetBundlesParamsOrder `json:"order,omitempty"`
+	Cc     *CcParam               `json:"cc,omitempty"`
+	Sort   *GetBundlesParamsSort  `json:"sort,omitempty"`
+	Type   *GetBundlesParamsType  `json:"type,omitempty"`
+}
+
+// GetBundlesParamsOrder defines parameters for GetBundles.
+type GetBundlesParamsOrder string
+
+// GetBundlesParamsSort defines parameters for GetBundles.
+type GetBundlesParamsSort string
+
+// GetBundlesParamsType defines parameters for GetBundles.
+type GetBundlesParamsType string
+
+// GetBundlesIdParams defines parameters for GetBundlesId.
+type GetBundlesIdParams struct {
+	Cc       *CcParam `json:"cc,omitempty"`
+	PlayerId *int64   `json:"player_id,omitempty"`
+}
+
+// GetChangesPicsHistoryParams defines parameters for GetChangesPicsHistory.
+type GetChangesPicsHistoryParams struct {
+	Offset       *OffsetParam                      `json:"offset,omitempty"`
+	Limit        *LimitParam                       `json:"limit,omitempty"`
+	ChangeNumber *int32                            `json:"change_number,omitempty"`
+	Field        *GetChangesPicsHistoryParamsField `json:"field,omitempty"`
+	Key          *string                           `json:"key,omitempty"`
+}
+
+// GetChangesPicsHistoryParamsField defines parameters for GetChangesPicsHistory.
+type GetChangesPicsHistoryParamsField string
+
+// GetChangesStreamParams defines parameters for GetChangesStream.
+type GetChangesStreamParams struct {
+	Since  *int32   `json:"since,omitempty"`
+	AppIds *[]int32 `json:"app_ids,omitempty"`
+}
+
+// GetChangesWsParams defines parameters for GetChangesWs.
+type GetChangesWsParams struct {
+	Since  *int32   `json:"since,omitempty"`
+	AppIds *[]int32 `json:"app_ids,omitempty"`
+}
+
+// GetDepotsIdParams defines parameters for GetDepotsId.
+type GetDepotsIdParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+}
+
+// GetExchangeRatesCurrencyParams defines parameters for GetExchangeRatesCurrency.
+type GetExchangeRatesCurrencyParams struct {
+	Limit *LimitParam `json:"limit,omitempty"`
+}
+
+// GetFranchisesParams defines parameters for GetFranchises.
+type GetFranchisesParams struct {
+	Offset *OffsetParam              `json:"offset,omitempty"`
+	Limit  *LimitParam               `json:"limit,omitempty"`
+	Order  *GetFranchisesParamsOrder `json:"order,omitempty"`
+	Sort   *GetFranchisesParamsSort  `json:"sort,omitempty"`
+	Cc     *CcParam                  `json:"cc,omitempty"`
+}
+
+// GetFranchisesParamsOrder defines parameters for GetFranchises.
+type GetFranchisesParamsOrder string
+
+// GetFranchisesParamsSort defines parameters for GetFranchises.
+type GetFranchisesParamsSort string
+
+// GetFranchisesIdParams defines parameters for GetFranchisesId.
+type GetFranchisesIdParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
 // GetGamesParams defines parameters for GetGames.
 type GetGamesParams struct {
 	Offset     *OffsetParam         `json:"offset,omitempty"`
@@ -272,6 +786,54 @@ type GetGamesParams struct {
 // GetGamesParamsOrder defines parameters for GetGames.
 type GetGamesParamsOrder string
 
+// GetGamesCoopParams defines parameters for GetGamesCoop.
+type GetGamesCoopParams struct {
+	Players []int64     `json:"players"`
+	Limit   *LimitParam `json:"limit,omitempty"`
+	Cc      *CcParam    `json:"cc,omitempty"`
+}
+
+// GetGamesIdDepotsParams defines parameters for GetGamesIdDepots.
+type GetGamesIdDepotsParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+	Branch *string      `json:"branch,omitempty"`
+}
+
+// GetGamesIdItemsParams defines parameters for GetGamesIdItems.
+type GetGamesIdItemsParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+}
+
+// GetGamesIdPicsHistoryParams defines parameters for GetGamesIdPicsHistory.
+type GetGamesIdPicsHistoryParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+	Key    *string      `json:"key,omitempty"`
+}
+
+// GetGamesIdPriceStatsParams defines parameters for GetGamesIdPriceStats.
+type GetGamesIdPriceStatsParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
+// GetGamesIdPricesParams defines parameters for GetGamesIdPrices.
+type GetGamesIdPricesParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
+// GetGamesIdRegionsParams defines parameters for GetGamesIdRegions.
+type GetGamesIdRegionsParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
+// GetGamesIdReviewsParams defines parameters for GetGamesIdReviews.
+type GetGamesIdReviewsParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+}
+
 // GetGroupsParams defines parameters for GetGroups.
 type GetGroupsParams struct {
 	Offset *OffsetParam          `json:"offset,omitempty"`
@@ -305,6 +867,28 @@ type GetPackagesParamsOrder string
 // GetPackagesParamsSort defines parameters for GetPackages.
 type GetPackagesParamsSort string
 
+// GetPackagesIdPicsHistoryParams defines parameters for GetPackagesIdPicsHistory.
+type GetPackagesIdPicsHistoryParams struct {
+	Offset *OffsetParam `json:"offset,omitempty"`
+	Limit  *LimitParam  `json:"limit,omitempty"`
+	Key    *string      `json:"key,omitempty"`
+}
+
+// GetPackagesIdPriceStatsParams defines parameters for GetPackagesIdPriceStats.
+type GetPackagesIdPriceStatsParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
+// GetPackagesIdPricesParams defines parameters for GetPackagesIdPrices.
+type GetPackagesIdPricesParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
+// GetPackagesIdRegionsParams defines parameters for GetPackagesIdRegions.
+type GetPackagesIdRegionsParams struct {
+	Cc *CcParam `json:"cc,omitempty"`
+}
+
 // GetPlayersParams defines parameters for GetPlayers.
 type GetPlayersParams struct {
 	Offset    *OffsetParam           `json:"offset,omitempty"`
@@ -321,6 +905,50 @@ type GetPlayersParamsOrder string
 // GetPlayersParamsSort defines parameters for GetPlayers.
 type GetPlayersParamsSort string
 
+// GetPlayersIdHistoryParams defines parameters for GetPlayersIdHistory.
+type GetPlayersIdHistoryParams struct {
+	Offset *OffsetParam                   `json:"offset,omitempty"`
+	Limit  *LimitParam                    `json:"limit,omitempty"`
+	Type   *GetPlayersIdHistoryParamsType `json:"type,omitempty"`
+}
+
+// GetPlayersIdHistoryParamsType defines parameters for GetPlayersIdHistory.
+type GetPlayersIdHistoryParamsType string
+
+// GetPricesParams defines parameters for GetPrices.
+type GetPricesParams struct {
+	Offset     *OffsetParam         `json:"offset,omitempty"`
+	Limit      *LimitParam          `json:"limit,omitempty"`
+	Cc         *CcParam             `json:"cc,omitempty"`
+	Type       *GetPricesParamsType `json:"type,omitempty"`
+	PercentMin *float32             `json:"percent_min,omitempty"`
+	PercentMax *float32             `json:"percent_max,omitempty"`
+	PriceMin   *int32               `json:"price_min,omitempty"`
+	PriceMax   *int32               `json:"price_max,omitempty"`
+}
+
+// GetPricesParamsType defines parameters for GetPrices.
+type GetPricesParamsType string
+
+// GetSalesParams defines parameters for GetSales.
+type GetSalesParams struct {
+	Offset      *OffsetParam         `json:"offset,omitempty"`
+	Limit       *LimitParam          `json:"limit,omitempty"`
+	Order       *GetSalesParamsOrder `json:"order,omitempty"`
+	Sort        *GetSalesParamsSort  `json:"sort,omitempty"`
+	SaleType    *[]string            `json:"sale_type,omitempty"`
+	AppType     *[]string            `json:"app_type,omitempty"`
+	DiscountMin *int32               `json:"discount_min,omitempty"`
+	DiscountMax *int32               `json:"discount_max,omitempty"`
+	AppIds      *[]int32             `json:"app_ids,omitempty"`
+}
+
+// GetSalesParamsOrder defines parameters for GetSales.
+type GetSalesParamsOrder string
+
+// GetSalesParamsSort defines parameters for GetSales.
+type GetSalesParamsSort string
+
 // Getter for additional properties for GameSchema_Prices. Returns the specified
 // element and whether it was found
 func (a GameSchema_Prices) Get(fieldName string) (value ProductPriceSchema, found bool) {
@@ -374,6 +1002,59 @@ func (a GameSchema_Prices) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for ItemSchema_Prices. Returns the specified
+// element and whether it was found
+func (a ItemSchema_Prices) Get(fieldName string) (value int32, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ItemSchema_Prices
+func (a *ItemSchema_Prices) Set(fieldName string, value int32) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int32)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ItemSchema_Prices to handle AdditionalProperties
+func (a *ItemSchema_Prices) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int32)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int32
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ItemSchema_Prices to handle AdditionalProperties
+func (a ItemSchema_Prices) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for PackageSchema_Prices. Returns the specified
 // element and whether it was found
 func (a PackageSchema_Prices) Get(fieldName string) (value ProductPriceSchema, found bool) {
@@ -427,48 +1108,235 @@ func (a PackageSchema_Prices) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List Articles
-	// (GET /articles)
-	GetArticles(w http.ResponseWriter, r *http.Request, params GetArticlesParams)
-	// List Games
-	// (GET /games)
-	GetGames(w http.ResponseWriter, r *http.Request, params GetGamesParams)
-	// Retrieve Game
-	// (GET /games/{id})
-	GetGamesId(w http.ResponseWriter, r *http.Request, id int32)
-	// List games with similar owners
-	// (GET /games/{id}/similar)
-	GetGamesIdSimilar(w http.ResponseWriter, r *http.Request, id int32)
-	// List Groups
-	// (GET /groups)
-	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
-	// List Packages
-	// (GET /packages)
-	GetPackages(w http.ResponseWriter, r *http.Request, params GetPackagesParams)
-	// List Players
-	// (GET /players)
-	GetPlayers(w http.ResponseWriter, r *http.Request, params GetPlayersParams)
-	// Retrieve Player
-	// (GET /players/{id})
-	GetPlayersId(w http.ResponseWriter, r *http.Request, id int64)
-	// Update Player
-	// (POST /players/{id})
-	PostPlayersId(w http.ResponseWriter, r *http.Request, id int64)
+// Getter for additional properties for SaleSchema_LowestPrices. Returns the specified
+// element and whether it was found
+func (a SaleSchema_LowestPrices) Get(fieldName string) (value int32, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
+// Setter for additional properties for SaleSchema_LowestPrices
+func (a *SaleSchema_LowestPrices) Set(fieldName string, value int32) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int32)
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
-
-// GetArticles operation middleware
-func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+// Override default JSON handling for SaleSchema_LowestPrices to handle AdditionalProperties
+func (a *SaleSchema_LowestPrices) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int32)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int32
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SaleSchema_LowestPrices to handle AdditionalProperties
+func (a SaleSchema_LowestPrices) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for SaleSchema_Prices. Returns the specified
+// element and whether it was found
+func (a SaleSchema_Prices) Get(fieldName string) (value int32, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for SaleSchema_Prices
+func (a *SaleSchema_Prices) Set(fieldName string, value int32) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]int32)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SaleSchema_Prices to handle AdditionalProperties
+func (a *SaleSchema_Prices) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]int32)
+		for fieldName, fieldBuf := range object {
+			var fieldVal int32
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SaleSchema_Prices to handle AdditionalProperties
+func (a SaleSchema_Prices) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List Articles
+	// (GET /articles)
+	GetArticles(w http.ResponseWriter, r *http.Request, params GetArticlesParams)
+	// List Bundles
+	// (GET /bundles)
+	GetBundles(w http.ResponseWriter, r *http.Request, params GetBundlesParams)
+	// Retrieve bundle value against individual package prices
+	// (GET /bundles/{id})
+	GetBundlesId(w http.ResponseWriter, r *http.Request, id int32, params GetBundlesIdParams)
+	// Search PICS key changes
+	// (GET /changes/pics-history)
+	GetChangesPicsHistory(w http.ResponseWriter, r *http.Request, params GetChangesPicsHistoryParams)
+	// Stream PICS changes
+	// (GET /changes/stream)
+	GetChangesStream(w http.ResponseWriter, r *http.Request, params GetChangesStreamParams)
+	// Stream PICS changes over a WebSocket
+	// (GET /changes/ws)
+	GetChangesWs(w http.ResponseWriter, r *http.Request, params GetChangesWsParams)
+	// List depot manifest history
+	// (GET /depots/{id})
+	GetDepotsId(w http.ResponseWriter, r *http.Request, id int32, params GetDepotsIdParams)
+	// List latest exchange rates
+	// (GET /exchange-rates)
+	GetExchangeRates(w http.ResponseWriter, r *http.Request)
+	// List exchange rate history for a currency
+	// (GET /exchange-rates/{currency})
+	GetExchangeRatesCurrency(w http.ResponseWriter, r *http.Request, currency string, params GetExchangeRatesCurrencyParams)
+	// List Franchises
+	// (GET /franchises)
+	GetFranchises(w http.ResponseWriter, r *http.Request, params GetFranchisesParams)
+	// Retrieve Franchise
+	// (GET /franchises/{id})
+	GetFranchisesId(w http.ResponseWriter, r *http.Request, id string, params GetFranchisesIdParams)
+	// List Games
+	// (GET /games)
+	GetGames(w http.ResponseWriter, r *http.Request, params GetGamesParams)
+	// List games owned by all or most of a group of players, for playing together
+	// (GET /games/coop)
+	GetGamesCoop(w http.ResponseWriter, r *http.Request, params GetGamesCoopParams)
+	// Retrieve Game
+	// (GET /games/{id})
+	GetGamesId(w http.ResponseWriter, r *http.Request, id int32)
+	// Retrieve achievement rarity, unlock order and completionists for a game
+	// (GET /games/{id}/achievement-stats)
+	GetGamesIdAchievementStats(w http.ResponseWriter, r *http.Request, id int32)
+	// List game build and manifest history
+	// (GET /games/{id}/depots)
+	GetGamesIdDepots(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdDepotsParams)
+	// List game inventory items
+	// (GET /games/{id}/items)
+	GetGamesIdItems(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdItemsParams)
+	// Retrieve game inventory item
+	// (GET /games/{id}/items/{item})
+	GetGamesIdItemsItem(w http.ResponseWriter, r *http.Request, id int32, item int32)
+	// List game PICS key changes
+	// (GET /games/{id}/pics-history)
+	GetGamesIdPicsHistory(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdPicsHistoryParams)
+	// Retrieve game price statistics and deal score
+	// (GET /games/{id}/price-stats)
+	GetGamesIdPriceStats(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdPriceStatsParams)
+	// List game price history
+	// (GET /games/{id}/prices)
+	GetGamesIdPrices(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdPricesParams)
+	// List game prices in every region, cheapest first
+	// (GET /games/{id}/regions)
+	GetGamesIdRegions(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdRegionsParams)
+	// List game review history
+	// (GET /games/{id}/reviews)
+	GetGamesIdReviews(w http.ResponseWriter, r *http.Request, id int32, params GetGamesIdReviewsParams)
+	// List games with similar owners
+	// (GET /games/{id}/similar)
+	GetGamesIdSimilar(w http.ResponseWriter, r *http.Request, id int32)
+	// List Groups
+	// (GET /groups)
+	GetGroups(w http.ResponseWriter, r *http.Request, params GetGroupsParams)
+	// List Packages
+	// (GET /packages)
+	GetPackages(w http.ResponseWriter, r *http.Request, params GetPackagesParams)
+	// List package PICS key changes
+	// (GET /packages/{id}/pics-history)
+	GetPackagesIdPicsHistory(w http.ResponseWriter, r *http.Request, id int32, params GetPackagesIdPicsHistoryParams)
+	// Retrieve package price statistics and deal score
+	// (GET /packages/{id}/price-stats)
+	GetPackagesIdPriceStats(w http.ResponseWriter, r *http.Request, id int32, params GetPackagesIdPriceStatsParams)
+	// List package price history
+	// (GET /packages/{id}/prices)
+	GetPackagesIdPrices(w http.ResponseWriter, r *http.Request, id int32, params GetPackagesIdPricesParams)
+	// List package prices in every region, cheapest first
+	// (GET /packages/{id}/regions)
+	GetPackagesIdRegions(w http.ResponseWriter, r *http.Request, id int32, params GetPackagesIdRegionsParams)
+	// List Players
+	// (GET /players)
+	GetPlayers(w http.ResponseWriter, r *http.Request, params GetPlayersParams)
+	// Retrieve Player
+	// (GET /players/{id})
+	GetPlayersId(w http.ResponseWriter, r *http.Request, id int64)
+	// Update Player
+	// (POST /players/{id})
+	PostPlayersId(w http.ResponseWriter, r *http.Request, id int64)
+	// List profile events for a player
+	// (GET /players/{id}/history)
+	GetPlayersIdHistory(w http.ResponseWriter, r *http.Request, id int64, params GetPlayersIdHistoryParams)
+	// List latest price changes
+	// (GET /prices)
+	GetPrices(w http.ResponseWriter, r *http.Request, params GetPricesParams)
+	// List current sales
+	// (GET /sales)
+	GetSales(w http.ResponseWriter, r *http.Request, params GetSalesParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+}
+
+type MiddlewareFunc func(http.HandlerFunc) http.HandlerFunc
+
+// GetArticles operation middleware
+func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
 	var err error
 
@@ -567,8 +1435,8 @@ func (siw *ServerInterfaceWrapper) GetArticles(w http.ResponseWriter, r *http.Re
 	handler(w, r.WithContext(ctx))
 }
 
-// GetGames operation middleware
-func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Request) {
+// GetBundles operation middleware
+func (siw *ServerInterfaceWrapper) GetBundles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -578,7 +1446,7 @@ func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Reque
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGamesParams
+	var params GetBundlesParams
 
 	// ------------- Optional query parameter "offset" -------------
 	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
@@ -613,6 +1481,17 @@ func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "sort" -------------
 	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
 
@@ -624,85 +1503,1673 @@ func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// ------------- Optional query parameter "ids" -------------
-	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+	// ------------- Optional query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
+
+	}
 
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter type: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBundles(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetBundlesId operation middleware
+func (siw *ServerInterfaceWrapper) GetBundlesId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBundlesIdParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "player_id" -------------
+	if paramValue := r.URL.Query().Get("player_id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "player_id", r.URL.Query(), &params.PlayerId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter player_id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBundlesId(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetChangesPicsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetChangesPicsHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesPicsHistoryParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "change_number" -------------
+	if paramValue := r.URL.Query().Get("change_number"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "change_number", r.URL.Query(), &params.ChangeNumber)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter change_number: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "field" -------------
+	if paramValue := r.URL.Query().Get("field"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter field: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "key" -------------
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter key: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangesPicsHistory(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
-		return
-	}
+	handler(w, r.WithContext(ctx))
+}
+
+// GetChangesStream operation middleware
+func (siw *ServerInterfaceWrapper) GetChangesStream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesStreamParams
+
+	// ------------- Optional query parameter "since" -------------
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter since: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "app_ids" -------------
+	if paramValue := r.URL.Query().Get("app_ids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "app_ids", r.URL.Query(), &params.AppIds)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter app_ids: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangesStream(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetChangesWs operation middleware
+func (siw *ServerInterfaceWrapper) GetChangesWs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChangesWsParams
+
+	// ------------- Optional query parameter "since" -------------
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter since: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "app_ids" -------------
+	if paramValue := r.URL.Query().Get("app_ids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "app_ids", r.URL.Query(), &params.AppIds)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter app_ids: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangesWs(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetDepotsId operation middleware
+func (siw *ServerInterfaceWrapper) GetDepotsId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDepotsIdParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDepotsId(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExchangeRates(w, r)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetExchangeRatesCurrency operation middleware
+func (siw *ServerInterfaceWrapper) GetExchangeRatesCurrency(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "currency" -------------
+	var currency string
+
+	err = runtime.BindStyledParameter("simple", false, "currency", chi.URLParam(r, "currency"), &currency)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter currency: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExchangeRatesCurrencyParams
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExchangeRatesCurrency(w, r, currency, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFranchises operation middleware
+func (siw *ServerInterfaceWrapper) GetFranchises(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFranchisesParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFranchises(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFranchisesId operation middleware
+func (siw *ServerInterfaceWrapper) GetFranchisesId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFranchisesIdParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFranchisesId(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGames operation middleware
+func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ids" -------------
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter tags: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "genres" -------------
+	if paramValue := r.URL.Query().Get("genres"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "genres", r.URL.Query(), &params.Genres)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter genres: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "categories" -------------
+	if paramValue := r.URL.Query().Get("categories"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "categories", r.URL.Query(), &params.Categories)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter categories: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "developers" -------------
+	if paramValue := r.URL.Query().Get("developers"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "developers", r.URL.Query(), &params.Developers)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter developers: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "publishers" -------------
+	if paramValue := r.URL.Query().Get("publishers"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "publishers", r.URL.Query(), &params.Publishers)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter publishers: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "platforms" -------------
+	if paramValue := r.URL.Query().Get("platforms"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "platforms", r.URL.Query(), &params.Platforms)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter platforms: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGames(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesCoop operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesCoop(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesCoopParams
+
+	// ------------- Required query parameter "players" -------------
+	if paramValue := r.URL.Query().Get("players"); paramValue != "" {
+
+	} else {
+		http.Error(w, "Query argument players is required, but not found", http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "players", r.URL.Query(), &params.Players)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter players: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesCoop(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesId operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesId(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesId(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdAchievementStats operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdAchievementStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdAchievementStats(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdDepots operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdDepots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdDepotsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "branch" -------------
+	if paramValue := r.URL.Query().Get("branch"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "branch", r.URL.Query(), &params.Branch)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter branch: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdDepots(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdItems operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdItemsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdItems(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdItemsItem operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdItemsItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "item" -------------
+	var item int32
+
+	err = runtime.BindStyledParameter("simple", false, "item", chi.URLParam(r, "item"), &item)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter item: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdItemsItem(w, r, id, item)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdPicsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdPicsHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdPicsHistoryParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "key" -------------
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter key: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdPicsHistory(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdPriceStats operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdPriceStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdPriceStatsParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdPriceStats(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdPrices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdPricesParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdPrices(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdRegions operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdRegions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdRegionsParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdRegions(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGamesIdReviewsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdReviews(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGamesIdSimilar operation middleware
+func (siw *ServerInterfaceWrapper) GetGamesIdSimilar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGamesIdSimilar(w, r, id)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupsParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ids" -------------
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGroups(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPackages operation middleware
+func (siw *ServerInterfaceWrapper) GetPackages(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ids" -------------
+	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "billingType" -------------
+	if paramValue := r.URL.Query().Get("billingType"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "billingType", r.URL.Query(), &params.BillingType)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter billingType: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "licenseType" -------------
+	if paramValue := r.URL.Query().Get("licenseType"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "licenseType", r.URL.Query(), &params.LicenseType)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter licenseType: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter status: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackages(w, r, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPackagesIdPicsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPackagesIdPicsHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesIdPicsHistoryParams
+
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "key" -------------
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter key: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackagesIdPicsHistory(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPackagesIdPriceStats operation middleware
+func (siw *ServerInterfaceWrapper) GetPackagesIdPriceStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesIdPriceStatsParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackagesIdPriceStats(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPackagesIdPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetPackagesIdPrices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesIdPricesParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackagesIdPrices(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPackagesIdRegions operation middleware
+func (siw *ServerInterfaceWrapper) GetPackagesIdRegions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPackagesIdRegionsParams
+
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var handler = func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPackagesIdRegions(w, r, id, params)
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetPlayers operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
+
+	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlayersParams
 
-	// ------------- Optional query parameter "tags" -------------
-	if paramValue := r.URL.Query().Get("tags"); paramValue != "" {
+	// ------------- Optional query parameter "offset" -------------
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter tags: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter offset: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "genres" -------------
-	if paramValue := r.URL.Query().Get("genres"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "genres", r.URL.Query(), &params.Genres)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter genres: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter limit: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "categories" -------------
-	if paramValue := r.URL.Query().Get("categories"); paramValue != "" {
+	// ------------- Optional query parameter "order" -------------
+	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "categories", r.URL.Query(), &params.Categories)
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter categories: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "developers" -------------
-	if paramValue := r.URL.Query().Get("developers"); paramValue != "" {
+	// ------------- Optional query parameter "sort" -------------
+	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "developers", r.URL.Query(), &params.Developers)
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter developers: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "publishers" -------------
-	if paramValue := r.URL.Query().Get("publishers"); paramValue != "" {
+	// ------------- Optional query parameter "continent" -------------
+	if paramValue := r.URL.Query().Get("continent"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "publishers", r.URL.Query(), &params.Publishers)
+	err = runtime.BindQueryParameter("form", true, false, "continent", r.URL.Query(), &params.Continent)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter publishers: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter continent: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "platforms" -------------
-	if paramValue := r.URL.Query().Get("platforms"); paramValue != "" {
+	// ------------- Optional query parameter "country" -------------
+	if paramValue := r.URL.Query().Get("country"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "platforms", r.URL.Query(), &params.Platforms)
+	err = runtime.BindQueryParameter("form", true, false, "country", r.URL.Query(), &params.Country)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter platforms: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter country: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGames(w, r, params)
+		siw.Handler.GetPlayers(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -712,14 +3179,14 @@ func (siw *ServerInterfaceWrapper) GetGames(w http.ResponseWriter, r *http.Reque
 	handler(w, r.WithContext(ctx))
 }
 
-// GetGamesId operation middleware
-func (siw *ServerInterfaceWrapper) GetGamesId(w http.ResponseWriter, r *http.Request) {
+// GetPlayersId operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id int32
+	var id int64
 
 	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
@@ -732,7 +3199,7 @@ func (siw *ServerInterfaceWrapper) GetGamesId(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGamesId(w, r, id)
+		siw.Handler.GetPlayersId(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -742,14 +3209,14 @@ func (siw *ServerInterfaceWrapper) GetGamesId(w http.ResponseWriter, r *http.Req
 	handler(w, r.WithContext(ctx))
 }
 
-// GetGamesIdSimilar operation middleware
-func (siw *ServerInterfaceWrapper) GetGamesIdSimilar(w http.ResponseWriter, r *http.Request) {
+// PostPlayersId operation middleware
+func (siw *ServerInterfaceWrapper) PostPlayersId(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id int32
+	var id int64
 
 	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
 	if err != nil {
@@ -762,7 +3229,7 @@ func (siw *ServerInterfaceWrapper) GetGamesIdSimilar(w http.ResponseWriter, r *h
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGamesIdSimilar(w, r, id)
+		siw.Handler.PostPlayersId(w, r, id)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -772,18 +3239,27 @@ func (siw *ServerInterfaceWrapper) GetGamesIdSimilar(w http.ResponseWriter, r *h
 	handler(w, r.WithContext(ctx))
 }
 
-// GetGroups operation middleware
-func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Request) {
+// GetPlayersIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPlayersIdHistory(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		return
+	}
+
 	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
 
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGroupsParams
+	var params GetPlayersIdHistoryParams
 
 	// ------------- Optional query parameter "offset" -------------
 	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
@@ -807,41 +3283,19 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Optional query parameter "order" -------------
-	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "sort" -------------
-	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
-
-	}
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "ids" -------------
-	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+	// ------------- Optional query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter type: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGroups(w, r, params)
+		siw.Handler.GetPlayersIdHistory(w, r, id, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -851,8 +3305,8 @@ func (siw *ServerInterfaceWrapper) GetGroups(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetPackages operation middleware
-func (siw *ServerInterfaceWrapper) GetPackages(w http.ResponseWriter, r *http.Request) {
+// GetPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetPrices(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -862,7 +3316,7 @@ func (siw *ServerInterfaceWrapper) GetPackages(w http.ResponseWriter, r *http.Re
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPackagesParams
+	var params GetPricesParams
 
 	// ------------- Optional query parameter "offset" -------------
 	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
@@ -886,74 +3340,74 @@ func (siw *ServerInterfaceWrapper) GetPackages(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Optional query parameter "order" -------------
-	if paramValue := r.URL.Query().Get("order"); paramValue != "" {
+	// ------------- Optional query parameter "cc" -------------
+	if paramValue := r.URL.Query().Get("cc"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	err = runtime.BindQueryParameter("form", true, false, "cc", r.URL.Query(), &params.Cc)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter order: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter cc: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
-	if paramValue := r.URL.Query().Get("sort"); paramValue != "" {
+	// ------------- Optional query parameter "type" -------------
+	if paramValue := r.URL.Query().Get("type"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter sort: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter type: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "ids" -------------
-	if paramValue := r.URL.Query().Get("ids"); paramValue != "" {
+	// ------------- Optional query parameter "percent_min" -------------
+	if paramValue := r.URL.Query().Get("percent_min"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "ids", r.URL.Query(), &params.Ids)
+	err = runtime.BindQueryParameter("form", true, false, "percent_min", r.URL.Query(), &params.PercentMin)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter ids: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter percent_min: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "billingType" -------------
-	if paramValue := r.URL.Query().Get("billingType"); paramValue != "" {
+	// ------------- Optional query parameter "percent_max" -------------
+	if paramValue := r.URL.Query().Get("percent_max"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "billingType", r.URL.Query(), &params.BillingType)
+	err = runtime.BindQueryParameter("form", true, false, "percent_max", r.URL.Query(), &params.PercentMax)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter billingType: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter percent_max: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "licenseType" -------------
-	if paramValue := r.URL.Query().Get("licenseType"); paramValue != "" {
+	// ------------- Optional query parameter "price_min" -------------
+	if paramValue := r.URL.Query().Get("price_min"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "licenseType", r.URL.Query(), &params.LicenseType)
+	err = runtime.BindQueryParameter("form", true, false, "price_min", r.URL.Query(), &params.PriceMin)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter licenseType: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter price_min: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+	// ------------- Optional query parameter "price_max" -------------
+	if paramValue := r.URL.Query().Get("price_max"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "price_max", r.URL.Query(), &params.PriceMax)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter status: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter price_max: %s", err), http.StatusBadRequest)
 		return
 	}
 
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPackages(w, r, params)
+		siw.Handler.GetPrices(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -963,8 +3417,8 @@ func (siw *ServerInterfaceWrapper) GetPackages(w http.ResponseWriter, r *http.Re
 	handler(w, r.WithContext(ctx))
 }
 
-// GetPlayers operation middleware
-func (siw *ServerInterfaceWrapper) GetPlayers(w http.ResponseWriter, r *http.Request) {
+// GetSales operation middleware
+func (siw *ServerInterfaceWrapper) GetSales(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -974,7 +3428,7 @@ func (siw *ServerInterfaceWrapper) GetPlayers(w http.ResponseWriter, r *http.Req
 	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPlayersParams
+	var params GetSalesParams
 
 	// ------------- Optional query parameter "offset" -------------
 	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
@@ -1020,90 +3474,63 @@ func (siw *ServerInterfaceWrapper) GetPlayers(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Optional query parameter "continent" -------------
-	if paramValue := r.URL.Query().Get("continent"); paramValue != "" {
+	// ------------- Optional query parameter "sale_type" -------------
+	if paramValue := r.URL.Query().Get("sale_type"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "continent", r.URL.Query(), &params.Continent)
+	err = runtime.BindQueryParameter("form", true, false, "sale_type", r.URL.Query(), &params.SaleType)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter continent: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter sale_type: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "country" -------------
-	if paramValue := r.URL.Query().Get("country"); paramValue != "" {
+	// ------------- Optional query parameter "app_type" -------------
+	if paramValue := r.URL.Query().Get("app_type"); paramValue != "" {
 
 	}
 
-	err = runtime.BindQueryParameter("form", true, false, "country", r.URL.Query(), &params.Country)
+	err = runtime.BindQueryParameter("form", true, false, "app_type", r.URL.Query(), &params.AppType)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter country: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter app_type: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPlayers(w, r, params)
-	}
+	// ------------- Optional query parameter "discount_min" -------------
+	if paramValue := r.URL.Query().Get("discount_min"); paramValue != "" {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
 	}
 
-	handler(w, r.WithContext(ctx))
-}
-
-// GetPlayersId operation middleware
-func (siw *ServerInterfaceWrapper) GetPlayersId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	err = runtime.BindQueryParameter("form", true, false, "discount_min", r.URL.Query(), &params.DiscountMin)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter discount_min: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
-
-	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
+	// ------------- Optional query parameter "discount_max" -------------
+	if paramValue := r.URL.Query().Get("discount_max"); paramValue != "" {
 
-	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPlayersId(w, r, id)
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "discount_max", r.URL.Query(), &params.DiscountMax)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid format for parameter discount_max: %s", err), http.StatusBadRequest)
+		return
 	}
 
-	handler(w, r.WithContext(ctx))
-}
-
-// PostPlayersId operation middleware
-func (siw *ServerInterfaceWrapper) PostPlayersId(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+	// ------------- Optional query parameter "app_ids" -------------
+	if paramValue := r.URL.Query().Get("app_ids"); paramValue != "" {
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	}
 
-	err = runtime.BindStyledParameter("simple", false, "id", chi.URLParam(r, "id"), &id)
+	err = runtime.BindQueryParameter("form", true, false, "app_ids", r.URL.Query(), &params.AppIds)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Invalid format for parameter id: %s", err), http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("Invalid format for parameter app_ids: %s", err), http.StatusBadRequest)
 		return
 	}
 
-	ctx = context.WithValue(ctx, KeyHeaderScopes, []string{""})
-
-	ctx = context.WithValue(ctx, KeyQueryScopes, []string{""})
-
 	var handler = func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPlayersId(w, r, id)
+		siw.Handler.GetSales(w, r, params)
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1153,12 +3580,72 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/articles", wrapper.GetArticles)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/bundles", wrapper.GetBundles)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/bundles/{id}", wrapper.GetBundlesId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/changes/pics-history", wrapper.GetChangesPicsHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/changes/stream", wrapper.GetChangesStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/changes/ws", wrapper.GetChangesWs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/depots/{id}", wrapper.GetDepotsId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/exchange-rates", wrapper.GetExchangeRates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/exchange-rates/{currency}", wrapper.GetExchangeRatesCurrency)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/franchises", wrapper.GetFranchises)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/franchises/{id}", wrapper.GetFranchisesId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/games", wrapper.GetGames)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/coop", wrapper.GetGamesCoop)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/games/{id}", wrapper.GetGamesId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/achievement-stats", wrapper.GetGamesIdAchievementStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/depots", wrapper.GetGamesIdDepots)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/items", wrapper.GetGamesIdItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/items/{item}", wrapper.GetGamesIdItemsItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/pics-history", wrapper.GetGamesIdPicsHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/price-stats", wrapper.GetGamesIdPriceStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/prices", wrapper.GetGamesIdPrices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/regions", wrapper.GetGamesIdRegions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/games/{id}/reviews", wrapper.GetGamesIdReviews)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/games/{id}/similar", wrapper.GetGamesIdSimilar)
 	})
@@ -1168,6 +3655,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/packages", wrapper.GetPackages)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packages/{id}/pics-history", wrapper.GetPackagesIdPicsHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packages/{id}/price-stats", wrapper.GetPackagesIdPriceStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packages/{id}/prices", wrapper.GetPackagesIdPrices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/packages/{id}/regions", wrapper.GetPackagesIdRegions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/players", wrapper.GetPlayers)
 	})
@@ -1177,6 +3676,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/players/{id}", wrapper.PostPlayersId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/players/{id}/history", wrapper.GetPlayersIdHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/prices", wrapper.GetPrices)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sales", wrapper.GetSales)
+	})
 
 	return r
 }
@@ -1184,45 +3692,107 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+wb227juPVXAraPcmxnkqLjt0GBTgfdomln+xQYBi0dy9xIlJakPDEG/veCF1EXkrYs",
-	"O17stG+WdO43HvLQ31Fc5GVBgQqOFt9RiRnOQQBTTxnJiZiod/KRULRAv1bA9ihCFOeAFhoERYjHW8ix",
-	"hEpgg6tMoMV8FqEcv5G8yuXDTD4Sah4jJPalJECogBQYOhwiVGw2HE4w1DB+jm0OMz8HlgDTDCYJ8DjI",
-	"RcL5mSCFFyGgks0LwupJvVxanlwwQlN0kDwZ8LKgHJRJMRMkzoBP6rfyZVxQAVSo72WZkRgLUtDpL7yg",
-	"8l1bCB4zUsqvaIF+IlzcFZu7miaKUMmKEpggXWZKSwG5+vFHBhu0QH+YNp6fag58ahAmhuPB6oMZw3v5",
-	"DIwVTJLpKRqhEqeEYi3acS4NpGWkzPRrRRgk0qYtWhFqqae5L5VZT9jiEKEU5zDOzl0zhnVOVbQc11ZJ",
-	"EdBT4R9T69OdAjGsrhs1Zcmju29EbO865j5H9+GR1bGCG1bvFjxayiGRoyGlYqyoyquaWlM8x7YaYbBx",
-	"JfhvYt1asQHm1aCHCOXAOU5HZuYx4WvCVnJHmH9oCG2T+BWn102pmuYZnrYoQ31tEH4Lb7fUOyOdygzv",
-	"gb1vHdY8TqqrJQmpqmkcr8YGyLLk76zXJa60Mg6Pra6B+qF1NDYMqyGhUcMeIsRJTjLMJr/z1a2txijz",
-	"nblMacUM17viG1XmPNT9arv5m4SiDpflisTamo766mMiP20KlmOh2+gPD8jtqiOEK7ENmNF4kXs/JlhA",
-	"n8WfHr0sNgCJl4b8sMrwGrLwZ/12kCpBg7jGCEgqiMjAS6JiPhl7UUESVNPQGNa8LWMa03W0NzZqq2zd",
-	"GDXONiou6/Y4FB0xFpAWjJyTBAKLI8tSAjvIJIurUUyBsuvJd8L1rqdzEDhmRJB4xeOCDQ0xavYMgUWM",
-	"r3L85mdYA3wDeD0CxUhsMjxJiKweOHvu+PboEsCKpIrFRFFxrVWsf4FYKDbVOiN8e0V3MsgAc1idURYY",
-	"7Ah84ysKKRZkB36L1FBlwclpKNeXSVGtVT4aJFrla5PsOL2S+r4yQPXu0OSt4mWDPmpnaCe5Oq6x4dAN",
-	"L08s9V7hXYp6LvEY0uOBviE9ebKsdzjhtWm9llRs53PZ+hRe5beAk4xQfz4OKwjN6xxkWPCBQhnoFaGr",
-	"eIvF+Vj12cMZWAWtlb2oTjGS43plGEBIMKCJxG2INRk0fFE02dAJDbtE1uucdWiTNjX3xkGu8V3DOkar",
-	"w6it/7K1mz2/uTeYp9WvAZv20O7FjnV33co0xFG9gixprOKiokOjc02yjNDUNlyOyuuKJro7ukSueItp",
-	"CsPz38DroDtneYmLXKrDi04hWBdFBpjqnqYsxIokF5v6jL4z1LnmOIVVVqSFn476XPrjLUIZiYFyCHvu",
-	"WNcipHhdEzhgziHFbbqUfk/hyNUGWFWUvA0MDbmYV/x07qo87KSSzYJevrTj2huz3XhsB58tdgq1FQkd",
-	"v/e8bCtq48FWt+Cs/H0zWRMsO+cUwYKkB0XDjGtmPMOApW78LxVjQM9C+bkQOBu6qRsM23O/nVbVczJN",
-	"qSNCT4Vlc2YWLO47LLB/UVnjxJwm+soZFYQaM3m26hUVbH/8NMQl2hxVe2qSv1HKZLPqxzi6ORIkD/Tu",
-	"MhT9eDtMidivzm8ztImtQZsjGnvmrdVoidbYsG3rWrqOLMrJviLmbsRVWMR+vySEK5bPwGIYvExvCHWj",
-	"OQTLAPxrH6EJ2ZGkGkyKUCLIQOieZ6wRGiq1Gq4ROqIZDZa9s8bjp2JJKH+qTu60K5aaF/s/6dM5zzd3",
-	"qTC136wT9RDaUFiaQA/KHpI7kFTB6F+qk0SIK0bE/qtkpum/wv5vgI2iamq+1Y92bP4K+8aZuCR/B7XY",
-	"v8L+X2q+Hpi2e9EOKmQ2hXvGuxWi5IvpFJfkPs2KNc64AJzf205dAMv5Pzdfge1IDAZjMZ1mRYyzbcHF",
-	"4uPsz/OpArPHbQv0WdG6+yqJ3X16/iJTFhjXTOf3s/sZitDbRPdYAZqYcxB8SvJ0yvFknU7mHx/e5h8f",
-	"7kudsUUJFJcELdAHQ7DEYqvMO22P61O9/kn3qnX1SyIFBPGpNetv3dV48fdLDci0c7fiEJ2Eb9/9GADu",
-	"XKyQOD5f84J1b284UenH031Og3ZOt53jty8afD6buScvfoY6HW/M1Jzhhq2z7N0neZjNQs2yhZu6l04O",
-	"EXq8AHM+GvNxJObTSGllJavyHMvio2corQzSx3cvyL5SlW9qu51QEn42rcD/M/Bdk8GceF7OcShDe7Z6",
-	"O5adU9zbse2cF9+Obedk+oZsWztcD1f3ZM7y+OCwGFWAe+PtodXXhzYfh/Y4Bu1pjJBuxa3LZV1u9XOr",
-	"1k6/k+RwsuB+SdySq7wtO6h2mULtxlawCtped0Ls6J3U0d4+39nOtaih7vYjPo5DfBonasfp/wbBCOxA",
-	"Od7xe4T+qndkXffXdygGhMFXA/n7iIbAFZehYXEMfX4Z+uMl6E+XCO/WiPC1lkDdsMdOwWCxly5/jC6t",
-	"uYCuYrq+fq4emslWa9zVmlNFV+3xAoekR1fmcYW0dxN38Lrpw5uPxHschfc0Sk7P2llHsU0C/UJnQfv2",
-	"aCgPnluXUn/8TOgOWrzzle5MpTcTqYca0a13RUN7WaPTz0bcm/E1dro5X+OQd2E5qii5F8eHlqUA5nw0",
-	"5uNIzKeR0rrlqVVc6gJlX5kS1dxBDlYoe3f4f6BA1VMjZ66kRkjD605nwOSmRo7ffgKaii1aPETn7XCD",
-	"DOvZ1nXZjUvC/u37wTnoRZyPRXwch/g0TlRP/tnUseln3nSy7+QG22Bdf4utesXrb6r6fyoJbECf6/90",
-	"9K1jN6ERKgvusclzwd/bKL6UudAsVz1DuMZRwH9K2WaF/aD/6VDPGpVpW1PGl6UsRs308GUpzcKB7Wo/",
-	"qLn+qYGgJNIA9md2D1MkqRrJvtfu/Gz+v2RfPNt/rdhXn5o/mzZg9fLXeve5/u+bfaOi77A8/DcAAP//",
-	"5jiQCIA9AAA=",
+	"H4sIAAAAAAAC/+xdX3PjNpL/KizePdKWNZG3Ln7LJZtk7nKbuXi39iGloiESkrCmQAaAbKum/N238I8E",
+	"SIACScl2ZvwyY0looNH4dQNoNBqf46zcVSWGmNH45nNcAQJ2kEEiPmXZhfiC/41wfBP/sYfkECcxBjsY",
+	"38RZFicxzbZwB3iRHK7BvmDxTbyncRLvwNMvEG/YNr75kMTsUHESygjCm/j5OYkLtEOsvwFRxN3G/Eq0",
+	"gHb7Hf9wxT8irD7WzSHM4AYS0V65XlN4pEFZxt2i2cKVuwWSQyIbuMghzbyt8HIe0Qm6JIaYN/N7DMQn",
+	"8eWyK8TnJCaQViWmUAwYyLYIPsAdxOyCMsDohf5ZDGeJGcRMFKyqAmWAoRLP/kVLzL9ruKlIWUHCkKwU",
+	"ElIS/ker9SQWTfBf/pPAdXwT/8esQdNMVkdnXZ5UQ5L7P/aIwJx3VdaWqPaWone84wRVnM/4Jv6uqSoC",
+	"GBQHhjIarUsSgWjDRfucxIAwlBVwZM/t9n5BlEXlOtJ1xklLNPUPfKAZ3B0XhiSoRVAPKSAEHOJn3XuX",
+	"tCuwQRhI1vpbaUr6ZG3U1YisV/YdWTwn8WqP8wKeAmOypmP9Uu01wvML6wEU+9D6RFmfpBRnusZeeEay",
+	"cARwHiFGI0ESgQ1AmLKIbWGEcI4eUL4HRVSB7B5sYFQRlJnipKeTZzguO5J9A7DUfQhBpS77nMTZFuAN",
+	"pBeUEQh2PmEy+MRm8EEaJV7QlmZfN2QDTRccOJBVcsYUN0lUFjmkLFojQplgsyyrC260zmyjRRPBOKi5",
+	"8kKhNWKy+r4h+omXiFgZVQU4RKzcQLaFJIlWljhyWJXsYosoK8nhvBJRjQTLxGbNryJnUwTNcJgioCIX",
+	"BmgHMFpzGSsICi1+UuAlgJ0beKKJYCFbnIWCTzYRIhZdfSRIkqiCJPrHbZSXRQEIb2dNAM62iMLzCqVu",
+	"5pg8Gn48CGlq6p+SmnJm8/SFuhkOgG6HX1DDDH5D0GQUVxb2/Db8WI8to+0w0kdgopfPE2Ykz3qxqmgS",
+	"PSK2jSyRn2f+6p26zgig49OglocsyTtGyn11UlHLGofIVhIEC5cXfxXp6o4FiFcWfU5El86rlryFYz0V",
+	"XHj6KOh71RJHCPM1akkOkSitWqXn71g4LKwuviAqJHMhoLCFKNCxg5SCzUi73dcJXbF/e/B/soSUjdgD",
+	"ntQK6DoH2IGaJHTIFcFrjLrRvQHGtkIZfZu7C4uzN765+PTx+9voHh5oxLaARY+QwAjkOcyTiMBd+QDz",
+	"qCTRrszRGsFcsF2AAyQvI3mxmx+AYZu1VxC9YrhP8p9IuUYFjGRR5eqUnCcRho/WHlr16KxClm0EytYn",
+	"BFlH/4JUFaqbPPOUN2mQax6Hgi9wn2sbQNVUiMLqspxDgjJ4oZ1jb1qW0iEaLEqjY+MEKtsLkicvajpU",
+	"ZONv5ajF5Gb0IcvPwiCiDBRRUT5S4UsCUQ5BEdGsJFAYIe7LzvaEQMykUGLRzoaPpPh8ZnHIpsJBYrIW",
+	"7F1SbfTbZ97XCGFuockhkjRJlJX4ARIG8whhVkYl1uLKDkmUbSGoLMtN4AOCj29zgdLi7Y0uUX4AqDhE",
+	"kteIlQwUtDtBUlC8cdsnOAweGl56lMmTzYRYPFmSc4Z2qADk4k/uIDK7MUp0Az09smOq1ah8xGI6ftZn",
+	"/55Tex/uQFWlKOd/rUuyA0zGH3zzIe6GIyQisqOAnClEWVpBkqlRqmnzcr8qYEOM97uVg5YGNrgFJIeU",
+	"WWPRGa621djBHAGcNg2mFGYlzmlKEc5gKpQ3NUTURdKPpNyJGUmU5UdN/EMBKIsMuiS6ZfxQLi8hjXDJ",
+	"IgKzkuTR4xZiFT8QPQKqKhErpzxOrH7/ZeHst7H4C5ASAQQx2/zag5yVe8wCK8PKM9yNrjEBjKX/V1a8",
+	"dAwCBVz66bCO7HFRZvepDGYZNOb7KgcM5inodNMp4VZvlBKYK+EWXH3Y73R0DPzqEWwA35KF1cFlE5PS",
+	"r9aZNJcd4Q3SebBnW4+dVGbaPUKc3aCxSOI1hLmzDv5DWoAVLPw/y2+DuuIVCMoDOWWIFdBZxZ4Ux7VG",
+	"YEzWISlq8RrCVKKzei8/xGaXkwa19WCrLi6bQJoefNgzXID42jqXEThE55I4R3SIJdqgNQMrS96rsiwg",
+	"wO4x8436DmzcY1YgfO/8wWMDPW7NEaKTG5wwWlE2bcJ8BpHxxVYgAQUPCG+cs/qxjn2ugwszAeCKOUIL",
+	"pxppAXU17ygNqBGSSEBbvtwabVrclkwccu2IQGNHIcVCfMciuyK/uhNx5jGkYq6AKdvClMJQ4ev9n9um",
+	"DQaMge0BxVO+As2HgHIQHs8H3ha8RORxLdEGMw6AuJDSGUILiy1hLeugsrdloINt6mnsoEvBLRVrK/XS",
+	"jHLzK1lZVikDGzpsCTlgdeDp3Q5RqiDrFIpvUdHev8hq0iHK4p2x1P5wIksVYFv3hDiARwK5rqQ7hPcs",
+	"2MxI/wtNhaMwcKsZXrZnilELKtFzXWUtzmasEwNunS622W+sij3Gy064otcsrBkk0xf1K7hWIurUsxKR",
+	"SM6fpMlKlfAC56jBi0Quh/CeyOJe+DO0g6maqQdhv1nSiPhHPmQq+tF1a8KznbQF1jJuag2j5G103OpU",
+	"PVaJGvtWn5btGEy/WRw6Er3LCwLYKBUzZlgitzkGX0srbLLfZTV5dtQzbBDIHmDBWZjc6JFJJnwn0tj1",
+	"EHeS13xLr0UKioKjKnT2V1SPEN6fYeFX7VcFotsTSFtbXww3gKEHOHDOqUqKRpCdZPpR846ahdRCSCPf",
+	"kpEFz9bgdEfYmMI63XQIzDeDLXWUqtfaAAY3JUFDHOkMsJ7DII8STqhxAzE5HX9hqm0uFyEDGUEMZQ7I",
+	"jFjq6aHegSd3gyY0ekrVh+UgzxFDJQbFJ2ts+w+My3yfMd+5aLn6F8xYj5ZPGAACCwgoTAd4Hl0GIswe",
+	"nGal2tmljO7+8ZWsWqIq0CemhrZNiGlc6lgGE14OLLW+Ag+buDUkYy1OR0+WOtDYv0RYrXgt9anptNVy",
+	"z8k3BHmBsFsfh871O8hhETrXqdIpwmm2BWw4lb4CMICqxLqzk+wUQTugZ4aAihiBOFf76o4GhfvdlTZY",
+	"0Ki98HrFXg9ooza69WaAusLvCrYjNA0js//LJqg8QxW8QLjaMy+oeck0h+tw4P6xB5ipo8EQQYNNgCwN",
+	"LiSJ0U67Q96u8I4OjAd3yeioGZTt1Gyd6hScB6Syg/tgwjpJHmQWBo/vDpB7yFLvOYb83X+G0qeiR9YA",
+	"4avxZsr/Yw8KW2xNi3J0x0HCvyQY7gVkBOR+gclvPofu/21dUQbIxEftBdCiqafpmg9rFE3w1RbKRIEx",
+	"X2uJLo27CcOjjxTl8U7rgk0cS+24PauTm9eRDjlWXKGi4K43z1gmRuaAKXwp30+4VTF9RUPWsFm5492h",
+	"ZYndoNWOpXM7L0LPYtOi3JTuesTPlf+8NoOYQv/I9W2NGGdvoDF4oa1Qe+PiMI5NgXSP0VMgNCgDbE+D",
+	"DBaNLVVKmiwVlr6YuHZi1sajCb7aXglSAwnWuLdGufGH1CNombjW9qItploESyuQ0muQZHagMOGqxD6h",
+	"HrgNpN/L8OYhJH/ncaeBBCy4bGv46xRFOjmSrMliodWFZfsGlNfEZ3ohVOcf4td7xGiJ+z1xEtfXe1xB",
+	"A+c/8XiRY401gkVuikFM5Djm3nsGsRRJVuI12vBdyZpq3eF/FGAvzwjUhOoU1D089MWshIrKGxrXVHPk",
+	"YEN2VTKUaAB0zjGW3btcww+9+kZ18OE3X6yhfEhp73wjfvV63XmmmJ7555i1VoZR1NKwbbJkMuA4QGqd",
+	"uNg3h7rSfwAMeMQPchUE4FqWYIawMnfd0eGzDDn0h193K22ul/uCF7qLBu7ZclP0elIZsn5szarQkyIK",
+	"I3ZIh/skpIhrgTYx4fU9ddkNg7VGhqasNXcWL8vWZa0TbYOz0+hd7zFjjtZryH8O9To1BAPj072L22PB",
+	"gOESkxFntTULpmis3Gmst+0XdgY+pbXRMJm2xsMp665x6V4g68KuKFJxqF2Uj4GCMUnCoQYeIOFScAai",
+	"9lxd8CC9D7jyRzYoiIffiEsJYLav01AG/vuQoyLdzzQDuRimHBxoYKcb2gF7axX/Ej6OBG72BSADxHQk",
+	"bM+We7sBGzhxF0dWFzpCcCDIJ2RrtOyhlWrh2Bt2D1H7TaNs99OggN01wsERoWsCfcHXQ4NLEUYMgWLU",
+	"CDeDq2vR3egKoRWvKXqwbF0kHRydGzZBDZxvMC9SIBocO9sQpL0cDQrJA/h+zJgIusQbM2v0zc23U27L",
+	"7rVV30gNcI91TJ7uYl9uX77lwpu9DnF1XA4Dkbz4w6/lybthDFKmrouK67ugKKK6lkTcD5O3SONk0DVZ",
+	"XUePm3tgfAuGj+kIkoHhMKOiZ9JVuVu5bc7osBrlHTIOnY3DZm2grQ62RKRAZPNoQsRAbnu0ui4mVcC9",
+	"1hw2KoNE3JJKzYdbMkt1dfglbphVlX8nzX9slkQBdpUTeB3ERcmvb6fnOd46T618EFKIQ/0SungKKUM7",
+	"e6NqKhMv5hW6+HXYRSBBQhkgbAij3oGi+9VUh5WqwsCXdVOuxomFMcO5bGPFZNgUX0tYliCMwXMNzLJ1",
+	"Bz5gW+5ys+wxc/9UX6N1/FRHj4ZKU6929X1UVcNS+UO8vPv4Drtm3GxXl/wnCrM9QexwyxuT9d/Dw88Q",
+	"qI4iHN+IEA5INN2NckPqKbNC/wsPyl36/yKHviejvpPsWSxl12V3UbBlrKI3sxmo0OWmKFegoAyC3WUd",
+	"/cEg2dFf17eQPIj1maC4mc2KMgPFtqTs5tur/5rPRLH6luhN/JOoS100/+7TR+7ZgYTKRueXV5dXcRI/",
+	"XcgjNU+dgFLI6AztNjMKLlabi/m3H57m3364rFQscQUxqFB8E3+jKuSeQyHemZmPfiOPO/jwimOUjzln",
+	"ELLvjGT2xmsPv7uXN02RmfV+wnNytLz5vkNA8c7jCc+Je6xpSewXGjqodNPJY62GbMjh6g48fZTF51dX",
+	"3TAWd4NNAPALNqquHvuls2y9GfHh6sq3tq3LzbqvKjwn8WIC5Xw05WIk5fVIbsX8ttsBctC5PQwNkiEr",
+	"v8f1V8LyzYz0+z41/G9V5M+ghUdo6mdigjW2ee5EzBn6lM0KvOleCJbXOGkTYW96L5NQM6AXBTUzAbeh",
+	"xylN5z2HUJ1xE87HEi7GEV6PY7WrLw3UtbrobyxtmX1G+bOhMq1MW4DSOvdh9PEHnuZlA8WrGohE+kIv",
+	"/xhRqJKSqbRC4hpvnRc1ao6yfUr5Me+qpYCSOqOrJ5TYXAAxsocmrgb5ME6hZlI2aj3tZOMvi2NsTAD6",
+	"WJyPhflYlI8FuQ/jv0FGEHyA+vUX+9UX/4svXXVIVD45pRcqz6CVItarH3+DMOcKcmed9t9FImPo3T08",
+	"3F1GP4gQBZHRTwYpRKUgpxEgMFoXgDHIVYWVMstrge5hdLdYzC/1dUp6Ka4bZHeikrurS/gEs70Id7y7",
+	"dGnU97IPn1BGf67TuL3sjOdSlXZIxAStdS7CVFCFY5I5bSDJc9K3HzrxItCdRDlU2Xuo55OoFxOorydw",
+	"bhmBWwhItq0zJNc5QhslV5rQ0u3m8SGnVvMNKCQXtxCz6K8iB3AikkkqLb+TiYHFcybym0hC+jL6DdL9",
+	"DsoJ8E7kshK2gM+Pd78Ayi5EdRcff7iL5B68T31vJZfuSbG92EPyvDlEpa7CVeq8m6lR+uB7aSpUIzo5",
+	"6EOVwUn44dtxhNfjWLXRL9+7EugPRf4j9aL+Vwyj/7n99W8a0xzeigMnsPug+0/6FcF2HoKed9j2wDYq",
+	"HyBfMf0Trm7L7B4yL47lNN3etHRgKNdcb3NHMWllNcpkep55C4VeH/l8GvliCvn1FOa7G2ZRsnnArcl/",
+	"rJEoQaWAaD/q1ofFv6qSvwGd72bo+PkekAsdwF76+UT6xST660n8dwdRxRjYL88ZY2ht9ex6Z5917MVz",
+	"8HB+bwR2HLUzZl4Xr7Ux3m/+Rpga45NjG3J22/GOvWDsWaDT9kO9HmKMvROK9tt9Puz92JT6Ys6RGq+0",
+	"8caG2rc3R9LUyjxc52dRyQ7GqEaWTdIL16OOoTrhpZ1PoF2Mpr0ezXNXByyEaqQbX7bRfnQl19CeZjVn",
+	"WNT5a8JmAmomgGYCZiZA5qgP90fjWVUvaOo7Lz6oiDeP3w/Yz33WrW7fT28xtME6Hc/LNWkl/nm5Zq0U",
+	"Qy/XrJXM6AWbNe4rO1p1pOrQbXxzGldi61WVUEPsIpuPI1uMIbsew2R3qtbmUhtc+dmwteJd+qMG93te",
+	"KMjZ16zf/NPzkAy8NuZ2COtPLgCe1qpPXAXUCZpHoM9LO59AuxhNez2a5y4iRQkVOLA6iNsB4mVL9WSR",
+	"fGbYeGEvEZsr/gHhTcTKDWRbSDqQTuJPsrwF7mMLT0H7Gh7E0abstZ3KV4txhKdwKtdLyZ/U6wctBPwo",
+	"bznZwz/rvAEVAIjvGppbQfLnAEinqyOCCo9UMZ9exWJqFddTO+JGlUERyYeIkki+PhSJBb4IzLDfQ1KO",
+	"n40Ljx0gquiD4+hTPugv7VjDs0KsE3+fOKbi/UAk5EBEPM4mUrsLePecjGgra52QGPCuV3VH0C0XcO9n",
+	"dlAm7xtho11k83FkizFk12OY9EAP4QeIhQ8dKVy08Sbx4obb7DP/7zkUdfyfV0GeqwnJy6uuF1TyyBHw",
+	"G4e+ceAbh72j070DfuHo8wSO+tDXG6T5ZU7r73GSLxwn2djUnlBJDWs74MYEdpOnJgTXvPQr7ZDO7sBx",
+	"vkAfjDUv8XwK8WI88fV4tnsMqCCIOAGiDGUyBL550t7lpzGPxNvICwbdlws4HUQ4EnJu8vk08sUU8usp",
+	"zHtMnISdf5PiA5nMfxOCst9UyS8PZmYOoBEw6yOfTyNfTCG/nsJ8H8xEFhv4AMkhkuRJlG0hqPg2WTxi",
+	"PQh/Ii9OEP5kyfeNMqxTAI1e+fXSzyfSLybRX0/i34NbWd5rHzuwVOk3AmB5q0r+ORziZlqREaauj3w+",
+	"jXwxhfx6CvO+Izlx7UMRRXXwnRM4dfZZL1hkiS8wfrF7q755Dcd4Isd42yY5aZBP0DH5SeImxBCOCJxw",
+	"0c1H0i1G0V2P4tMRPKFRXCuB/EJqgfn2s08PPplPX3/xmmC/m+B8LsF+IqH1xIF+oyB56bC40GAm1ae/",
+	"t/NcnLtdJacXb1cNyFmaHLdDVvo0YnPsppyPplyMpLweyW3XPBnGpb5u0LwQb5qoYT5rXcm72/rdbf1C",
+	"bmudv6PHc22g3XZet0Ae5r82MP7uwv56XdhW3pggL7YBQ8vH40DhEAC+u7O/Vne2DcGuxyYMcAF+7QZx",
+	"767tr9u1bUFuiHfbi0UVet2HPlXkq9gI60eKOs8YMbQbkmvKes+ouwUzrpF/SIZdpfE2qJ9SOm1z4+YP",
+	"CZkRM4eTcD6WcDGO8Hocq459Xq06tR5aVx1UHUcvOyiq0193OFPuQ9mvo2s42S2HdOoLASKzv0Mmn0p6",
+	"bqG4VGaiWE56n+MU1zL+UeWA9YxDF6WzEC+EHpgzOSCOg/Z1HBDeJLrWpKLmFPnMrMjPaiT4fQBZugJY",
+	"lVF/rgmCOE/1q6Pqo87YeqrEvK2XLEea7gneDj/99aT2HasoUq5RAWWeQH0TpDqiBMf3hZ7d4Osuk44m",
+	"yPWiFlTVqDSb6iGJdIewyx/XPHFzhBw8jSIXj/212z5J2jpVNXiaUPX7dvxs23GVW0ruxh1eSHPbQ8GR",
+	"TPS34DXy0LtQp58rce1fAM2MDYz8JPZC4draszuCODez/aiPRg76+sUZ6xXzAW2b79EMyjAwH/TexJmb",
+	"qJ9PPG51wJNatFxdjbNBTVvg6dxtvcHsskJvR0QDgVEvB7jIFmPIrscw2bVw6mXQiILCY9qMF4aEvTLe",
+	"Fvp9yce5eTPo9yUfAipyKUvjJh59PvYM0HNiFmy/1PNhJtx/irHPGkkyBOk5qb/Qayvjq/q1ELOY9loZ",
+	"36lIDrOU7L7xjT5mMr5Sdx+Nb4wMRsa3ErHGFzoPvUUJYfy8fP73AL6tM8UpygAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1296,4 +3866,3 @@ func GetSwagger() (swagger *openapi3.T, err error) {
 	}
 	return
 }
-
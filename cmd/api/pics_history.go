@@ -0,0 +1,121 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetGamesIdPicsHistory(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdPicsHistoryParams) {
+
+	_, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.PicsHistoryResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PicsHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	filter := bson.D{{Key: "app_id", Value: int(id)}}
+
+	if params.Key != nil && *params.Key != "" {
+		filter = append(filter, bson.E{Key: "key", Value: *params.Key})
+	}
+
+	returnPICSHistory(w, r, params.Offset, params.Limit, filter)
+}
+
+func (s Server) GetPackagesIdPicsHistory(w http.ResponseWriter, r *http.Request, id int32, params generated.GetPackagesIdPicsHistoryParams) {
+
+	_, err := mongo.GetPackage(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.PicsHistoryResponse{Error: "package not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PicsHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	filter := bson.D{{Key: "package_id", Value: int(id)}}
+
+	if params.Key != nil && *params.Key != "" {
+		filter = append(filter, bson.E{Key: "key", Value: *params.Key})
+	}
+
+	returnPICSHistory(w, r, params.Offset, params.Limit, filter)
+}
+
+func (s Server) GetChangesPicsHistory(w http.ResponseWriter, r *http.Request, params generated.GetChangesPicsHistoryParams) {
+
+	filter := bson.D{}
+
+	if params.ChangeNumber != nil {
+		filter = append(filter, bson.E{Key: "change_number", Value: int(*params.ChangeNumber)})
+	}
+
+	if params.Key != nil && *params.Key != "" {
+		filter = append(filter, bson.E{Key: "key", Value: *params.Key})
+	}
+
+	// The whole collection is too big to page through
+	if len(filter) == 0 {
+		returnResponse(w, r, http.StatusBadRequest, generated.PicsHistoryResponse{Error: "change_number or key is required"})
+		return
+	}
+
+	if params.Field != nil {
+		filter = append(filter, bson.E{Key: "field", Value: string(*params.Field)})
+	}
+
+	returnPICSHistory(w, r, params.Offset, params.Limit, filter)
+}
+
+func returnPICSHistory(w http.ResponseWriter, r *http.Request, offsetParam *generated.OffsetParam, limitParam *generated.LimitParam, filter bson.D) {
+
+	var limit int64 = 10
+	if limitParam != nil && *limitParam >= 1 && *limitParam <= 1000 {
+		limit = int64(*limitParam)
+	}
+
+	var offset int64 = 0
+	if offsetParam != nil {
+		offset = int64(*offsetParam)
+	}
+
+	histories, err := mongo.GetProductPICSHistories(offset, limit, filter)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PicsHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionProductPICSHistory, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.PicsHistoryResponse{History: []generated.PicsHistorySchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, v := range histories {
+		result.History = append(result.History, generated.PicsHistorySchema{
+			AppId:        int32(v.AppID),
+			PackageId:    int32(v.PackageID),
+			ChangeNumber: int32(v.ChangeNumber),
+			CreatedAt:    v.CreatedAt.Unix(),
+			Field:        generated.PicsHistorySchemaField(v.Field),
+			Key:          v.Key,
+			Action:       generated.PicsHistorySchemaAction(v.Action),
+			Before:       v.Before,
+			After:        v.After,
+		})
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func (s Server) GetPlayersIdHistory(w http.ResponseWriter, r *http.Request, id int64, params generated.GetPlayersIdHistoryParams) {
+
+	id, err := helpers.IsValidPlayerID(id)
+	if err != nil {
+		returnResponse(w, r, http.StatusBadRequest, generated.PlayerHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	var eventType mongo.PlayerHistoryType
+	if params.Type != nil {
+		eventType = mongo.PlayerHistoryType(*params.Type)
+		if !eventType.IsValid() {
+			returnResponse(w, r, http.StatusBadRequest, generated.PlayerHistoryResponse{Error: "invalid type"})
+			return
+		}
+	}
+
+	events, err := mongo.GetPlayerHistory(id, eventType, offset, limit)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PlayerHistoryResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountPlayerHistory(id, eventType)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.PlayerHistoryResponse{}
+	result.Pagination.Fill(offset, limit, total)
+	result.Events = []generated.PlayerHistorySchema{}
+
+	for _, event := range events {
+
+		schema := generated.PlayerHistorySchema{
+			Type:      string(event.Type),
+			Text:      event.GetText(),
+			ItemId:    event.ItemID,
+			ItemName:  event.ItemName,
+			Before:    event.Before,
+			After:     event.After,
+			CreatedAt: event.CreatedAt.Unix(),
+		}
+
+		if event.ItemPath != "" {
+			schema.ItemPath = config.C.GlobalSteamDomain + event.ItemPath
+		}
+
+		result.Events = append(result.Events, schema)
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func (s Server) GetGamesIdPriceStats(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdPriceStatsParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.PriceStatsResponse{Error: "invalid cc"})
+		return
+	}
+
+	returnPriceStats(w, r, helpers.ProductTypeApp, int(id), cc)
+}
+
+func (s Server) GetPackagesIdPriceStats(w http.ResponseWriter, r *http.Request, id int32, params generated.GetPackagesIdPriceStatsParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.PriceStatsResponse{Error: "invalid cc"})
+		return
+	}
+
+	returnPriceStats(w, r, helpers.ProductTypePackage, int(id), cc)
+}
+
+func returnPriceStats(w http.ResponseWriter, r *http.Request, productType helpers.ProductType, id int, cc steamapi.ProductCC) {
+
+	stats, err := mongo.GetProductPriceStats(productType, id, cc)
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.PriceStatsResponse{Error: "no price history"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PriceStatsResponse{Error: err.Error()})
+		return
+	}
+
+	returnResponse(w, r, http.StatusOK, generated.PriceStatsResponse{Stats: generated.PriceStatsSchema{
+		Cc:                  string(stats.ProdCC),
+		Currency:            string(stats.Currency),
+		CurrentPrice:        int32(stats.CurrentPrice),
+		RegularPrice:        int32(stats.RegularPrice),
+		AllTimeLow:          int32(stats.AllTimeLow),
+		AllTimeLowAt:        stats.AllTimeLowAt.Unix(),
+		RecentLow:           int32(stats.GetRecentLow()),
+		DiscountCount:       int32(stats.DiscountCount),
+		AverageDiscount:     stats.GetAverageDiscount(),
+		DiscountCadenceDays: stats.GetDiscountCadence(),
+		DealScore:           int32(stats.DealScore(stats.CurrentPrice)),
+		DealRating:          stats.DealRating(stats.CurrentPrice),
+	}})
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/api/generated"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (s Server) GetGamesIdPrices(w http.ResponseWriter, r *http.Request, id int32, params generated.GetGamesIdPricesParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.PriceChangesResponse{Error: "invalid cc"})
+		return
+	}
+
+	_, err := mongo.GetApp(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.PriceChangesResponse{Error: "app not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PriceChangesResponse{Error: err.Error()})
+		return
+	}
+
+	returnPriceHistory(w, r, int(id), helpers.ProductTypeApp, cc)
+}
+
+func (s Server) GetPackagesIdPrices(w http.ResponseWriter, r *http.Request, id int32, params generated.GetPackagesIdPricesParams) {
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.PriceChangesResponse{Error: "invalid cc"})
+		return
+	}
+
+	_, err := mongo.GetPackage(int(id))
+	if err == mongo.ErrNoDocuments {
+		returnResponse(w, r, http.StatusNotFound, generated.PriceChangesResponse{Error: "package not found"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PriceChangesResponse{Error: err.Error()})
+		return
+	}
+
+	returnPriceHistory(w, r, int(id), helpers.ProductTypePackage, cc)
+}
+
+func returnPriceHistory(w http.ResponseWriter, r *http.Request, id int, productType helpers.ProductType, cc steamapi.ProductCC) {
+
+	prices, err := mongo.GetPricesForProduct(id, productType, cc)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PriceChangesResponse{Error: err.Error()})
+		return
+	}
+
+	var count = int64(len(prices))
+
+	result := generated.PriceChangesResponse{Prices: []generated.PriceChangeSchema{}}
+	result.Pagination.Fill(0, int64(math.Max(float64(count), 1)), count)
+
+	for _, price := range prices {
+		result.Prices = append(result.Prices, priceChangeSchema(price))
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetPrices(w http.ResponseWriter, r *http.Request, params generated.GetPricesParams) {
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	cc, ok := getProdCCParam(params.Cc)
+	if !ok {
+		returnResponse(w, r, http.StatusBadRequest, generated.PriceChangesResponse{Error: "invalid cc"})
+		return
+	}
+
+	filter := bson.D{{Key: "prod_cc", Value: string(cc)}}
+
+	if params.Type != nil {
+		switch *params.Type {
+		case "app":
+			filter = append(filter, bson.E{Key: "app_id", Value: bson.M{"$gt": 0}})
+		case "package":
+			filter = append(filter, bson.E{Key: "package_id", Value: bson.M{"$gt": 0}})
+		}
+	}
+
+	if params.PercentMin != nil {
+		filter = append(filter, bson.E{Key: "difference_percent", Value: bson.M{"$gte": *params.PercentMin}})
+	}
+	if params.PercentMax != nil {
+		filter = append(filter, bson.E{Key: "difference_percent", Value: bson.M{"$lte": *params.PercentMax}})
+	}
+	if params.PriceMin != nil {
+		filter = append(filter, bson.E{Key: "price_after", Value: bson.M{"$gte": *params.PriceMin}})
+	}
+	if params.PriceMax != nil {
+		filter = append(filter, bson.E{Key: "price_after", Value: bson.M{"$lte": *params.PriceMax}})
+	}
+
+	prices, err := mongo.GetPrices(offset, limit, filter)
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.PriceChangesResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionProductPrices, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.PriceChangesResponse{Prices: []generated.PriceChangeSchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, price := range prices {
+		result.Prices = append(result.Prices, priceChangeSchema(price))
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func (s Server) GetSales(w http.ResponseWriter, r *http.Request, params generated.GetSalesParams) {
+
+	var limit int64 = 10
+	if params.Limit != nil && *params.Limit >= 1 && *params.Limit <= 1000 {
+		limit = int64(*params.Limit)
+	}
+
+	var offset int64 = 0
+	if params.Offset != nil {
+		offset = int64(*params.Offset)
+	}
+
+	var sort = "offer_end"
+	if params.Sort != nil {
+		switch *params.Sort {
+		case "ends":
+			sort = "offer_end"
+		case "discount":
+			sort = "offer_percent"
+		case "rating":
+			sort = "app_rating"
+		case "release_date":
+			sort = "app_date"
+		default:
+			sort = "offer_end"
+		}
+	}
+
+	var order = 1
+	if params.Order != nil {
+		switch *params.Order {
+		case "1", "asc", "ascending":
+			order = 1
+		case "0", "-1", "desc", "descending":
+			order = -1
+		default:
+			order = 1
+		}
+	}
+
+	// Discounts are stored as negative numbers
+	if sort == "offer_percent" {
+		order = -order
+	}
+
+	filter := bson.D{{Key: "offer_end", Value: bson.M{"$gt": time.Now()}}}
+
+	if params.SaleType != nil && len(*params.SaleType) > 0 {
+		filter = append(filter, bson.E{Key: "offer_type", Value: bson.M{"$in": *params.SaleType}})
+	}
+	if params.AppType != nil && len(*params.AppType) > 0 {
+		filter = append(filter, bson.E{Key: "app_type", Value: bson.M{"$in": *params.AppType}})
+	}
+	if params.AppIds != nil && len(*params.AppIds) > 0 {
+		filter = append(filter, bson.E{Key: "app_id", Value: bson.M{"$in": *params.AppIds}})
+	}
+	if params.DiscountMin != nil {
+		filter = append(filter, bson.E{Key: "offer_percent", Value: bson.M{"$lte": -*params.DiscountMin}})
+	}
+	if params.DiscountMax != nil {
+		filter = append(filter, bson.E{Key: "offer_percent", Value: bson.M{"$gte": -*params.DiscountMax}})
+	}
+
+	sales, err := mongo.GetAllSales(offset, limit, filter, bson.D{{Key: sort, Value: order}})
+	if err != nil {
+		log.ErrS(err)
+		returnResponse(w, r, http.StatusInternalServerError, generated.SalesResponse{Error: err.Error()})
+		return
+	}
+
+	total, err := mongo.CountDocuments(mongo.CollectionAppSales, filter, 60*60)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	result := generated.SalesResponse{Sales: []generated.SaleSchema{}}
+	result.Pagination.Fill(offset, limit, total)
+
+	for _, sale := range sales {
+
+		newSale := generated.SaleSchema{
+			AppIcon:         sale.AppIcon,
+			AppId:           int32(sale.AppID),
+			AppName:         sale.AppName,
+			AppRating:       sale.AppRating,
+			AppType:         sale.AppType,
+			SaleEnd:         sale.SaleEnd.Unix(),
+			SaleEndEstimate: sale.SaleEndEstimate,
+			SaleName:        sale.GetOfferName(),
+			SalePercent:     int32(-sale.SalePercent),
+			SaleStart:       sale.SaleStart.Unix(),
+			SaleType:        sale.SaleType,
+			SubId:           int32(sale.SubID),
+			Prices: generated.SaleSchema_Prices{
+				AdditionalProperties: map[string]int32{},
+			},
+			LowestPrices: generated.SaleSchema_LowestPrices{
+				AdditionalProperties: map[string]int32{},
+			},
+		}
+
+		for k, v := range sale.AppPrices {
+			newSale.Prices.AdditionalProperties[string(k)] = int32(v)
+		}
+		for k, v := range sale.AppLowestPrice {
+			newSale.LowestPrices.AdditionalProperties[string(k)] = int32(v)
+		}
+
+		result.Sales = append(result.Sales, newSale)
+	}
+
+	returnResponse(w, r, http.StatusOK, result)
+}
+
+func getProdCCParam(param *generated.CcParam) (cc steamapi.ProductCC, ok bool) {
+
+	cc = steamapi.ProductCCUS
+	if param != nil && *param != "" {
+		cc = steamapi.ProductCC(*param)
+	}
+
+	return cc, i18n.IsValidProdCC(cc)
+}
+
+func priceChangeSchema(price mongo.ProductPrice) generated.PriceChangeSchema {
+
+	return generated.PriceChangeSchema{
+		AppId:             int32(price.AppID),
+		PackageId:         int32(price.PackageID),
+		Name:              price.Name,
+		Icon:              price.GetIcon(),
+		Cc:                string(price.ProdCC),
+		Currency:          string(price.Currency),
+		PriceBefore:       int32(price.PriceBefore),
+		PriceAfter:        int32(price.PriceAfter),
+		Difference:        int32(price.Difference),
+		DifferencePercent: price.GetPercentChange(),
+		CreatedAt:         price.CreatedAt.Unix(),
+	}
+}
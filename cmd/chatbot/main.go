@@ -78,6 +78,8 @@ func closeWebcocketConn(session *discordgo.Session) func() {
 	}
 }
 
+// Slash commands are only registered in English, this version of discordgo can't send localizations.
+// Only the command output, like .help and embed fields, is translated.
 func refreshCommands(session *discordgo.Session) {
 
 	apiCommands, err := session.ApplicationCommands(config.C.DiscordChatBotClientID, "")
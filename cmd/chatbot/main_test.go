@@ -20,30 +20,38 @@ func TestLegacyRegexes(t *testing.T) {
 	}
 
 	tests := map[string]string{
-		"app 440":          chatbot.CApp,
-		"app tf2":          chatbot.CApp,
-		"game 440":         chatbot.CApp,
-		"game tf2":         chatbot.CApp,
-		"new":              chatbot.CAppsNew,
-		"players tf2":      chatbot.CAppPlayers,
-		"online tf2":       chatbot.CAppPlayers,
-		"popular":          chatbot.CAppsPopular,
-		"random":           chatbot.CAppsRandom,
-		"trending":         chatbot.CAppsTrending,
-		"group tf2":        chatbot.CGroup,
-		"clan tf2":         chatbot.CGroup,
-		"trendinggroups":   chatbot.CGroupsTrending,
-		"trending-groups":  chatbot.CGroupsTrending,
-		"trending groups":  chatbot.CGroupsTrending,
-		"help":             chatbot.CHelp,
-		"players":          chatbot.CSteamOnline,
-		"games Jleagle":    chatbot.CPlayerApps,
-		"level Jleagle":    chatbot.CPlayerLevel,
-		"player Jleagle":   chatbot.CPlayer,
-		"playtime Jleagle": chatbot.CPlayerPlaytime,
-		"recent Jleagle":   chatbot.CPlayerRecent,
-		"update":           chatbot.CPlayerUpdate,
-		"update Jleagle":   chatbot.CPlayerUpdate,
+		"app 440":                    chatbot.CApp,
+		"app tf2":                    chatbot.CApp,
+		"game 440":                   chatbot.CApp,
+		"game tf2":                   chatbot.CApp,
+		"new":                        chatbot.CAppsNew,
+		"players tf2":                chatbot.CAppPlayers,
+		"compare tf2, csgo":          chatbot.CAppsCompare,
+		"compare tf2 vs csgo":        chatbot.CAppsCompare,
+		"price-history tf2":          chatbot.CAppPriceHistory,
+		"price-history uk tf2":       chatbot.CAppPriceHistory,
+		"history Jleagle":            chatbot.CPlayerHistory,
+		"history games year Jleagle": chatbot.CPlayerHistory,
+		"online tf2":                 chatbot.CAppPlayers,
+		"popular":                    chatbot.CAppsPopular,
+		"random":                     chatbot.CAppsRandom,
+		"trending":                   chatbot.CAppsTrending,
+		"group tf2":                  chatbot.CGroup,
+		"clan tf2":                   chatbot.CGroup,
+		"coop <@123>":                chatbot.CPlayerCoop,
+		"trendinggroups":             chatbot.CGroupsTrending,
+		"trending-groups":            chatbot.CGroupsTrending,
+		"trending groups":            chatbot.CGroupsTrending,
+		"help":                       chatbot.CHelp,
+		"players":                    chatbot.CSteamOnline,
+		"games Jleagle":              chatbot.CPlayerApps,
+		"level Jleagle":              chatbot.CPlayerLevel,
+		"player Jleagle":             chatbot.CPlayer,
+		"playtime Jleagle":           chatbot.CPlayerPlaytime,
+		"recent Jleagle":             chatbot.CPlayerRecent,
+		"subscribe list":             chatbot.CSubscribe,
+		"update":                     chatbot.CPlayerUpdate,
+		"update Jleagle":             chatbot.CPlayerUpdate,
 	}
 
 	for _, start := range []string{".", "!"} {
@@ -58,7 +66,7 @@ func TestLegacyRegexes(t *testing.T) {
 						continue
 					}
 
-					msg, err := c.Output("123", steamapi.ProductCCUS, c.LegacyInputs(start+message))
+					msg, err := c.Output("123", steamapi.ProductCCUS, chatbot.LanguageEnglish, c.LegacyInputs(start+message))
 					if err != nil {
 						t.Error(err)
 						continue
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -35,7 +36,13 @@ func websocketServer() (session *discordgo.Session, err error) {
 		return nil, err
 	}
 
-	session.AddHandler(func(s *discordgo.Session, e *discordgo.InteractionCreate) {
+	// Uses the raw event, as discordgo does not unmarshal the interaction locales
+	session.AddHandler(func(s *discordgo.Session, event *discordgo.Event) {
+
+		e, ok := event.Struct.(*discordgo.InteractionCreate)
+		if !ok {
+			return
+		}
 
 		// Check for pings
 		if e.Type == discordgo.InteractionPing {
@@ -94,7 +101,8 @@ func websocketServer() (session *discordgo.Session, err error) {
 
 		// Check in cache first
 		code := getProdCC(command, user.ID)
-		cacheItem := memcache.ItemChatBotRequestSlash(command.ID(), arguments(e), code)
+		lang := getLanguage(user.ID, e.GuildID, event.RawData)
+		cacheItem := memcache.ItemChatBotRequestSlash(command.ID(), arguments(e), code, string(lang))
 
 		if !command.DisableCache() && !config.IsLocal() {
 
@@ -117,7 +125,7 @@ func websocketServer() (session *discordgo.Session, err error) {
 		}
 
 		// Make output
-		out, err := command.Output(user.ID, code, arguments(e))
+		out, err := commandOutput(s, command, e.GuildID, e.ChannelID, user.ID, code, lang, arguments(e))
 		if err != nil {
 			log.ErrS(err)
 			return
@@ -197,8 +205,9 @@ func websocketServer() (session *discordgo.Session, err error) {
 
 					// Get user settings
 					code := getProdCC(command, e.Author.ID)
+					lang := getLanguage(e.Author.ID, e.GuildID, nil)
 
-					cacheItem := memcache.ItemChatBotRequest(msg, code)
+					cacheItem := memcache.ItemChatBotRequest(msg, code, string(lang))
 
 					// Check in cache first
 					if !command.DisableCache() && !config.IsLocal() {
@@ -225,7 +234,7 @@ func websocketServer() (session *discordgo.Session, err error) {
 					}
 
 					// Make output
-					message, err := command.Output(e.Author.ID, code, command.LegacyInputs(msg))
+					message, err := commandOutput(s, command, e.GuildID, e.ChannelID, e.Author.ID, code, lang, command.LegacyInputs(msg))
 					if err != nil {
 						log.ErrS(err, msg)
 						return
@@ -296,6 +305,78 @@ func getProdCC(command chatbot.Command, authorID string) steamapi.ProductCC {
 	return code
 }
 
+func commandOutput(s *discordgo.Session, command chatbot.Command, guildID, channelID, authorID string, code steamapi.ProductCC, lang chatbot.Language, inputs map[string]string) (discordgo.MessageSend, error) {
+
+	if guildCommand, ok := command.(chatbot.GuildCommand); ok && guildID != "" {
+
+		guild := chatbot.GuildContext{
+			GuildID:   guildID,
+			ChannelID: channelID,
+			Admin:     isGuildAdmin(s, authorID, channelID),
+		}
+
+		return guildCommand.GuildOutput(authorID, guild, code, lang, inputs)
+	}
+
+	return command.Output(authorID, code, lang, inputs)
+}
+
+func isGuildAdmin(s *discordgo.Session, userID, channelID string) bool {
+
+	permissions, err := s.State.UserChannelPermissions(userID, channelID)
+	if err != nil {
+		permissions, err = s.UserChannelPermissions(userID, channelID)
+		if err != nil {
+			discordError(err)
+			return false
+		}
+	}
+
+	return permissions&(discordgo.PermissionManageServer|discordgo.PermissionAdministrator) != 0
+}
+
+// User setting, then the guild setting, then the interaction locale, then English
+func getLanguage(authorID string, guildID string, rawInteraction []byte) chatbot.Language {
+
+	settings, err := mysql.GetChatBotSettings(authorID)
+	if err != nil {
+		log.ErrS(err)
+	}
+	if lang, ok := chatbot.GetLanguage(settings.Language); ok {
+		return lang
+	}
+
+	if guildID != "" {
+
+		guildSettings, err := mysql.GetChatBotGuildSettings(guildID)
+		if err != nil {
+			log.ErrS(err)
+		}
+		if lang, ok := chatbot.GetLanguage(guildSettings.Language); ok {
+			return lang
+		}
+	}
+
+	var locales = struct {
+		Locale      string `json:"locale"`
+		GuildLocale string `json:"guild_locale"`
+	}{}
+
+	if len(rawInteraction) > 0 {
+		err = json.Unmarshal(rawInteraction, &locales)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+
+	if lang, ok := chatbot.GetLanguage(locales.Locale); ok {
+		return lang
+	}
+
+	lang, _ := chatbot.GetLanguage(locales.GuildLocale)
+	return lang
+}
+
 func saveToDB(command chatbot.Command, isSlash bool, wasSuccess *bool, message, guildID, channelID string, user *discordgo.User) {
 
 	if config.IsLocal() {
@@ -50,6 +50,9 @@ func main() {
 	// Load consumers
 	consumers.Init(consumers.ConsumersDefinitions)
 
+	// Deliver delayed messages
+	go consumers.StartDelayScheduler()
+
 	helpers.KeepAlive(
 		mysql.Close,
 		mongo.Close,
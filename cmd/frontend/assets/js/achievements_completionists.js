@@ -0,0 +1,59 @@
+if ($('#achievements-completionists-page').length > 0) {
+
+    const options = {
+        'order': [[1, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-app-id', data[0]);
+            $(row).attr('data-link', data[3]);
+        },
+        'columnDefs': [
+            // Icon / Name
+            {
+                'targets': 0,
+                'render': function (data, type, row) {
+                    return '<a href="' + row[3] + '" class="icon-name"><div class="icon"><img class="tall" data-lazy="' + row[2] + '" alt="" data-lazy-alt="' + row[1] + '"></div><div class="name">' + row[1] + '</div></a>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).addClass('img');
+                },
+                'orderable': false,
+            },
+            // Completionists
+            {
+                'targets': 1,
+                'render': function (data, type, row) {
+                    return row[4] + '% <small class="text-muted">(' + row[5].toLocaleString() + ')</small>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    const percent = Math.ceil(rowData[4]);
+                    $(td).css('background', 'linear-gradient(to right, rgba(0,0,0,.15) ' + percent + '%, transparent ' + percent + '%)');
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Players
+            {
+                'targets': 2,
+                'render': function (data, type, row) {
+                    return row[6].toLocaleString();
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Median Time
+            {
+                'targets': 3,
+                'render': function (data, type, row) {
+                    return row[7];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderSequence': ['asc', 'desc'],
+            },
+        ],
+    };
+
+    $('table.table').gdbTable({
+        tableOptions: options,
+    });
+}
@@ -384,6 +384,83 @@ if ($('#admin-webhooks-page').length > 0) {
     );
 }
 
+if ($('#admin-failed-page').length > 0) {
+
+    // Setup drop downs
+    $('select.form-control-chosen').chosen({
+        disable_search_threshold: 5,
+        allow_single_deselect: true,
+    });
+
+    const options = {
+        'order': [[0, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-link', data[7]);
+            if (data[8]) {
+                $(row).addClass('table-success');
+            }
+        },
+        'columnDefs': [
+            // Date
+            {
+                'targets': 0,
+                'render': function (data, type, row) {
+                    return row[1];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderable': false,
+            },
+            // Queue
+            {
+                'targets': 1,
+                'render': function (data, type, row) {
+                    return row[2];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderable': false,
+            },
+            // Attempt
+            {
+                'targets': 2,
+                'render': function (data, type, row) {
+                    return row[3];
+                },
+                'orderable': false,
+            },
+            // Reason
+            {
+                'targets': 3,
+                'render': function (data, type, row) {
+                    return row[5];
+                },
+                'orderable': false,
+            },
+            // Body
+            {
+                'targets': 4,
+                'render': function (data, type, row) {
+                    return '<code>' + $('<div/>').text(row[6]).html() + '</code>' + (row[9] ? ' <span class="badge badge-secondary">Edited</span>' : '');
+                },
+                'orderable': false,
+            },
+        ],
+    };
+
+    const $table = $('table.table');
+    $table.gdbTable({
+        tableOptions: options,
+        searchFields: [
+            $('#queue'),
+            $('#reason'),
+            $('#status'),
+        ],
+    });
+}
+
 if ($('#admin-delays-page').length > 0) {
 
     const options = {
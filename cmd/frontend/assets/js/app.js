@@ -57,6 +57,8 @@ if ($appPage.length > 0) {
         'achievements': loadAchievements,
         'dlc': loadDLC,
         'dev-localization': loadDevLocalization,
+        'patches-table': loadAppPatches,
+        'pics-history-table': loadAppPICSHistory,
         'media': loadAppMediaTab,
         'tags-chart': loadAppTags,
 
@@ -310,6 +312,7 @@ if ($appPage.length > 0) {
                         {Name: 'Tradable', Value: rowx[22]},
                         {Name: 'Type', Value: rowx[23]},
                         {Name: 'Workshop ID', Value: rowx[24]},
+                        {Name: 'Item Page', Value: '<a href="' + rowx[30] + '">' + rowx[30] + '</a>'},
                     ];
 
                     const html = json2html.transform(fields, {
@@ -1222,6 +1225,16 @@ if ($appPage.length > 0) {
         });
     }
 
+    function loadAppPatches() {
+
+        $('#patches-table').gdbTable({tableOptions: depotHistoryTableOptions()});
+    }
+
+    function loadAppPICSHistory() {
+
+        $('#pics-history-table').gdbTable({tableOptions: picsHistoryTableOptions(false)});
+    }
+
     function loadAppBundlesTab() {
 
         const options = {
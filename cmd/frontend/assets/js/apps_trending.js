@@ -14,7 +14,13 @@ if ($trendingAppsPage.length > 0) {
             {
                 'targets': 0,
                 'render': function (data, type, row) {
-                    return '<a href="' + row[3] + '" class="icon-name"><div class="icon"><img alt="" data-lazy="' + row[2] + '" data-lazy-alt="' + row[1] + '"></div><div class="name">' + row[1] + '</div></a>';
+
+                    let name = row[1];
+                    if (row[7]) {
+                        name += ' <span class="badge badge-danger" data-toggle="tooltip" data-placement="top" title="Unusual number of negative reviews">Review Bomb</span>';
+                    }
+
+                    return '<a href="' + row[3] + '" class="icon-name"><div class="icon"><img alt="" data-lazy="' + row[2] + '" data-lazy-alt="' + row[1] + '"></div><div class="name">' + name + '</div></a>';
                 },
                 'createdCell': function (td, cellData, rowData, row, col) {
                     $(td).addClass('img');
@@ -90,7 +90,7 @@ if ($('#bundles-page').length > 0) {
 
     //
     const options = {
-        'order': [[6, 'desc']],
+        'order': [[7, 'desc']],
         'createdRow': function (row, data, dataIndex) {
             $(row).attr('data-link', data[2]);
         },
@@ -148,9 +148,30 @@ if ($('#bundles-page').length > 0) {
                 },
                 'orderSequence': ['asc', 'desc'],
             },
-            // Items
+            // Saving
             {
                 'targets': 3,
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'render': function (data, type, row) {
+                    if (user.prodCC in row[14]) {
+
+                        let saving = row[14][user.prodCC] + '%';
+
+                        if (user.prodCC in row[15]) {
+                            saving += ' <small>' + row[15][user.prodCC] + '</small>';
+                        }
+
+                        return saving;
+                    }
+                    return '-';
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Items
+            {
+                'targets': 4,
                 'render': function (data, type, row) {
                     return row[5].toLocaleString();
                 },
@@ -158,7 +179,7 @@ if ($('#bundles-page').length > 0) {
             },
             // Giftable
             {
-                'targets': 4,
+                'targets': 5,
                 'render': function (data, type, row) {
                     if (row[6]) {
                         return '<i class="fas fa-check text-success fa-fw"></i>';
@@ -170,7 +191,7 @@ if ($('#bundles-page').length > 0) {
             },
             // Complete the set
             {
-                'targets': 5,
+                'targets': 6,
                 'render': function (data, type, row) {
                     if (row[12] === 'cts') {
                         return '<i class="fas fa-check text-success fa-fw"></i>';
@@ -182,7 +203,7 @@ if ($('#bundles-page').length > 0) {
             },
             // First Seen
             {
-                'targets': 6,
+                'targets': 7,
                 'createdCell': function (td, cellData, rowData, row, col) {
                     $(td).attr('nowrap', 'nowrap');
                 },
@@ -193,7 +214,7 @@ if ($('#bundles-page').length > 0) {
             },
             // Link
             {
-                'targets': 7,
+                'targets': 8,
                 'render': function (data, type, row) {
                     if (row[8]) {
                         return '<a href="' + row[8] + '" target="_blank" rel="noopener"><i class="fas fa-link"></i></a>';
@@ -204,7 +225,7 @@ if ($('#bundles-page').length > 0) {
             },
             // Search score
             {
-                'targets': 8,
+                'targets': 9,
                 'render': function (data, type, row) {
                     return row[10];
                 },
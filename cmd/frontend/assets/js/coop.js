@@ -115,7 +115,7 @@ if ($('#coop-page').length > 0) {
     function loadCoopGamesTable() {
 
         const options = {
-            'order': [[0, 'asc']],
+            'order': [[7, 'desc']],
             'createdRow': function (row, data, dataIndex) {
                 $(row).attr('data-app-id', data[0]);
                 $(row).attr('data-link', data[7]);
@@ -159,9 +159,52 @@ if ($('#coop-page').length > 0) {
                     },
                     'orderable': false,
                 },
-                // Community Link
+                // Owners
                 {
                     'targets': 4,
+                    'render': function (data, type, row) {
+                        return row[9] + '/' + row[10];
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        if (rowData[9] === rowData[10]) {
+                            $(td).addClass('text-success');
+                        }
+                    },
+                    'orderable': false,
+                },
+                // Recent Playtime
+                {
+                    'targets': 5,
+                    'render': function (data, type, row) {
+                        return row[11];
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).attr('nowrap', 'nowrap');
+                    },
+                    'orderable': false,
+                },
+                // Cost For Missing Owners
+                {
+                    'targets': 6,
+                    'render': function (data, type, row) {
+                        return row[9] === row[10] ? '-' : row[12];
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).attr('nowrap', 'nowrap');
+                    },
+                    'orderable': false,
+                },
+                // Score
+                {
+                    'targets': 7,
+                    'render': function (data, type, row) {
+                        return row[8].toFixed(2);
+                    },
+                    'orderable': false,
+                },
+                // Community Link
+                {
+                    'targets': 8,
                     'render': function (data, type, row) {
                         if (row[6]) {
                             return '<a href="' + row[6] + '" target="_blank" rel="noopener"><i class="fas fa-link"></i></a>';
@@ -0,0 +1,78 @@
+if ($('#depots-page').length > 0) {
+
+    $('#depots-table').gdbTable({tableOptions: depotHistoryTableOptions()});
+}
+
+if ($('#depot-page').length > 0) {
+
+    $('#depot-table').gdbTable({tableOptions: depotHistoryTableOptions()});
+}
+
+// Also used on the app page patch history tab
+function depotHistoryTableOptions() {
+
+    return {
+        'order': [[4, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-link', data[2]);
+        },
+        'columnDefs': [
+            // App
+            {
+                'targets': 0,
+                'render': function (data, type, row) {
+                    return '<a href="' + row[2] + '">' + row[1] + '</a>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderable': false,
+            },
+            // Branch
+            {
+                'targets': 1,
+                'render': function (data, type, row) {
+                    return row[4];
+                },
+                'orderable': false,
+            },
+            // Depot
+            {
+                'targets': 2,
+                'render': function (data, type, row) {
+                    if (row[7] === '') {
+                        return '';
+                    }
+                    return '<a href="' + row[7] + '">' + row[6] + '</a>';
+                },
+                'orderable': false,
+            },
+            // Change
+            {
+                'targets': 3,
+                'render': function (data, type, row) {
+
+                    const label = row[3] === 'build' ? 'Build' : 'Manifest';
+                    const before = row[8] === '' ? '<em>New</em>' : row[8];
+
+                    return label + ' ' + before + ' <i class="fas fa-long-arrow-alt-right"></i> ' + row[9];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderable': false,
+            },
+            // Date
+            {
+                'targets': 4,
+                'render': function (data, type, row) {
+                    return '<a href="' + row[13] + '"><span data-toggle="tooltip" data-placement="left" title="' + row[11] + '" data-livestamp="' + row[10] + '"></span></a>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderable': false,
+            },
+        ],
+    };
+}
@@ -0,0 +1,72 @@
+if ($('#franchises-page').length > 0) {
+
+    const options = {
+        'order': [[2, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-link', data[2]);
+        },
+        'columnDefs': [
+            // Icon / Name
+            {
+                'targets': 0,
+                'render': function (data, type, row) {
+                    return '<a href="' + row[2] + '" class="icon-name"><div class="icon"><img class="tall" data-lazy="' + row[3] + '" alt="" data-lazy-alt="' + row[1] + '"></div><div class="name markable">' + row[1] + '</div></a>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).addClass('img');
+                },
+                'orderSequence': ['asc', 'desc'],
+            },
+            // Games
+            {
+                'targets': 1,
+                'render': function (data, type, row) {
+                    return row[4].toLocaleString();
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Players
+            {
+                'targets': 2,
+                'render': function (data, type, row) {
+                    return row[5].toLocaleString();
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Score
+            {
+                'targets': 3,
+                'render': function (data, type, row) {
+                    return row[6];
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Owners
+            {
+                'targets': 4,
+                'render': function (data, type, row) {
+                    return row[7].toLocaleString();
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Price
+            {
+                'targets': 5,
+                'render': function (data, type, row) {
+                    return row[8];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+        ],
+    };
+
+    $('table.table').gdbTable({
+        tableOptions: options,
+        searchFields: [
+            $('#search'),
+        ],
+    });
+}
@@ -0,0 +1,146 @@
+const $itemsPage = $('#items-page');
+
+if ($itemsPage.length > 0) {
+
+    // Setup drop downs
+    $('select.form-control-chosen').chosen({
+        disable_search_threshold: 5,
+        allow_single_deselect: true,
+        max_selected_options: 10,
+    });
+
+    const options = {
+        'order': [[4, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-link', data[4]);
+        },
+        'columnDefs': [
+            // Item
+            {
+                'targets': 0,
+                'render': function (data, type, row) {
+
+                    const name = row[3] + '<br><small><a href="' + row[7] + '">' + row[6] + '</a></small>';
+
+                    return '<a href="' + row[4] + '" class="icon-name"><div class="icon"><img class="tall" data-lazy="' + row[5] + '" alt="" data-lazy-alt="' + row[2] + '"></div><div class="name">' + name + '</div></a>';
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).addClass('img');
+                },
+                'orderable': false,
+            },
+            // Type
+            {
+                'targets': 1,
+                'render': function (data, type, row) {
+                    if (row[9]) {
+                        return row[8] + '<br><small>' + row[9] + '</small>';
+                    }
+                    return row[8];
+                },
+                'orderable': false,
+            },
+            // Tradable
+            {
+                'targets': 2,
+                'render': function (data, type, row) {
+                    if (row[10]) {
+                        return '<i class="fas fa-check text-success fa-fw"></i>';
+                    }
+                    return '<i class="fas fa-times text-danger fa-fw"></i>';
+                },
+                'orderable': false,
+            },
+            // Price
+            {
+                'targets': 3,
+                'render': function (data, type, row) {
+                    return row[12];
+                },
+                'createdCell': function (td, cellData, rowData, row, col) {
+                    $(td).attr('nowrap', 'nowrap');
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Recipes
+            {
+                'targets': 4,
+                'render': function (data, type, row) {
+                    return row[13].toLocaleString();
+                },
+                'orderSequence': ['desc', 'asc'],
+            },
+            // Search Score
+            {
+                'targets': 5,
+                'render': function (data, type, row) {
+                    return row[14];
+                },
+                'orderable': false,
+                'visible': user.isLocal,
+            },
+        ],
+    };
+
+    const searchFields = [
+        $('#search'),
+        $('#type'),
+        $('#quality'),
+        $('#tradable'),
+        $('#marketable'),
+        $('#tags'),
+    ];
+
+    const $table = $('table.table');
+
+    // Facets come back with each search
+    $table.on('xhr.dt', function (e, settings, json, xhr) {
+
+        if (!json || !json.aggregations) {
+            return;
+        }
+
+        updateFacet($('#type'), json.aggregations.type);
+        updateFacet($('#quality'), json.aggregations.quality);
+        updateFacet($('#tags'), json.aggregations.tags);
+    });
+
+    $table.gdbTable({
+        tableOptions: options,
+        searchFields: searchFields,
+    });
+
+    function updateFacet($select, buckets) {
+
+        if (!buckets) {
+            return;
+        }
+
+        const selected = [].concat($select.val() || []);
+
+        $select.find('option').filter(function () {
+            return $(this).val() !== '' && !selected.includes($(this).val());
+        }).remove();
+
+        const keys = Object.keys(buckets).sort(function (a, b) {
+            return buckets[b] - buckets[a];
+        });
+
+        for (const key of keys) {
+
+            const label = key + ' (' + buckets[key].toLocaleString() + ')';
+
+            const $existing = $select.find('option').filter(function () {
+                return $(this).val() === key;
+            });
+
+            if ($existing.length > 0) {
+                $existing.text(label);
+            } else {
+                $select.append($('<option>').val(key).text(label));
+            }
+        }
+
+        $select.trigger('chosen:updated');
+    }
+}
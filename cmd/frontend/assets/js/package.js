@@ -15,6 +15,9 @@ if ($packagePage.length > 0) {
                 case '#prices':
                     loadPriceChart();
                     break;
+                case '#history':
+                    $('#pics-history-table').gdbTable({tableOptions: picsHistoryTableOptions(false)});
+                    break;
             }
         }
     });
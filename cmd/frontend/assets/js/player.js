@@ -26,6 +26,14 @@ if ($playerPage.length > 0) {
         });
     });
 
+    // Export link
+    $('#export-button').on('click', function (e) {
+
+        e.preventDefault();
+
+        $('#export-form').trigger('submit');
+    });
+
     const delay = ms => new Promise(res => setTimeout(res, ms));
 
     // Websockets
@@ -37,6 +45,31 @@ if ($playerPage.length > 0) {
         }
 
         const data = JSON.parse(e.data);
+
+        if (data.Data['queue'] === 'price-alert') {
+
+            if (data.Data['id'] === $playerPage.attr('data-id') && data.Data['id'] === String(user.playerID)) {
+                toast(true, '<a href="' + data.Data['link'] + '">' + data.Data['message'] + '</a>', 'Price Alert', -1);
+            }
+            return;
+        }
+
+        if (data.Data['queue'] === 'wishlist-alert') {
+
+            if (data.Data['id'] === $playerPage.attr('data-id') && data.Data['id'] === String(user.playerID)) {
+                toast(true, '<a href="' + data.Data['link'] + '">' + data.Data['message'] + '</a>', 'Wishlist Alert', -1);
+            }
+            return;
+        }
+
+        if (data.Data['queue'] === 'ban-watch') {
+
+            if (data.Data['id'] === $playerPage.attr('data-id') && data.Data['id'] === String(user.playerID)) {
+                toast(false, '<a href="' + data.Data['link'] + '">' + data.Data['message'] + '</a>', 'Ban Watch', -1);
+            }
+            return;
+        }
+
         if (data.Data['id'] === $playerPage.attr('data-id')) {
 
             if (data.Data['queue'] === 'player') {
@@ -79,7 +112,10 @@ if ($playerPage.length > 0) {
         'badges-table': loadPlayerBadgesTab,
         'friends-table': loadPlayerFriendsTab,
         'groups-table': loadPlayerGroupsTab,
+        'activity-table': loadPlayerActivityTab,
         'wishlist-table': loadPlayerWishlistTab,
+        'wishlist-sales-table': loadPlayerWishlistSales,
+        'wishlist-totals-chart': loadPlayerWishlistTotalsChart,
         'achievements-table': loadPlayerAchievementsTab,
         'achievement-influx-chart': loadPlayerAchievementsInfluxChart,
     });
@@ -615,6 +651,59 @@ if ($playerPage.length > 0) {
         });
     }
 
+    function loadPlayerActivityTab() {
+
+        const options = {
+            'order': [[1, 'desc']],
+            'createdRow': function (row, data, dataIndex) {
+                if (data[3]) {
+                    $(row).attr('data-link', data[3]);
+                }
+            },
+            'columnDefs': [
+                // Event
+                {
+                    'targets': 0,
+                    'render': function (data, type, row) {
+
+                        let icon;
+                        if (row[2].startsWith('fa')) {
+                            icon = '<i class="' + row[2] + '"></i>';
+                        } else if (row[2]) {
+                            icon = '<img data-lazy="' + row[2] + '" alt="" data-lazy-alt="">';
+                        } else {
+                            icon = '';
+                        }
+
+                        return '<div class="icon-name"><div class="icon">' + icon + '</div><div class="name">' + row[1] + '</div></div>';
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).addClass('img');
+                    },
+                    'orderable': false,
+                },
+                // Date
+                {
+                    'targets': 1,
+                    'render': function (data, type, row) {
+                        return '<span data-toggle="tooltip" data-placement="left" title="' + row[5] + '" data-livestamp="' + row[4] + '"></span>';
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).attr('nowrap', 'nowrap');
+                    },
+                    'orderSequence': ['desc'],
+                },
+            ],
+        };
+
+        $('#activity-table').gdbTable({
+            tableOptions: options,
+            searchFields: [
+                $('#type'),
+            ],
+        });
+    }
+
     function loadPlayerWishlistTab() {
 
         const options = {
@@ -689,6 +778,126 @@ if ($playerPage.length > 0) {
         });
     }
 
+    function loadPlayerWishlistSales() {
+
+        const options = {
+            'order': [],
+            'createdRow': function (row, data, dataIndex) {
+                $(row).attr('data-link', data[2]);
+                if (data[9]) {
+                    $(row).addClass('table-success');
+                }
+            },
+            'columnDefs': [
+                // App Name
+                {
+                    'targets': 0,
+                    'render': function (data, type, row) {
+                        return '<a href="' + row[2] + '" class="icon-name"><div class="icon"><img data-lazy="' + row[3] + '" data-src="/assets/img/no-player-image.jpg" alt="" data-lazy-alt="' + row[1] + '"></div><div class="name">' + row[1] + '</div></a>';
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).addClass('img');
+                    },
+                    'orderable': false,
+                },
+                // Discount
+                {
+                    'targets': 1,
+                    'render': function (data, type, row) {
+                        return row[6] + '%';
+                    },
+                    'orderable': false,
+                },
+                // Price
+                {
+                    'targets': 2,
+                    'render': function (data, type, row) {
+                        return row[4] + ' <small><del>' + row[5] + '</del></small>';
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).attr('nowrap', 'nowrap');
+                    },
+                    'orderable': false,
+                },
+                // All-Time Low
+                {
+                    'targets': 3,
+                    'render': function (data, type, row) {
+                        if (row[9]) {
+                            return '<span class="font-weight-bold">' + row[7] + '</span> <small>Lowest ever</small>';
+                        }
+                        if (row[8]) {
+                            return row[7] + ' <small>' + row[8] + '</small>';
+                        }
+                        return row[7];
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        $(td).attr('nowrap', 'nowrap');
+                    },
+                    'orderable': false,
+                },
+            ],
+        };
+
+        $('#wishlist-sales-table').gdbTable({
+            tableOptions: options,
+        });
+    }
+
+    function loadPlayerWishlistTotalsChart() {
+
+        $.ajax({
+            type: 'GET',
+            url: '/players/' + $playerPage.attr('data-id') + '/wishlist-totals.json',
+            dataType: 'json',
+            success: function (data, textStatus, jqXHR) {
+
+                if (data === null) {
+                    data = {};
+                }
+
+                // One series per region, only the user's region is shown by default
+                const prefix = 'max_wishlist_total_';
+                const series = [];
+
+                for (const key in data) {
+                    if (data.hasOwnProperty(key) && key.startsWith(prefix)) {
+
+                        const cc = key.substring(prefix.length);
+
+                        series.push({
+                            name: cc.toUpperCase(),
+                            data: data[key].map(function (point) {
+                                return [point[0], point[1] / 100];
+                            }),
+                            marker: {symbol: 'circle'},
+                            visible: cc === user.prodCC,
+                        });
+                    }
+                }
+
+                Highcharts.chart('wishlist-totals-chart', $.extend(true, {}, defaultChartOptions, {
+                    yAxis: {
+                        title: {
+                            text: 'Total Cost',
+                        },
+                        labels: {
+                            formatter: function () {
+                                return this.value.toLocaleString();
+                            },
+                        },
+                    },
+                    tooltip: {
+                        formatter: function () {
+                            return this.series.name + ' ' + this.y.toLocaleString(undefined, {minimumFractionDigits: 2}) + ' on ' + moment(this.key).format('dddd DD MMM YYYY');
+                        },
+                    },
+                    series: series,
+                }));
+            },
+        });
+    }
+
     function loadPlayerAchievementsTab() {
 
         $.ajax({
@@ -62,8 +62,14 @@ if ($('#product-keys-page').length > 0) {
         searchFields: [$type, $key, $comparator, $value],
     });
 
+    const historyDT = $('#history-table').gdbTable({
+        tableOptions: picsHistoryTableOptions(true),
+        searchFields: [$type, $key],
+    });
+
     $('#search button').on('click', function (e) {
         dt.draw();
+        historyDT.draw();
     });
 
     $('#apps-table').gdbTable({
@@ -86,6 +92,7 @@ if ($('#product-keys-page').length > 0) {
 
         $('a.nav-link[href="#search"]').tab('show');
         dt.draw();
+        historyDT.draw();
     });
 
     $('#packages-table tbody tr').on('click', function (e) {
@@ -95,5 +102,83 @@ if ($('#product-keys-page').length > 0) {
 
         $('a.nav-link[href="#search"]').tab('show');
         dt.draw();
+        historyDT.draw();
+    });
+}
+
+// Also used on the app and package PICS history tabs
+function picsHistoryTableOptions(showProduct) {
+
+    const value = function (data) {
+        return '<code class="wbba">' + $('<div/>').text(data).html() + '</code>';
+    };
+
+    const columns = [
+        // Section
+        {
+            'render': function (data, type, row) {
+                return row[5];
+            },
+            'createdCell': function (td, cellData, rowData, row, col) {
+                $(td).attr('nowrap', 'nowrap');
+            },
+            'orderable': false,
+        },
+        // Key
+        {
+            'render': function (data, type, row) {
+                return row[6];
+            },
+            'orderable': false,
+        },
+        // Change
+        {
+            'render': function (data, type, row) {
+
+                if (row[7] === 'added') {
+                    return '<em>Added</em> ' + value(row[9]);
+                }
+                if (row[7] === 'removed') {
+                    return '<em>Removed</em> ' + value(row[8]);
+                }
+                return value(row[8]) + ' <i class="fas fa-long-arrow-alt-right"></i> ' + value(row[9]);
+            },
+            'orderable': false,
+        },
+        // Date
+        {
+            'render': function (data, type, row) {
+                return '<a href="' + row[13] + '"><span data-toggle="tooltip" data-placement="left" title="' + row[11] + '" data-livestamp="' + row[10] + '"></span></a>';
+            },
+            'createdCell': function (td, cellData, rowData, row, col) {
+                $(td).attr('nowrap', 'nowrap');
+            },
+            'orderable': false,
+        },
+    ];
+
+    // The key search already shows the section and key
+    if (showProduct) {
+        columns.splice(0, 2, {
+            'render': function (data, type, row) {
+                return '<a href="' + row[3] + '">' + row[2] + '</a>';
+            },
+            'createdCell': function (td, cellData, rowData, row, col) {
+                $(td).attr('nowrap', 'nowrap');
+            },
+            'orderable': false,
+        });
+    }
+
+    columns.forEach(function (column, i) {
+        column.targets = i;
     });
+
+    return {
+        'order': [[columns.length - 1, 'desc']],
+        'createdRow': function (row, data, dataIndex) {
+            $(row).attr('data-link', showProduct ? data[3] : data[13]);
+        },
+        'columnDefs': columns,
+    };
 }
@@ -189,9 +189,25 @@ if ($('#sales-page').length > 0) {
                     },
                     'orderSequence': ['desc', 'asc'],
                 },
-                // Link
+                // Deal
                 {
                     'targets': 6,
+                    'render': function (data, type, row) {
+                        if (!row[16]) {
+                            return '-';
+                        }
+                        return '<span data-toggle="tooltip" data-placement="left" title="' + row[17] + '">' + row[16] + '</span>';
+                    },
+                    'createdCell': function (td, cellData, rowData, row, col) {
+                        if (rowData[16] >= 80) {
+                            $(td).addClass('text-success');
+                        }
+                    },
+                    'orderable': false,
+                },
+                // Link
+                {
+                    'targets': 7,
                     'render': function (data, type, row) {
                         if (row[8]) {
                             return '<a href="' + row[8] + '" target="_blank" rel="noopener"><i class="fas fa-link"></i></a>';
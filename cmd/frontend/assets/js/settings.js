@@ -38,10 +38,249 @@ if ($settingsPage.length > 0) {
     });
 
     loadAjaxOnObserve({
+        'alerts-table': loadAlerts,
+        'ban-watch-table': loadBanWatch,
+        'webhooks-table': loadWebhooks,
         'events-table': loadEvents,
         'donations-table': loadDonations,
     });
 
+    function loadAlerts() {
+
+        $('#alerts table.table').gdbTable({
+            tableOptions: {
+                'order': [],
+                'columnDefs': [
+                    // Product
+                    {
+                        'targets': 0,
+                        'render': function (data, type, row) {
+                            return '<a href="' + row[3] + '">' + row[2] + '</a> <small class="text-muted">' + row[1] + '</small>';
+                        },
+                        'orderable': false,
+                    },
+                    // Region
+                    {
+                        'targets': 1,
+                        'render': function (data, type, row) {
+                            return row[4];
+                        },
+                        'orderable': false,
+                    },
+                    // Rule
+                    {
+                        'targets': 2,
+                        'render': function (data, type, row) {
+                            if (row[6]) {
+                                return row[5] + ' (' + row[6] + ')';
+                            }
+                            return row[5];
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Notify
+                    {
+                        'targets': 3,
+                        'render': function (data, type, row) {
+                            const channels = [];
+                            if (row[7]) {
+                                channels.push('<i class="fas fa-envelope" data-toggle="tooltip" data-placement="left" title="Email"></i>');
+                            }
+                            if (row[8]) {
+                                channels.push('<i class="fab fa-discord" data-toggle="tooltip" data-placement="left" title="Discord"></i>');
+                            }
+                            return channels.join(' ');
+                        },
+                        'orderable': false,
+                    },
+                    // Last Alert
+                    {
+                        'targets': 4,
+                        'render': function (data, type, row) {
+                            return row[9];
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Delete
+                    {
+                        'targets': 5,
+                        'render': function (data, type, row) {
+                            return '<a href="/settings/alerts/' + row[0] + '/delete" class="text-danger"><i class="fas fa-trash-alt"></i></a>';
+                        },
+                        'orderable': false,
+                    },
+                ],
+            },
+        });
+    }
+
+    function loadBanWatch() {
+
+        $('#ban-watch table.table').gdbTable({
+            tableOptions: {
+                'order': [],
+                'createdRow': function (row, data, dataIndex) {
+                    $(row).attr('data-link', data[3]);
+                    if (data[5] > 0 || data[6] > 0) {
+                        $(row).addClass('table-danger');
+                    }
+                },
+                'columnDefs': [
+                    // Player
+                    {
+                        'targets': 0,
+                        'render': function (data, type, row) {
+
+                            let name = row[2];
+                            if (!row[11]) {
+                                name += ' <small class="text-muted">Scanning</small>';
+                            }
+
+                            return '<a href="' + row[3] + '" class="icon-name"><div class="icon"><img data-lazy="' + row[4] + '" data-src="/assets/img/no-player-image.jpg" alt="" data-lazy-alt="' + row[2] + '"></div><div class="name">' + name + '</div></a>';
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).addClass('img');
+                        },
+                        'orderable': false,
+                    },
+                    // VAC Bans
+                    {
+                        'targets': 1,
+                        'render': function (data, type, row) {
+                            return row[5].toLocaleString();
+                        },
+                        'orderable': false,
+                    },
+                    // Game Bans
+                    {
+                        'targets': 2,
+                        'render': function (data, type, row) {
+                            return row[6].toLocaleString();
+                        },
+                        'orderable': false,
+                    },
+                    // Last Ban
+                    {
+                        'targets': 3,
+                        'render': function (data, type, row) {
+                            return row[7];
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Notify
+                    {
+                        'targets': 4,
+                        'render': function (data, type, row) {
+                            const channels = [];
+                            if (row[8]) {
+                                channels.push('<i class="fas fa-envelope" data-toggle="tooltip" data-placement="left" title="Email"></i>');
+                            }
+                            if (row[9]) {
+                                channels.push('<i class="fab fa-discord" data-toggle="tooltip" data-placement="left" title="Discord"></i>');
+                            }
+                            return channels.join(' ');
+                        },
+                        'orderable': false,
+                    },
+                    // Last Alert
+                    {
+                        'targets': 5,
+                        'render': function (data, type, row) {
+                            return row[10];
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Delete
+                    {
+                        'targets': 6,
+                        'render': function (data, type, row) {
+                            return '<a href="/settings/ban-watch/' + row[0] + '/delete" class="text-danger"><i class="fas fa-trash-alt"></i></a>';
+                        },
+                        'orderable': false,
+                    },
+                ],
+            },
+        });
+    }
+
+    function loadWebhooks() {
+
+        $('#webhooks table#webhooks-table').gdbTable({
+            tableOptions: {
+                'order': [[0, 'desc']],
+                'columnDefs': [
+                    // Time
+                    {
+                        'targets': 0,
+                        'render': function (data, type, row) {
+                            return '<span data-toggle="tooltip" data-placement="left" title="' + row[1] + '" data-livestamp="' + row[0] + '">' + row[1] + '</span>';
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Event
+                    {
+                        'targets': 1,
+                        'render': function (data, type, row) {
+                            return '<span data-toggle="tooltip" data-placement="left" title="' + row[10] + '">' + row[2] + '</span>';
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // URL
+                    {
+                        'targets': 2,
+                        'render': function (data, type, row) {
+                            return row[3];
+                        },
+                        'orderable': false,
+                    },
+                    // Attempt
+                    {
+                        'targets': 3,
+                        'render': function (data, type, row) {
+                            if (row[9]) {
+                                return row[4] + ' <small class="text-muted">(retrying)</small>';
+                            }
+                            return row[4];
+                        },
+                        'createdCell': function (td, cellData, rowData, row, col) {
+                            $(td).attr('nowrap', 'nowrap');
+                        },
+                        'orderable': false,
+                    },
+                    // Response
+                    {
+                        'targets': 4,
+                        'render': function (data, type, row) {
+                            if (row[8]) {
+                                return '<span class="text-success">' + row[5] + '</span> <small class="text-muted">' + row[7] + 'ms</small>';
+                            }
+                            return '<span class="text-danger">' + row[6] + '</span> <small class="text-muted">' + row[7] + 'ms</small>';
+                        },
+                        'orderable': false,
+                    },
+                ],
+            },
+        });
+    }
+
     function loadEvents() {
 
         // Setup drop downs
@@ -9,6 +9,7 @@ import (
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/mongo"
 	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func AchievementsRouter() http.Handler {
@@ -16,6 +17,8 @@ func AchievementsRouter() http.Handler {
 	r := chi.NewRouter()
 	r.Get("/", achievementsHandler)
 	r.Get("/achievements.json", achievementsAjaxHandler)
+	r.Get("/completionists", achievementsCompletionistsHandler)
+	r.Get("/completionists.json", achievementsCompletionistsAjaxHandler)
 	return r
 }
 
@@ -87,3 +90,72 @@ func achievementsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 
 	returnJSON(w, r, response)
 }
+
+func achievementsCompletionistsHandler(w http.ResponseWriter, r *http.Request) {
+
+	t := globalTemplate{}
+	t.fill(w, r, "achievements_completionists", "Completionists", "Games ranked by how many players unlock every achievement")
+
+	returnTemplate(w, r, t)
+}
+
+func achievementsCompletionistsAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	var query = datatable.NewDataTableQuery(r, true)
+
+	var columns = map[string]string{
+		"1": "completionist_percent",
+		"2": "players",
+		"3": "median_completion_seconds",
+	}
+
+	// Small samples give silly percentages
+	var filter = bson.D{{Key: "players", Value: bson.M{"$gte": 100}}}
+
+	var wg sync.WaitGroup
+
+	var list []mongo.AppAchievementStats
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		list, err = mongo.GetAppAchievementStatsList(query.GetOffset64(), 100, filter, query.GetOrderMongo(columns))
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionAppAchievementStats, filter, 60*60)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, count, nil)
+	for _, stats := range list {
+
+		response.AddRow([]interface{}{
+			stats.AppID,                // 0
+			stats.GetAppName(),         // 1
+			stats.GetAppIcon(),         // 2
+			stats.GetAppPath(),         // 3
+			stats.CompletionistPercent, // 4
+			stats.Completionists,       // 5
+			stats.Players,              // 6
+			stats.GetMedianCompletionSinceFirstAchievement(), // 7
+		})
+	}
+
+	returnJSON(w, r, response)
+}
@@ -51,8 +51,14 @@ func AdminRouter() http.Handler {
 	r.Get("/websockets", adminWebsocketsHandler)
 	r.Get("/discord-guilds", adminDiscordGuildsHandler)
 	r.Get("/discord-guilds.json", adminDiscordGuildsAjaxHandler)
+	r.Get("/failed", adminFailedHandler)
+	r.Get("/failed.json", adminFailedAjaxHandler)
+	r.Get("/failed/{id:[a-z0-9-]+}", adminFailedMessageHandler)
 	r.Post("/queues", adminQueuesHandler)
 	r.Post("/settings", adminSettingsHandler)
+	r.Post("/failed/replay", adminFailedReplayHandler)
+	r.Post("/failed/delete", adminFailedDeleteHandler)
+	r.Post("/failed/{id:[a-z0-9-]+}", adminFailedMessagePostHandler)
 	return r
 }
 
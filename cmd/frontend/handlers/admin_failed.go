@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/session"
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const adminFailedBulkLimit = 10_000
+
+func adminFailedHandler(w http.ResponseWriter, r *http.Request) {
+
+	t := adminFailedTemplate{}
+	t.fill(w, r, "admin_failed", "Admin", "Admin")
+	t.addAssetChosen()
+
+	queues, err := mongo.GetDistict(mongo.CollectionFailedMessages, "queue")
+	if err != nil {
+		log.ErrS(err)
+	} else {
+		for _, v := range queues {
+			if s, ok := v.(string); ok {
+				t.Queues = append(t.Queues, s)
+			}
+		}
+	}
+
+	returnTemplate(w, r, t)
+}
+
+type adminFailedTemplate struct {
+	globalTemplate
+	Queues []string
+}
+
+func adminFailedAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	query := datatable.NewDataTableQuery(r, false)
+
+	var replayed *bool
+	switch query.GetSearchString("status") {
+	case "pending":
+		replayed = new(bool)
+	case "replayed":
+		replayed = new(bool)
+		*replayed = true
+	}
+
+	filter := mongo.FailedMessagesFilter(query.GetSearchSlice("queue"), query.GetSearchString("reason"), replayed)
+
+	var wg sync.WaitGroup
+
+	// Get messages
+	var messages []mongo.FailedMessage
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		messages, err = mongo.GetFailedMessages(query.GetOffset64(), 100, filter)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get count
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionFailedMessages, filter, 60)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Wait
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, count, nil)
+	for _, fm := range messages {
+
+		response.AddRow([]interface{}{
+			fm.UUID,                              // 0
+			fm.CreatedAt.Format(helpers.DateSQL), // 1
+			fm.Queue,                             // 2
+			fm.Attempt,                           // 3
+			fm.FirstSeen.Format(helpers.DateSQL), // 4
+			fm.Reason,                            // 5
+			fm.GetBodyShort(),                    // 6
+			fm.GetPath(),                         // 7
+			fm.IsReplayed(),                      // 8
+			fm.Edited,                            // 9
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
+func adminFailedMessageHandler(w http.ResponseWriter, r *http.Request) {
+
+	fm, err := mongo.GetFailedMessage(chi.URLParam(r, "id"))
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Sorry but we can not find this message."})
+			return
+		}
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: 500})
+		return
+	}
+
+	t := adminFailedMessageTemplate{}
+	t.fill(w, r, "admin_failed_message", "Admin", "Admin")
+	t.Message = fm
+
+	// Pretty print for editing
+	var out = map[string]interface{}{}
+	if json.Unmarshal([]byte(fm.Body), &out) == nil {
+		if b, err := json.MarshalIndent(out, "", "    "); err == nil {
+			t.Body = string(b)
+		}
+	}
+	if t.Body == "" {
+		t.Body = fm.Body
+	}
+
+	returnTemplate(w, r, t)
+}
+
+type adminFailedMessageTemplate struct {
+	globalTemplate
+	Message mongo.FailedMessage
+	Body    string
+}
+
+func adminFailedMessagePostHandler(w http.ResponseWriter, r *http.Request) {
+
+	id := chi.URLParam(r, "id")
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/admin/failed/"+id, http.StatusFound)
+	}()
+
+	err := r.ParseForm()
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	body := strings.TrimSpace(r.PostForm.Get("body"))
+	if !json.Valid([]byte(body)) {
+		session.SetFlash(r, session.SessionBad, "Payload is not valid JSON")
+		return
+	}
+
+	// Compact it back down
+	var out interface{}
+	err = json.Unmarshal([]byte(body), &out)
+	if err == nil {
+		var b []byte
+		b, err = json.Marshal(out)
+		body = string(b)
+	}
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Payload is not valid JSON")
+		return
+	}
+
+	err = mongo.UpdateFailedMessageBody(id, body)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong saving the payload")
+		return
+	}
+
+	if r.PostForm.Get("replay") == "1" {
+
+		fm, err := mongo.GetFailedMessage(id)
+		if err != nil {
+			log.ErrS(err)
+			session.SetFlash(r, session.SessionBad, "Something went wrong replaying the message")
+			return
+		}
+
+		replayed, err := consumers.ReplayFailedMessages([]mongo.FailedMessage{fm})
+		if err != nil || len(replayed) == 0 {
+			if err != nil {
+				log.ErrS(err)
+			}
+			session.SetFlash(r, session.SessionBad, "Payload saved, but it could not be replayed to "+fm.Queue)
+			return
+		}
+
+		session.SetFlash(r, session.SessionGood, "Payload saved and replayed to "+fm.Queue)
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Payload saved")
+}
+
+// Acts on the selected IDs, or everything matching the filter if none are selected
+func adminFailedBulkFilter(r *http.Request) (filter bson.D, err error) {
+
+	err = r.ParseForm()
+	if err != nil {
+		return filter, err
+	}
+
+	var ids []string
+	for _, v := range strings.Split(r.PostForm.Get("ids"), ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			ids = append(ids, v)
+		}
+	}
+
+	if len(ids) > 0 {
+		return bson.D{{Key: "_id", Value: bson.M{"$in": ids}}}, nil
+	}
+
+	var queues []string
+	for _, v := range r.PostForm["queue"] {
+		if v != "" {
+			queues = append(queues, v)
+		}
+	}
+
+	pending := false
+
+	return mongo.FailedMessagesFilter(queues, r.PostForm.Get("reason"), &pending), nil
+}
+
+func adminFailedReplayHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/admin/failed", http.StatusFound)
+	}()
+
+	filter, err := adminFailedBulkFilter(r)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	messages, err := mongo.GetFailedMessages(0, adminFailedBulkLimit, filter)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong loading messages")
+		return
+	}
+
+	replayed, err := consumers.ReplayFailedMessages(messages)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	// Messages with invalid JSON or an unknown queue
+	var skipped = len(messages) - len(replayed)
+	var message = strconv.Itoa(len(replayed)) + " messages replayed"
+	if skipped > 0 {
+		message += ", " + strconv.Itoa(skipped) + " skipped"
+	}
+
+	if err != nil || skipped > 0 {
+		session.SetFlash(r, session.SessionBad, message)
+	} else {
+		session.SetFlash(r, session.SessionGood, message)
+	}
+}
+
+func adminFailedDeleteHandler(w http.ResponseWriter, r *http.Request) {
+
+	filter, err := adminFailedBulkFilter(r)
+
+	// Deleting from the filter also includes replayed messages
+	if err == nil && len(filter) > 0 && filter[len(filter)-1].Key == "replayed_at" {
+		filter = filter[:len(filter)-1]
+	}
+
+	// An empty form would delete every message
+	if err == nil && len(filter) == 0 && r.PostForm.Get("all") != "1" {
+		returnErrorTemplate(w, r, errorTemplate{Code: 400, Message: "Choose messages or a filter, or confirm deleting all messages"})
+		return
+	}
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/admin/failed", http.StatusFound)
+	}()
+
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	count, err := mongo.DeleteFailedMessages(filter)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong deleting messages")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, strconv.FormatInt(count, 10)+" messages deleted")
+}
@@ -46,6 +46,8 @@ func appRouter() http.Handler {
 	r.Get("/news.json", appNewsAjaxHandler)
 	r.Get("/news-feeds.json", appNewsFeedsAjaxHandler)
 	r.Get("/packages.json", appPackagesAjaxHandler)
+	r.Get("/patches.json", appPatchesAjaxHandler)
+	r.Get("/pics-history.json", appPICSHistoryAjaxHandler)
 	r.Get("/players-heatmap.json", appPlayersHeatmapAjaxHandler)
 	r.Get("/players.json", appPlayersAjaxHandler(true))
 	r.Get("/players2.json", appPlayersAjaxHandler(false))
@@ -225,6 +227,28 @@ func appHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	// Get achievement analytics
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		if app.AchievementsCount == 0 {
+			return
+		}
+
+		stats, err := mongo.GetAppAchievementStats(app.ID)
+		if err != nil {
+			err = helpers.IgnoreErrors(err, mongo.ErrNoDocuments)
+			if err != nil {
+				log.ErrS(err)
+			}
+			return
+		}
+
+		t.AchievementStats = &stats
+	}()
+
 	// Get players count
 	wg.Add(1)
 	go func() {
@@ -297,6 +321,8 @@ func appHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Functions that get called multiple times in the template
 	t.Price = app.Prices.Get(session.GetProductCC(r))
+	t.RegionPrices = getRegionPrices(r, app.GetPrices())
+	t.PriceStats = getPriceStats(r, helpers.ProductTypeApp, app.ID)
 	t.Common = app.Common.Formatted(app.ID, pics.CommonKeys)
 	t.Extended = app.Extended.Formatted(app.ID, pics.ExtendedKeys)
 	t.Config = app.Config.Formatted(app.ID, pics.ConfigKeys)
@@ -333,6 +359,10 @@ func appHandler(w http.ResponseWriter, r *http.Request) {
 		banners["primary"] = append(banners["primary"], playedMessage)
 	}
 
+	if app.IsReviewBombed() {
+		banners["warning"] = append(banners["warning"], app.GetName()+" has had an unusual number of negative reviews recently, the review score may not reflect the game itself.")
+	}
+
 	if app.ReadPICS(app.Common).GetValue("app_retired_publisher_request") == "1" {
 		banners["warning"] = append(banners["warning"], "At the request of the publisher, "+app.GetName()+" is no longer available for sale on Steam.")
 	}
@@ -387,22 +417,25 @@ func appHandler(w http.ResponseWriter, r *http.Request) {
 
 type appTemplate struct {
 	globalTemplate
-	App           mongo.App
-	PlayersCount  int64
-	Banners       map[string][]string
-	Common        []pics.KeyValue
-	Config        []pics.KeyValue
-	Demos         []mongo.App
-	Extended      []pics.KeyValue
-	Links         []appLinkTemplate
-	Price         helpers.ProductPrice
-	TagsMax       int
-	UFS           []pics.KeyValue
-	PlayersInGame int64
-	GroupPath     string
-	Countries     []AppCountry
-	APIKey        string
-	Timezones     []string
+	App              mongo.App
+	PlayersCount     int64
+	Banners          map[string][]string
+	Common           []pics.KeyValue
+	Config           []pics.KeyValue
+	Demos            []mongo.App
+	Extended         []pics.KeyValue
+	Links            []appLinkTemplate
+	Price            helpers.ProductPrice
+	RegionPrices     helpers.RegionPrices
+	PriceStats       *mongo.ProductPriceStats
+	TagsMax          int
+	AchievementStats *mongo.AppAchievementStats
+	UFS              []pics.KeyValue
+	PlayersInGame    int64
+	GroupPath        string
+	Countries        []AppCountry
+	APIKey           string
+	Timezones        []string
 
 	// Stats
 	Categories []mongo.Stat
@@ -1075,6 +1108,7 @@ func appItemsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			item.GetType(),          // 27
 			item.Link(),             // 28
 			item.ShortDescription(), // 29
+			item.GetPath(),          // 30
 		})
 	}
 
@@ -1126,6 +1160,26 @@ func appBundlesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 	returnJSON(w, r, response)
 }
 
+func appPatchesAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.Atoi(helpers.RegexIntsOnly.FindString(chi.URLParam(r, "id")))
+	if err != nil || !helpers.IsValidAppID(id) {
+		return
+	}
+
+	depotHistoryAjax(w, r, bson.D{{"app_id", id}})
+}
+
+func appPICSHistoryAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.Atoi(helpers.RegexIntsOnly.FindString(chi.URLParam(r, "id")))
+	if err != nil || !helpers.IsValidAppID(id) {
+		return
+	}
+
+	picsHistoryAjax(w, r, bson.D{{"app_id", id}})
+}
+
 func appPackagesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.Atoi(helpers.RegexIntsOnly.FindString(chi.URLParam(r, "id")))
@@ -96,6 +96,18 @@ func appsCompareHandler(w http.ResponseWriter, r *http.Request) {
 	t.GroupIDs = strings.Join(groupIDs, ",")
 	t.PlayersCount = playersCount
 
+	for _, app := range apps {
+
+		regions := getRegionPrices(r, app.GetPrices())
+		local, _ := regions.Get(session.GetProductCC(r))
+
+		t.RegionPrices = append(t.RegionPrices, appsCompareRegionTemplate{
+			App:      app,
+			Local:    local,
+			Cheapest: regions.Cheapest(),
+		})
+	}
+
 	b, err := json.Marshal(namesMap)
 	if err != nil {
 		log.ErrS(err)
@@ -151,6 +163,13 @@ type appsCompareTemplate struct {
 	GroupNames   template.JS
 	GoogleJSON   []template.JS
 	PlayersCount int64
+	RegionPrices []appsCompareRegionTemplate
+}
+
+type appsCompareRegionTemplate struct {
+	App      mongo.App
+	Local    helpers.RegionPrice
+	Cheapest helpers.RegionPrice
 }
 
 type appsCompareGoogleTemplate struct {
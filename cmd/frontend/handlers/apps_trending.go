@@ -68,7 +68,7 @@ func trendingAppsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			"4": "player_trend",
 		}
 
-		projection := bson.M{"_id": 1, "name": 1, "icon": 1, "prices": 1, "player_trend": 1, "player_peak_week": 1}
+		projection := bson.M{"_id": 1, "name": 1, "icon": 1, "prices": 1, "player_trend": 1, "player_peak_week": 1, "review_bomb_at": 1}
 		order := query.GetOrderMongo(columns)
 		offset := query.GetOffset64()
 
@@ -124,6 +124,7 @@ func trendingAppsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			app.Prices.Get(code).GetFinal(), // 4
 			app.GetTrend(),                  // 5
 			app.PlayerPeakWeek,              // 6
+			app.IsReviewBombed(),            // 7
 		})
 	}
 
@@ -9,10 +9,12 @@ import (
 
 	"github.com/gamedb/gamedb/pkg/consumers"
 	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/mongo"
 	"github.com/gamedb/gamedb/pkg/session"
 	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func BundleRouter() http.Handler {
@@ -96,6 +98,28 @@ func bundleHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	// Get owned apps, for complete the set prices
+	var ownedApps = map[int]bool{}
+	var playerID = session.GetPlayerIDFromSesion(r)
+	if playerID > 0 && bundle.Type == mongo.BundleTypeCompleteTheSet {
+
+		wg.Add(1)
+		go func() {
+
+			defer wg.Done()
+
+			playerApps, err := mongo.GetPlayerAppsByPlayer(playerID, 0, 0, nil, bson.M{"app_id": 1}, bson.D{{"app_id", bson.M{"$in": bundle.Apps}}})
+			if err != nil {
+				log.ErrS(err)
+				return
+			}
+
+			for _, v := range playerApps {
+				ownedApps[v.AppID] = true
+			}
+		}()
+	}
+
 	// Wait
 	wg.Wait()
 
@@ -126,6 +150,19 @@ func bundleHandler(w http.ResponseWriter, r *http.Request) {
 		t.PriceSale = "-"
 	}
 
+	// Value
+	t.LoggedIn = playerID > 0
+	t.Value = bundle.GetValue(session.GetProductCC(r), packages, ownedApps)
+
+	for _, prodCC := range i18n.GetProdCCs(true) {
+		if _, ok := bundle.PricesIndividual[prodCC.ProductCode]; ok {
+			t.Regions = append(t.Regions, bundleRegionTemplate{
+				Region: prodCC,
+				Value:  bundle.GetValue(prodCC.ProductCode, packages, nil),
+			})
+		}
+	}
+
 	//
 	returnTemplate(w, r, t)
 }
@@ -137,6 +174,18 @@ type bundleTemplate struct {
 	Packages  []mongo.Package
 	Price     string
 	PriceSale string
+	Value     mongo.BundleValue
+	Regions   []bundleRegionTemplate
+	LoggedIn  bool
+}
+
+type bundleRegionTemplate struct {
+	Region i18n.ProductCountryCode
+	Value  mongo.BundleValue
+}
+
+func (t bundleRegionTemplate) GetFlag() string {
+	return "/assets/img/flags/" + t.Region.GetFlag() + ".png"
 }
 
 func bundlePricesAjaxHandler(w http.ResponseWriter, r *http.Request) {
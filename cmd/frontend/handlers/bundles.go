@@ -61,10 +61,11 @@ func bundlesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			// "0": "name",
 			"1": "discount_sale",
 			"2": "prices_sale." + string(code),
-			"3": "apps",
-			// "4": "giftable",
-			// "5": "type,
-			"6": "created_at",
+			"3": "savings." + string(code),
+			"4": "apps",
+			// "5": "giftable",
+			// "6": "type,
+			"7": "created_at",
 		}
 
 		var filters []elastic.Query
@@ -85,6 +85,19 @@ func changeHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	// Get PICS changes
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.PICSHistory, err = mongo.GetProductPICSHistories(0, 1000, bson.D{{Key: "change_number", Value: change.ID}})
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
 	// Get previous
 	wg.Add(1)
 	go func() {
@@ -121,9 +134,10 @@ func changeHandler(w http.ResponseWriter, r *http.Request) {
 
 type changeTemplate struct {
 	globalTemplate
-	Change   mongo.Change
-	Apps     map[int]mongo.App
-	Packages map[int]mongo.Package
-	Next     mongo.Change
-	Previous mongo.Change
+	Change      mongo.Change
+	Apps        map[int]mongo.App
+	Packages    map[int]mongo.Package
+	Next        mongo.Change
+	Previous    mongo.Change
+	PICSHistory []mongo.ProductPICSHistory
 }
@@ -4,7 +4,6 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
-	"sync"
 
 	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
 	"github.com/gamedb/gamedb/pkg/consumers"
@@ -12,6 +11,7 @@ import (
 	"github.com/gamedb/gamedb/pkg/helpers"
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/session"
 	"github.com/go-chi/chi/v5"
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -161,6 +161,7 @@ func coopGames(w http.ResponseWriter, r *http.Request) {
 
 	query := datatable.NewDataTableQuery(r, true)
 	ids := helpers.StringToSlice(query.GetSearchString("ids"), ",")
+	code := session.GetProductCC(r)
 
 	var playerIDs []int64
 	for _, v := range ids {
@@ -201,123 +202,44 @@ func coopGames(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Make a map of all games the players have
-	var allApps = map[int]bool{}
-	var allAppsByPlayer = map[int64][]int{}
-
-	playerApps, err := mongo.GetPlayerAppsByPlayers(foundPlayerIDs, bson.M{"_id": 0, "player_id": 1, "app_id": 1})
+	games, err := mongo.GetCoopGames(foundPlayerIDs, code, 0)
 	if err != nil {
 		log.ErrS(err)
 		return
 	}
 
-	for _, playerApp := range playerApps {
-
-		allApps[playerApp.AppID] = true
+	var count = int64(len(games))
 
-		_, ok := allAppsByPlayer[playerApp.PlayerID]
-		if ok {
-			allAppsByPlayer[playerApp.PlayerID] = append(allAppsByPlayer[playerApp.PlayerID], playerApp.AppID)
-		} else {
-			allAppsByPlayer[playerApp.PlayerID] = []int{playerApp.AppID}
-		}
+	var offset = query.GetOffset()
+	if offset > len(games) {
+		offset = len(games)
 	}
 
-	// Remove apps that are not in a users apps
-	for appID := range allApps {
-
-		// Loop each user
-		for _, gamesSlice := range allAppsByPlayer {
-
-			if !helpers.SliceHasInt(gamesSlice, appID) {
-				delete(allApps, appID)
-				break
-			}
-		}
-	}
-
-	var count int64
-	var games []coopGameTemplate
-
-	if len(allApps) > 0 {
-
-		// Convert to slice
-		var appsSlice bson.A
-		for appID := range allApps {
-			appsSlice = append(appsSlice, appID)
-		}
-
-		filter := bson.D{
-			// {"type", "game"},
-			{Key: "tags", Value: bson.M{"$in": bson.A{128, 1685, 3843, 3841, 4508, 3859, 7368, 17770}}},
-			{Key: "_id", Value: bson.M{"$in": appsSlice}},
-		}
-
-		projection := bson.M{"id": 1, "name": 1, "icon": 1, "platforms": 1, "achievements_count": 1, "tags": 1}
-
-		var wg sync.WaitGroup
-
-		wg.Add(1)
-		go func() {
-
-			defer wg.Done()
-
-			apps, err := mongo.GetApps(query.GetOffset64(), 100, bson.D{{Key: "reviews_score", Value: -1}}, filter, projection)
-			if err != nil {
-				log.ErrS(err)
-			}
-
-			// Make visible tags
-			for _, app := range apps {
-
-				coopTags, err := app.GetCoopTags()
-				if err != nil {
-					log.ErrS(err)
-					continue
-				}
-
-				games = append(games, coopGameTemplate{
-					Game: app,
-					Tags: coopTags,
-				})
-			}
-		}()
-
-		wg.Add(1)
-		go func() {
-
-			defer wg.Done()
-
-			var err error
-			count, err = mongo.CountDocuments(mongo.CollectionApps, filter, 60*60)
-			if err != nil {
-				log.ErrS(err)
-			}
-		}()
-
-		wg.Wait()
+	games = games[offset:]
+	if len(games) > 100 {
+		games = games[0:100]
 	}
 
 	response := datatable.NewDataTablesResponse(r, query, count, count, nil)
 
-	for _, app := range games {
+	for _, game := range games {
 
 		response.AddRow([]interface{}{
-			app.Game.ID,                  // 0
-			app.Game.GetName(),           // 1
-			app.Game.GetIcon(),           // 2
-			app.Game.GetPlatformImages(), // 3
-			app.Game.AchievementsCount,   // 4
-			app.Tags,                     // 5
-			app.Game.GetStoreLink(),      // 6
-			app.Game.GetPath(),           // 7
+			game.App.ID,                                 // 0
+			game.App.GetName(),                          // 1
+			game.App.GetIcon(),                          // 2
+			game.App.GetPlatformImages(),                // 3
+			game.App.AchievementsCount,                  // 4
+			strings.Join(game.Tags, ", "),               // 5
+			game.App.GetStoreLink(),                     // 6
+			game.App.GetPath(),                          // 7
+			game.Score,                                  // 8
+			len(game.Owners),                            // 9
+			len(foundPlayerIDs),                         // 10
+			helpers.GetTimeShort(game.RecentMinutes, 2), // 11
+			game.GetMissingPrice(),                      // 12
 		})
 	}
 
 	returnJSON(w, r, response)
 }
-
-type coopGameTemplate struct {
-	Game mongo.App
-	Tags string
-}
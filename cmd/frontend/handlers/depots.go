@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql/pics"
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func DepotsRouter() http.Handler {
+
+	r := chi.NewRouter()
+	r.Get("/", depotsHandler)
+	r.Get("/depots.json", depotsAjaxHandler)
+	r.Get("/{id:[0-9]+}", depotHandler)
+	r.Get("/{id:[0-9]+}/history.json", depotHistoryAjaxHandler)
+	return r
+}
+
+func depotsHandler(w http.ResponseWriter, r *http.Request) {
+
+	t := depotsTemplate{}
+	t.fill(w, r, "depots", "Depots", "Every new build and depot manifest we see in Steam's PICS data.")
+
+	returnTemplate(w, r, t)
+}
+
+type depotsTemplate struct {
+	globalTemplate
+}
+
+func depotsAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	depotHistoryAjax(w, r, bson.D{})
+}
+
+func depotHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Invalid Depot ID."})
+		return
+	}
+
+	// Depots are only stored on apps, so use the history to find the app
+	histories, err := mongo.GetAppDepotHistories(0, 1, bson.D{{"depot_id", id}})
+	if err != nil {
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: 500, Message: "There was an issue retrieving the depot."})
+		return
+	}
+
+	if len(histories) == 0 {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "We haven't seen any changes to this depot yet."})
+		return
+	}
+
+	app, err := mongo.GetApp(histories[0].AppID)
+	if err != nil && err != mongo.ErrNoDocuments {
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: 500, Message: "There was an issue retrieving the depot."})
+		return
+	}
+
+	t := depotTemplate{}
+	t.Depot = pics.AppDepotItem{ID: id, Name: histories[0].DepotName}
+	t.App = app
+
+	for _, v := range app.Depots.Depots {
+		if v.ID == id {
+			t.Depot = v
+			break
+		}
+	}
+
+	var title = t.Depot.Name
+	if title == "" {
+		title = "Depot " + strconv.Itoa(id)
+	}
+
+	t.fill(w, r, "depot", title, template.HTML("Build history for depot "+strconv.Itoa(id)+"."))
+
+	returnTemplate(w, r, t)
+}
+
+type depotTemplate struct {
+	globalTemplate
+	Depot pics.AppDepotItem
+	App   mongo.App
+}
+
+func depotHistoryAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		return
+	}
+
+	depotHistoryAjax(w, r, bson.D{{"depot_id", id}})
+}
+
+// Shared by the depot pages and the app patch history tab
+func depotHistoryAjax(w http.ResponseWriter, r *http.Request, filter bson.D) {
+
+	query := datatable.NewDataTableQuery(r, true)
+
+	var wg sync.WaitGroup
+
+	var histories []mongo.AppDepotHistory
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		histories, err = mongo.GetAppDepotHistories(query.GetOffset64(), 100, filter)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionAppDepotHistory, filter, 60*60)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, count, nil)
+	for _, v := range histories {
+		response.AddRow(v.OutputForJSON())
+	}
+
+	returnJSON(w, r, response)
+}
@@ -1,40 +1,204 @@
 package handlers
 
 import (
-	"fmt"
+	"html/template"
 	"net/http"
+	"regexp"
+	"sort"
 	"strconv"
+	"sync"
 
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
+	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/session"
 	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func FranchiseRouter() http.Handler {
 
 	r := chi.NewRouter()
 	r.Get("/", franchisesHandler)
+	r.Get("/franchises.json", franchisesAjaxHandler)
 	r.Get("/{id}", franchiseHandler)
 	return r
 }
 
 func franchisesHandler(w http.ResponseWriter, r *http.Request) {
 
+	t := franchisesTemplate{}
+	t.fill(w, r, "franchises", "Franchises", "Series of games that share a name and a publisher or developer.")
+	t.addAssetMark()
+
+	returnTemplate(w, r, t)
 }
 
-func franchiseHandler(w http.ResponseWriter, r *http.Request) {
+type franchisesTemplate struct {
+	globalTemplate
+}
 
-	// Get publisher
-	id, err := strconv.Atoi(chi.URLParam(r, "id"))
-	if err != nil {
-		returnErrorTemplate(w, r, errorTemplate{Code: 400, Message: "Invalid App ID"})
-		return
+func franchisesAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	var query = datatable.NewDataTableQuery(r, true)
+	var code = session.GetProductCC(r)
+
+	var columns = map[string]string{
+		"0": "name",
+		"1": "apps",
+		"2": "players_week",
+		"3": "reviews_score",
+		"4": "owners",
+		"5": "prices." + string(code),
+	}
+
+	var filter = bson.D{}
+
+	search := query.GetSearchString("search")
+	if len(search) > 1 {
+		filter = append(filter, bson.E{Key: "name", Value: bson.M{"$regex": regexp.QuoteMeta(search), "$options": "i"}})
+	}
+
+	var wg sync.WaitGroup
+
+	// Get rows
+	var franchises []mongo.Franchise
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		franchises, err = mongo.GetFranchises(query.GetOffset64(), 100, filter, query.GetOrderMongo(columns))
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get total
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionFranchises, nil, 0)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get filtered
+	var filtered int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		filtered, err = mongo.CountDocuments(mongo.CollectionFranchises, filter, 0)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, filtered, nil)
+	for _, franchise := range franchises {
+
+		response.AddRow([]interface{}{
+			franchise.ID,               // 0
+			franchise.Name,             // 1
+			franchise.GetPath(),        // 2
+			franchise.GetIcon(),        // 3
+			franchise.Apps,             // 4
+			franchise.PlayersWeek,      // 5
+			franchise.GetReviewScore(), // 6
+			franchise.Owners,           // 7
+			franchise.GetPrice(code),   // 8
+		})
 	}
 
-	publisher, err := mongo.GetStat(mongo.StatsTypePublishers, id)
-	if err != nil {
-		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Invalid App ID"})
+	returnJSON(w, r, response)
+}
+
+func franchiseHandler(w http.ResponseWriter, r *http.Request) {
+
+	franchise, err := mongo.GetFranchise(chi.URLParam(r, "id"))
+	if err == mongo.ErrNoDocuments {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Sorry but we can not find this franchise."})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: 500, Message: "There was an issue retrieving the franchise."})
 		return
 	}
 
-	fmt.Println(publisher.Name)
+	t := franchiseTemplate{}
+	t.fill(w, r, "franchise", franchise.Name, template.HTML(template.HTMLEscapeString("The "+franchise.Name+" franchise, "+strconv.Itoa(franchise.Apps)+" games")))
+	t.Franchise = franchise
+	t.Price = franchise.GetPrice(session.GetProductCC(r))
+
+	var wg sync.WaitGroup
+
+	// Get apps
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var projection = bson.M{"_id": 1, "name": 1, "icon": 1, "player_peak_week": 1, "reviews_score": 1, "release_date_unix": 1, "release_date": 1, "prices": 1}
+
+		var err error
+		t.Apps, err = mongo.GetAppsByID(franchise.AppIDs, projection)
+		if err != nil {
+			log.ErrS(err)
+			return
+		}
+
+		sort.Slice(t.Apps, func(i, j int) bool {
+			return t.Apps[i].ReleaseDateUnix < t.Apps[j].ReleaseDateUnix
+		})
+	}()
+
+	// Get publishers
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.Publishers, err = mongo.GetStatsByID(mongo.StatsTypePublishers, franchise.Publishers)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get developers
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.Developers, err = mongo.GetStatsByID(mongo.StatsTypeDevelopers, franchise.Developers)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	returnTemplate(w, r, t)
+}
+
+type franchiseTemplate struct {
+	globalTemplate
+	Franchise  mongo.Franchise
+	Price      string
+	Apps       []mongo.App
+	Publishers []mongo.Stat
+	Developers []mongo.Stat
 }
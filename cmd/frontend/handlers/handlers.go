@@ -612,6 +612,7 @@ func (t globalTemplate) IsStatsPage() bool {
 		strings.HasPrefix(t.Path, "/genres") ||
 		strings.HasPrefix(t.Path, "/publishers") ||
 		strings.HasPrefix(t.Path, "/developers") ||
+		strings.HasPrefix(t.Path, "/franchise") ||
 		strings.HasPrefix(t.Path, "/categories")
 }
 
@@ -635,7 +636,7 @@ func (t globalTemplate) IsSettingsPage() bool {
 
 func (t globalTemplate) IsMorePage() bool {
 
-	if strings.HasPrefix(t.Path, "/chat") || strings.HasPrefix(t.Path, "/experience") {
+	if strings.HasPrefix(t.Path, "/chat") || strings.HasPrefix(t.Path, "/experience") || strings.HasPrefix(t.Path, "/items") {
 		return true
 	}
 	return helpers.SliceHasString(strings.TrimPrefix(t.Path, "/"), []string{"achievements", "discord-bot", "contact", "info", "queues", "info", "steam-api", "api"})
@@ -813,3 +814,29 @@ func getPlayerFromSession(r *http.Request) (player mongo.Player, err error) {
 
 	return mongo.GetPlayer(playerID)
 }
+
+// Prices in every region, converted into the user's currency
+func getRegionPrices(r *http.Request, prices helpers.ProductPrices) helpers.RegionPrices {
+
+	rates, err := mongo.GetExchangeRates()
+	if err != nil {
+		log.ErrS(err)
+		return nil
+	}
+
+	return prices.RegionPrices(rates, session.GetProductCC(r))
+}
+
+// Historical lows etc in the user's region, nil if there is no price history
+func getPriceStats(r *http.Request, productType helpers.ProductType, productID int) *mongo.ProductPriceStats {
+
+	stats, err := mongo.GetProductPriceStats(productType, productID, session.GetProductCC(r))
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			log.ErrS(err)
+		}
+		return nil
+	}
+
+	return &stats
+}
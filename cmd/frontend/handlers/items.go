@@ -0,0 +1,305 @@
+package handlers
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/session"
+	"github.com/go-chi/chi/v5"
+	"github.com/olivere/elastic/v7"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func ItemsRouter() http.Handler {
+
+	r := chi.NewRouter()
+	r.Get("/", itemsHandler)
+	r.Get("/items.json", itemsAjaxHandler)
+	r.Get("/{app:[0-9]+}/{id:[0-9]+}", itemHandler)
+	return r
+}
+
+func itemsHandler(w http.ResponseWriter, r *http.Request) {
+
+	t := itemsTemplate{}
+	t.fill(w, r, "items", "Items", "Search inventory items from every Steam game")
+	t.addAssetChosen()
+
+	// Facet options come from the search, so add any in the url
+	t.Type = r.URL.Query().Get("type")
+	t.Quality = r.URL.Query().Get("quality")
+	t.Tags = r.URL.Query()["tags"]
+
+	returnTemplate(w, r, t)
+}
+
+type itemsTemplate struct {
+	globalTemplate
+	Type    string
+	Quality string
+	Tags    []string
+}
+
+func itemsAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	var query = datatable.NewDataTableQuery(r, false)
+	var currency = i18n.GetProdCC(session.GetProductCC(r)).CurrencyCode
+
+	var wg sync.WaitGroup
+
+	var items []elasticsearch.Item
+	var aggregations map[string]map[string]int64
+	var filtered int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var sorters = query.GetOrderElastic(map[string]string{
+			"3": "prices." + string(currency),
+			"4": "recipes",
+		})
+
+		var filters []elastic.Query
+
+		if val := query.GetSearchString("type"); val != "" {
+			filters = append(filters, elastic.NewTermQuery("type", val))
+		}
+
+		if val := query.GetSearchString("quality"); val != "" {
+			filters = append(filters, elastic.NewTermQuery("quality", val))
+		}
+
+		// Items must have every tag
+		for _, tag := range query.GetSearchSlice("tags") {
+			filters = append(filters, elastic.NewTermQuery("tags", tag))
+		}
+
+		switch query.GetSearchString("tradable") {
+		case "1":
+			filters = append(filters, elastic.NewTermQuery("tradable", true))
+		case "0":
+			filters = append(filters, elastic.NewTermQuery("tradable", false))
+		}
+
+		switch query.GetSearchString("marketable") {
+		case "1":
+			filters = append(filters, elastic.NewTermQuery("marketable", true))
+		}
+
+		var err error
+		items, aggregations, filtered, err = elasticsearch.SearchItems(query.GetOffset(), 100, query.GetSearchString("search"), sorters, filters)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionAppItems, nil, 60*60*24)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, filtered, aggregations)
+	for _, item := range items {
+		response.AddRow(item.OutputForJSON(currency))
+	}
+
+	returnJSON(w, r, response)
+}
+
+func itemHandler(w http.ResponseWriter, r *http.Request) {
+
+	appID, err := strconv.Atoi(chi.URLParam(r, "app"))
+	if err != nil {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Invalid App ID."})
+		return
+	}
+
+	itemDefID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Invalid Item ID."})
+		return
+	}
+
+	item, err := mongo.GetAppItem(appID, itemDefID)
+	if err == mongo.ErrNoDocuments {
+		returnErrorTemplate(w, r, errorTemplate{Code: 404, Message: "Sorry but we can not find this item."})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: 500, Message: "There was an issue retrieving the item."})
+		return
+	}
+
+	t := itemTemplate{}
+	t.Item = item
+	t.Currency = i18n.GetProdCC(session.GetProductCC(r)).CurrencyCode
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.App, err = mongo.GetApp(appID)
+		if err != nil && err != mongo.ErrNoDocuments {
+			log.ErrS(err)
+		}
+	}()
+
+	// Recipe inputs
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var inputItems = map[int]mongo.AppItem{}
+
+		ids := item.GetRecipeItemDefIDs()
+		if len(ids) > 0 {
+
+			items, err := mongo.GetAppItems(0, 0, bson.D{{"app_id", appID}, {"item_def_id", bson.M{"$in": ids}}}, nil)
+			if err != nil {
+				log.ErrS(err)
+			}
+
+			for _, v := range items {
+				inputItems[v.ItemDefID] = v
+			}
+		}
+
+		for _, recipe := range item.GetRecipes() {
+
+			var itemRecipe itemRecipeTemplate
+			for _, input := range recipe.Inputs {
+
+				var node = itemRecipeNode{Quantity: input.Quantity}
+
+				if input.Tag != "" {
+					node.Name = input.Tag
+					node.Path = "/items?" + url.Values{"tags": []string{input.Tag}}.Encode()
+					node.Tag = true
+				} else if v, ok := inputItems[input.ItemDefID]; ok {
+					node.Name = v.Name
+					node.Path = v.GetPath()
+					node.Icon = v.Image(32, true)
+				} else {
+					node.Name = "Item " + strconv.Itoa(input.ItemDefID)
+				}
+
+				itemRecipe.Inputs = append(itemRecipe.Inputs, node)
+			}
+
+			t.Recipes = append(t.Recipes, itemRecipe)
+		}
+	}()
+
+	// Recipe outputs
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.UsedIn, err = mongo.GetAppItemsUsingItem(appID, itemDefID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	// Prices, users currency first
+	for currency, price := range item.GetPricesFormatted() {
+		t.Prices = append(t.Prices, itemPriceTemplate{Currency: currency, Price: price})
+	}
+
+	sort.Slice(t.Prices, func(i, j int) bool {
+		if t.Prices[i].Currency == t.Currency || t.Prices[j].Currency == t.Currency {
+			return t.Prices[i].Currency == t.Currency
+		}
+		return t.Prices[i].Currency < t.Prices[j].Currency
+	})
+
+	var name = item.Name
+	if name == "" {
+		name = "Item " + strconv.Itoa(itemDefID)
+	}
+
+	var description = "An inventory item"
+	if t.App.ID > 0 {
+		description += " from " + template.HTMLEscapeString(t.App.GetName())
+	}
+
+	t.fill(w, r, "item", name, template.HTML(description))
+	t.Canonical = item.GetPath()
+
+	returnTemplate(w, r, t)
+}
+
+type itemTemplate struct {
+	globalTemplate
+	Item     mongo.AppItem
+	App      mongo.App
+	Currency steamapi.CurrencyCode
+	Prices   []itemPriceTemplate
+	Recipes  []itemRecipeTemplate
+	UsedIn   []mongo.AppItem
+}
+
+func (t itemTemplate) GetIcon() string {
+	return t.Item.Image(256, false)
+}
+
+func (t itemTemplate) GetMarketLink() string {
+	return t.Item.Link()
+}
+
+func (t itemTemplate) GetTags() (tags []string) {
+
+	tags = t.Item.GetTagStrings()
+	sort.Slice(tags, func(i, j int) bool {
+		return strings.ToLower(tags[i]) < strings.ToLower(tags[j])
+	})
+	return tags
+}
+
+type itemPriceTemplate struct {
+	Currency steamapi.CurrencyCode
+	Price    string
+}
+
+type itemRecipeTemplate struct {
+	Inputs []itemRecipeNode
+}
+
+type itemRecipeNode struct {
+	Name     string
+	Path     string
+	Icon     string
+	Quantity int
+	Tag      bool
+}
@@ -20,6 +20,7 @@ func PackageRouter() http.Handler {
 	r := chi.NewRouter()
 	r.Get("/", packageHandler)
 	r.Get("/prices.json", packagePricesAjaxHandler)
+	r.Get("/pics-history.json", packagePICSHistoryAjaxHandler)
 	r.Get("/{slug}", packageHandler)
 	return r
 }
@@ -155,6 +156,8 @@ func packageHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Functions that get called multiple times in the template
 	t.Price = pack.Prices.Get(session.GetProductCC(r))
+	t.RegionPrices = getRegionPrices(r, pack.Prices)
+	t.PriceStats = getPriceStats(r, helpers.ProductTypePackage, pack.ID)
 	t.Controller = pack.Controller
 	t.Extended = t.Package.Extended.Formatted(pack.ID, pics.ExtendedKeys)
 
@@ -164,13 +167,15 @@ func packageHandler(w http.ResponseWriter, r *http.Request) {
 
 type packageTemplate struct {
 	globalTemplate
-	Apps       map[int]mongo.App
-	Bundles    []mongo.Bundle
-	Banners    map[string][]string
-	Controller pics.PICSController
-	Extended   []pics.KeyValue
-	Package    mongo.Package
-	Price      helpers.ProductPrice
+	Apps         map[int]mongo.App
+	Bundles      []mongo.Bundle
+	Banners      map[string][]string
+	Controller   pics.PICSController
+	Extended     []pics.KeyValue
+	Package      mongo.Package
+	Price        helpers.ProductPrice
+	PriceStats   *mongo.ProductPriceStats
+	RegionPrices helpers.RegionPrices
 }
 
 func (p packageTemplate) ShowDev() bool {
@@ -182,3 +187,13 @@ func packagePricesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 
 	productPricesAjaxHandler(w, r, helpers.ProductTypePackage)
 }
+
+func packagePICSHistoryAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil || id < 1 {
+		return
+	}
+
+	picsHistoryAjax(w, r, bson.D{{"package_id", id}})
+}
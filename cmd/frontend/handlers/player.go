@@ -45,6 +45,7 @@ func PlayerRouter() http.Handler {
 	r.Get("/achievement-days.json", playerAchievementDaysAjaxHandler)
 	r.Get("/achievement-influx.json", playerAchievementInfluxAjaxHandler)
 	r.Get("/achievements.json", playerAchievementsAjaxHandler)
+	r.Get("/activity.json", playerActivityAjaxHandler)
 	r.Get("/add-friends", playerAddFriendsHandler)
 	r.Get("/badges.json", playerBadgesAjaxHandler)
 	r.Get("/friends.json", playerFriendsAjaxHandler)
@@ -53,6 +54,8 @@ func PlayerRouter() http.Handler {
 	r.Get("/history.json", playersHistoryAjaxHandler)
 	r.Get("/recent.json", playerRecentAjaxHandler)
 	r.Get("/wishlist.json", playerWishlistAppsAjaxHandler)
+	r.Get("/wishlist-sales.json", playerWishlistSalesAjaxHandler)
+	r.Get("/wishlist-totals.json", playerWishlistTotalsAjaxHandler)
 	return r
 }
 
@@ -1044,6 +1047,123 @@ func playerWishlistAppsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 	returnJSON(w, r, response)
 }
 
+func playerWishlistSalesAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	query := datatable.NewDataTableQuery(r, false)
+
+	wishlistApps, err := mongo.GetPlayerWishlistAppsByPlayer(id, 0, 0, nil, bson.M{"app_id": 1})
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	var appIDs []int
+	for _, v := range wishlistApps {
+		appIDs = append(appIDs, v.AppID)
+	}
+
+	// The prices on the wishlist rows are from when the app was added
+	apps, err := mongo.GetAppsByID(appIDs, bson.M{"_id": 1, "name": 1, "icon": 1, "prices": 1})
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	var code = session.GetProductCC(r)
+	var currency = i18n.GetProdCC(code).CurrencyCode
+
+	var sales []mongo.App
+	for _, app := range apps {
+		if app.Prices.Get(code).DiscountPercent > 0 {
+			sales = append(sales, app)
+		}
+	}
+
+	sort.Slice(sales, func(i, j int) bool {
+		return sales[i].Prices.Get(code).DiscountPercent > sales[j].Prices.Get(code).DiscountPercent
+	})
+
+	var salesIDs []int
+	for _, app := range sales {
+		salesIDs = append(salesIDs, app.ID)
+	}
+
+	stats, err := mongo.GetProductPriceStatsForApps(salesIDs, code)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	var total = int64(len(sales))
+	var response = datatable.NewDataTablesResponse(r, query, total, total, nil)
+	for _, app := range sales {
+
+		var price = app.Prices.Get(code)
+		var lowest = "-"
+		var lowestAt = ""
+		var isLowest bool
+
+		if stat, ok := stats[app.ID]; ok && stat.AllTimeLow > 0 {
+			lowest = i18n.FormatPrice(currency, stat.AllTimeLow)
+			lowestAt = stat.AllTimeLowAt.Format(helpers.DateYear)
+			isLowest = price.Final <= stat.AllTimeLow
+		}
+
+		response.AddRow([]interface{}{
+			app.ID,                // 0
+			app.GetName(),         // 1
+			app.GetPath(),         // 2
+			app.GetIcon(),         // 3
+			price.GetFinal(),      // 4
+			price.GetInitial(),    // 5
+			price.DiscountPercent, // 6
+			lowest,                // 7
+			lowestAt,              // 8
+			isLowest,              // 9
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
+func playerWishlistTotalsAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id := helpers.RegexIntsOnly.FindString(chi.URLParam(r, "id"))
+	if id == "" {
+		return
+	}
+
+	builder := influxql.NewBuilder()
+	for _, v := range i18n.GetProdCCs(true) {
+		field := string(schemas.InfPlayersWishlistTotal(v.ProductCode))
+		builder.AddSelect("MAX("+field+")", "max_"+field)
+	}
+	builder.SetFrom(influx.InfluxGameDB, influx.InfluxRetentionPolicyAllTime.String(), influx.InfluxMeasurementPlayers.String())
+	builder.AddWhere("player_id", "=", id)
+	builder.AddWhere("time", ">", "now()-365d")
+	builder.AddGroupByTime("1d")
+	builder.SetFillNone()
+
+	resp, err := influx.InfluxQuery(builder)
+	if err != nil {
+		log.Err(err.Error(), zap.String("query", builder.String()))
+		return
+	}
+
+	var hc influx.HighChartsJSON
+
+	if len(resp.Results) > 0 && len(resp.Results[0].Series) > 0 {
+
+		hc = influx.InfluxResponseToHighCharts(resp.Results[0].Series[0], true)
+	}
+
+	returnJSON(w, r, hc)
+}
+
 func playerGroupsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 
 	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
@@ -1119,6 +1239,82 @@ func playerGroupsAjaxHandler(w http.ResponseWriter, r *http.Request) {
 	returnJSON(w, r, response)
 }
 
+func playerActivityAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	query := datatable.NewDataTableQuery(r, false)
+
+	var eventType = mongo.PlayerHistoryType(query.GetSearchString("type"))
+	if !eventType.IsValid() {
+		eventType = ""
+	}
+
+	//
+	var wg sync.WaitGroup
+
+	// Get events
+	var events []mongo.PlayerHistory
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		events, err = mongo.GetPlayerHistory(id, eventType, query.GetOffset64(), 100)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get totals
+	var total int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		total, err = mongo.CountPlayerHistory(id, "")
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	var filtered int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		filtered, err = mongo.CountPlayerHistory(id, eventType)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, total, filtered, nil)
+	for _, event := range events {
+
+		response.AddRow([]interface{}{
+			event.Type,             // 0
+			event.GetText(),        // 1
+			event.GetIcon(),        // 2
+			event.ItemPath,         // 3
+			event.CreatedAt.Unix(), // 4
+			event.GetCreatedNice(), // 5
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
 func playersUpdateAjaxHandler(w http.ResponseWriter, r *http.Request) {
 
 	message, success, err := func(r *http.Request) (string, bool, error) {
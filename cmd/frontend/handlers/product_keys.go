@@ -21,6 +21,7 @@ func ProductKeysRouter() http.Handler {
 	r := chi.NewRouter()
 	r.Get("/", productKeysHandler)
 	r.Get("/product-keys.json", productKeysAjaxHandler)
+	r.Get("/history.json", productKeysHistoryAjaxHandler)
 
 	return r
 }
@@ -218,3 +219,73 @@ type productKeyResult struct {
 	Product helpers.ProductInterface
 	Value   string
 }
+
+func productKeysHistoryAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	query := datatable.NewDataTableQuery(r, false)
+
+	var productType = query.GetSearchString("type")
+	var key = query.GetSearchString("key")
+
+	if !keyRegex.MatchString(key) {
+		return
+	}
+
+	keyParts := strings.SplitN(key, ".", 2)
+
+	var filter = bson.D{
+		{Key: "field", Value: keyParts[0]},
+		{Key: "key", Value: keyParts[1]},
+	}
+
+	if productType == "packages" {
+		filter = append(filter, bson.E{Key: "package_id", Value: bson.M{"$gt": 0}})
+	} else {
+		filter = append(filter, bson.E{Key: "app_id", Value: bson.M{"$gt": 0}})
+	}
+
+	picsHistoryAjax(w, r, filter)
+}
+
+// Shared by the product keys page and the app and package PICS history tabs
+func picsHistoryAjax(w http.ResponseWriter, r *http.Request, filter bson.D) {
+
+	query := datatable.NewDataTableQuery(r, true)
+
+	var wg sync.WaitGroup
+
+	var histories []mongo.ProductPICSHistory
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		histories, err = mongo.GetProductPICSHistories(query.GetOffset64(), 100, filter)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	var count int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		count, err = mongo.CountDocuments(mongo.CollectionProductPICSHistory, filter, 60*60)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, count, count, nil)
+	for _, v := range histories {
+		response.AddRow(v.OutputForJSON())
+	}
+
+	returnJSON(w, r, response)
+}
@@ -74,6 +74,24 @@ func queuesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
+		// Delayed messages wait in Mongo, not in the delay queue
+		builder = influxql.NewBuilder()
+		builder.AddSelect(`SUM("pending")`, "max_messages")
+		builder.SetFrom(influx.InfluxGameDB, influx.InfluxRetentionPolicyAllTime.String(), influx.InfluxMeasurementDelayQueue.String())
+		builder.AddWhere("time", ">=", "now() - 1h")
+		builder.AddGroupByTime("1m")
+		builder.SetFillNone()
+
+		resp, err = influx.InfluxQuery(builder)
+		if err != nil {
+			log.ErrS(builder.String())
+			return ret, err
+		}
+
+		if len(resp.Results) > 0 && len(resp.Results[0].Series) > 0 {
+			ret[string(consumers.QueueDelay)] = influx.InfluxResponseToHighCharts(resp.Results[0].Series[0], false)
+		}
+
 		return ret, err
 	}
 
@@ -403,9 +403,23 @@ func salesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 	// Wait
 	wg.Wait()
 
+	// Get deal scores
+	var appIDs []int
+	for _, sale := range sales {
+		appIDs = append(appIDs, sale.AppID)
+	}
+
+	stats, err := mongo.GetProductPriceStatsForApps(helpers.UniqueInt(appIDs), code)
+	if err != nil {
+		log.ErrS(err)
+	}
+
 	var response = datatable.NewDataTablesResponse(r, query, count, filtered, nil)
 	for _, sale := range sales {
 
+		stat := stats[sale.AppID]
+		price := sale.AppPrices[code]
+
 		response.AddRow([]interface{}{
 			sale.AppID,          // 0
 			sale.GetOfferName(), // 1
@@ -423,6 +437,8 @@ func salesAjaxHandler(w http.ResponseWriter, r *http.Request) {
 			helpers.GetAppType(sale.AppType),             // 13
 			sale.AppReleaseDateString,                    // 14
 			sale.AppCategories,                           // 15
+			stat.DealScore(price),                        // 16
+			stat.DealRating(price),                       // 17
 		})
 	}
 
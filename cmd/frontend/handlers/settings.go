@@ -3,8 +3,11 @@ package handlers
 import (
 	"encoding/json"
 	"html/template"
+	"math"
 	"net/http"
+	"net/url"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -15,6 +18,7 @@ import (
 	"github.com/gamedb/gamedb/cmd/frontend/helpers/datatable"
 	"github.com/gamedb/gamedb/cmd/frontend/helpers/geo"
 	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/consumers"
 	"github.com/gamedb/gamedb/pkg/helpers"
 	"github.com/gamedb/gamedb/pkg/i18n"
 	"github.com/gamedb/gamedb/pkg/log"
@@ -37,12 +41,23 @@ func SettingsRouter() http.Handler {
 	r.Use(middleware.MiddlewareAuthCheck)
 
 	r.Get("/", settingsHandler)
+	r.Get("/alerts.json", settingsAlertsAjaxHandler)
+	r.Get("/alerts/{id:[0-9]+}/delete", settingsDeleteAlertHandler)
+	r.Post("/alerts/add", settingsAddAlertHandler)
+	r.Get("/ban-watch.json", settingsBanWatchAjaxHandler)
+	r.Get("/ban-watch/{id:[0-9]+}/delete", settingsDeleteBanWatchHandler)
+	r.Post("/ban-watch/add", settingsAddBanWatchHandler)
 	r.Get("/donations.json", settingsDonationsAjaxHandler)
 	r.Get("/events.json", settingsEventsAjaxHandler)
+	r.Post("/exports/add", settingsAddExportHandler)
+	r.Get("/exports/{id}.zip", settingsDownloadExportHandler)
 	r.Get("/join-discord-server", joinDiscordServerHandler)
 	r.Get("/new-key", settingsNewKeyHandler)
 	r.Get("/remove-provider/{provider:[a-z]+}", settingsRemoveProviderHandler)
 	r.Post("/update", settingsPostHandler)
+	r.Get("/webhooks.json", settingsWebhookDeliveriesAjaxHandler)
+	r.Get("/webhooks/{id:[0-9]+}/delete", settingsDeleteWebhookHandler)
+	r.Post("/webhooks/add", settingsAddWebhookHandler)
 
 	return r
 }
@@ -58,6 +73,10 @@ func settingsHandler(w http.ResponseWriter, r *http.Request) {
 	t.addAssetChosen()
 	t.addAssetBootbox()
 	t.ProdCCs = i18n.GetProdCCs(true)
+	t.AlertRules = []mysql.PriceAlertRule{mysql.PriceAlertRuleLowest, mysql.PriceAlertRuleTarget}
+	t.AlertProductType = r.URL.Query().Get("alert_type")
+	t.AlertProductID = r.URL.Query().Get("alert_id")
+	t.WebhookEvents = mysql.WebhookEvents
 
 	// Get user
 	t.User, err = getUserFromSession(r)
@@ -232,6 +251,32 @@ func settingsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	// Get webhooks
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.Webhooks, err = mysql.GetUserWebhooksByUser(t.User.ID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get exports
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		t.Exports, err = mongo.GetPlayerExports(t.User.ID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
 	// Get event types
 	wg.Add(1)
 	go func() {
@@ -295,6 +340,15 @@ type settingsTemplate struct {
 	UserProviders map[oauth.ProviderEnum]mysql.UserProvider
 	Banners       []template.HTML
 	EventTypes    []settingsEventTemplate
+
+	AlertRules       []mysql.PriceAlertRule
+	AlertProductType string
+	AlertProductID   string
+
+	Webhooks      []mysql.UserWebhook
+	WebhookEvents []mysql.WebhookEvent
+
+	Exports []mongo.PlayerExport
 }
 
 type settingsEventTemplate struct {
@@ -376,11 +430,22 @@ func settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 	// }
 
 	// Save alerts
-	// if r.PostForm.Get("alerts") == "1" {
-	// 	user.ShowAlerts = true
-	// } else {
-	// 	user.ShowAlerts = false
-	// }
+	if r.PostForm.Get("alerts") == "1" {
+		user.ShowAlerts = true
+	} else {
+		user.ShowAlerts = false
+	}
+
+	// Save Discord activity
+	if r.PostForm.Get("discord_history") == "1" {
+		user.DiscordHistory = true
+	} else {
+		user.DiscordHistory = false
+	}
+
+	// Save wishlist emails
+	user.WishlistAlerts = r.PostForm.Get("wishlist_alerts") == "1"
+	user.WishlistDigest = r.PostForm.Get("wishlist_digest") == "1"
 
 	// Save user
 	db, err := mysql.GetMySQLClient()
@@ -395,12 +460,15 @@ func settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Have to save as a map because gorm does not save empty values otherwise
 	db = db.Model(&user).Updates(map[string]interface{}{
-		"email":          user.Email,
-		"email_verified": user.EmailVerified,
-		"password":       user.Password,
-		"country_code":   user.ProductCC,
+		"email":           user.Email,
+		"email_verified":  user.EmailVerified,
+		"password":        user.Password,
+		"country_code":    user.ProductCC,
+		"show_alerts":     user.ShowAlerts,
+		"discord_history": user.DiscordHistory,
+		"wishlist_alerts": user.WishlistAlerts,
+		"wishlist_digest": user.WishlistDigest,
 		// "hide_profile":   user.HideProfile,
-		// "show_alerts":    user.ShowAlerts,
 	})
 
 	if db.Error != nil {
@@ -437,9 +505,9 @@ func settingsPostHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Update session
 	session.SetMany(r, map[string]string{
-		session.SessionUserProdCC: string(user.ProductCC),
-		session.SessionUserEmail:  user.Email,
-		// session.SessionUserShowAlerts: strconv.FormatBool(user.ShowAlerts),
+		session.SessionUserProdCC:     string(user.ProductCC),
+		session.SessionUserEmail:      user.Email,
+		session.SessionUserShowAlerts: strconv.FormatBool(user.ShowAlerts),
 	})
 
 	session.SetFlash(r, session.SessionGood, "Settings saved")
@@ -735,3 +803,802 @@ func settingsRemoveProviderHandler(w http.ResponseWriter, r *http.Request) {
 		log.ErrS(err)
 	}
 }
+
+func settingsAlertsAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	query := datatable.NewDataTableQuery(r, false)
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	// Get alerts
+	var alerts []mysql.PriceAlert
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		alerts, err = mysql.GetPriceAlertsByUser(userID, query.GetOffset())
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get total
+	var total int
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		total, err = mysql.CountPriceAlertsByUser(userID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	// Get product names
+	var appIDs []int
+	var packageIDs []int
+	for _, alert := range alerts {
+		if alert.ProductType == helpers.ProductTypePackage {
+			packageIDs = append(packageIDs, alert.ProductID)
+		} else {
+			appIDs = append(appIDs, alert.ProductID)
+		}
+	}
+
+	var appNames = map[int]string{}
+	var packageNames = map[int]string{}
+
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		apps, err := mongo.GetAppsByID(appIDs, bson.M{"_id": 1, "name": 1})
+		if err != nil {
+			log.ErrS(err)
+			return
+		}
+		for _, app := range apps {
+			appNames[app.ID] = app.GetName()
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		packages, err := mongo.GetPackagesByID(packageIDs, bson.M{"_id": 1, "name": 1})
+		if err != nil {
+			log.ErrS(err)
+			return
+		}
+		for _, pack := range packages {
+			packageNames[pack.ID] = pack.GetName()
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, int64(total), int64(total), nil)
+	for _, alert := range alerts {
+
+		var name string
+		var path string
+		if alert.ProductType == helpers.ProductTypePackage {
+			name = helpers.GetPackageName(alert.ProductID, packageNames[alert.ProductID])
+			path = helpers.GetPackagePath(alert.ProductID, name)
+		} else {
+			name = helpers.GetAppName(alert.ProductID, appNames[alert.ProductID])
+			path = helpers.GetAppPath(alert.ProductID, name)
+		}
+
+		var target string
+		if alert.Rule == mysql.PriceAlertRuleTarget {
+			target = i18n.FormatPrice(i18n.GetProdCC(alert.ProductCC).CurrencyCode, alert.TargetPrice)
+		}
+
+		var notified string
+		if alert.NotifiedAt != nil {
+			notified = alert.NotifiedAt.Format(helpers.DateYearTime)
+		}
+
+		response.AddRow([]interface{}{
+			alert.ID,                                 // 0
+			alert.ProductType.String(),               // 1
+			name,                                     // 2
+			path + "#prices",                         // 3
+			strings.ToUpper(string(alert.ProductCC)), // 4
+			alert.Rule.String(),                      // 5
+			target,                                   // 6
+			alert.NotifyEmail,                        // 7
+			alert.NotifyDiscord,                      // 8
+			notified,                                 // 9
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
+func settingsAddAlertHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#alerts", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	alert := mysql.PriceAlert{
+		UserID:        userID,
+		ProductCC:     steamapi.ProductCC(r.PostForm.Get("prod_cc")),
+		Rule:          mysql.PriceAlertRule(r.PostForm.Get("rule")),
+		NotifyEmail:   r.PostForm.Get("email") == "1",
+		NotifyDiscord: r.PostForm.Get("discord") == "1",
+	}
+
+	// Product
+	alert.ProductID, err = strconv.Atoi(r.PostForm.Get("product_id"))
+	if err != nil {
+		session.SetFlash(r, session.SessionBad, "Invalid product ID")
+		return
+	}
+
+	if r.PostForm.Get("product_type") == "package" {
+
+		alert.ProductType = helpers.ProductTypePackage
+
+		_, err = mongo.GetPackage(alert.ProductID)
+		if err != nil {
+			err = helpers.IgnoreErrors(err, mongo.ErrNoDocuments, mongo.ErrInvalidPackageID)
+			if err != nil {
+				log.ErrS(err)
+			}
+			session.SetFlash(r, session.SessionBad, "Package not found")
+			return
+		}
+
+	} else {
+
+		alert.ProductType = helpers.ProductTypeApp
+
+		_, err = mongo.GetApp(alert.ProductID)
+		if err != nil {
+			err = helpers.IgnoreErrors(err, mongo.ErrNoDocuments, mongo.ErrInvalidAppID)
+			if err != nil {
+				log.ErrS(err)
+			}
+			session.SetFlash(r, session.SessionBad, "App not found")
+			return
+		}
+	}
+
+	// Region
+	if !i18n.IsValidProdCC(alert.ProductCC) {
+		session.SetFlash(r, session.SessionBad, "Invalid region")
+		return
+	}
+
+	// Rule
+	if !alert.Rule.IsValid() {
+		session.SetFlash(r, session.SessionBad, "Invalid alert type")
+		return
+	}
+
+	if alert.Rule == mysql.PriceAlertRuleTarget {
+
+		price, err := strconv.ParseFloat(r.PostForm.Get("target"), 64)
+		if err != nil || price <= 0 {
+			session.SetFlash(r, session.SessionBad, "Invalid target price")
+			return
+		}
+
+		alert.TargetPrice = int(math.Round(price * 100))
+	}
+
+	err = mysql.NewPriceAlert(alert)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong saving your alert")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Price alert added")
+}
+
+func settingsDeleteAlertHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#alerts", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		session.SetFlash(r, session.SessionBad, "Invalid alert ID")
+		return
+	}
+
+	err = mysql.DeletePriceAlert(userID, id)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong removing your alert")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Price alert removed")
+}
+
+func settingsBanWatchAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	query := datatable.NewDataTableQuery(r, false)
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	// Get watches
+	var watches []mysql.BanWatch
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		watches, err = mysql.GetBanWatchesByUser(userID, query.GetOffset())
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get total
+	var total int
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		total, err = mysql.CountBanWatchesByUser(userID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	// Get players
+	var playerIDs []int64
+	for _, watch := range watches {
+		playerIDs = append(playerIDs, watch.PlayerID)
+	}
+
+	var playersMap = map[int64]mongo.Player{}
+
+	players, err := mongo.GetPlayersByID(playerIDs, bson.M{"_id": 1, "persona_name": 1, "avatar": 1, "bans_cav": 1, "bans_game": 1, "bans_last": 1})
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	for _, player := range players {
+		playersMap[player.ID] = player
+	}
+
+	var response = datatable.NewDataTablesResponse(r, query, int64(total), int64(total), nil)
+	for _, watch := range watches {
+
+		player, ok := playersMap[watch.PlayerID]
+		if !ok {
+			player.ID = watch.PlayerID
+		}
+
+		var lastBan string
+		if player.NumberOfVACBans > 0 && !player.LastBan.IsZero() {
+			lastBan = player.LastBan.Format(helpers.DateYear)
+		}
+
+		var notified string
+		if watch.NotifiedAt != nil {
+			notified = watch.NotifiedAt.Format(helpers.DateYearTime)
+		}
+
+		response.AddRow([]interface{}{
+			watch.ID,                         // 0
+			strconv.FormatInt(player.ID, 10), // 1
+			player.GetName(),                 // 2
+			player.GetPath(),                 // 3
+			player.GetAvatar(),               // 4
+			player.NumberOfVACBans,           // 5
+			player.NumberOfGameBans,          // 6
+			lastBan,                          // 7
+			watch.NotifyEmail,                // 8
+			watch.NotifyDiscord,              // 9
+			notified,                         // 10
+			ok,                               // 11
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
+func settingsAddBanWatchHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#ban-watch", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	// Pasted IDs
+	playerIDs, invalid := helpers.ParsePlayerIDs(r.PostForm.Get("ids"))
+
+	// Friends list import
+	if friendsOf := strings.TrimSpace(r.PostForm.Get("friends_of")); friendsOf != "" {
+
+		ids, bad := helpers.ParsePlayerIDs(friendsOf)
+		invalid = append(invalid, bad...)
+
+		for _, id := range ids {
+
+			friends, err := mongo.GetFriends(id, 0, 0, nil, nil)
+			if err != nil {
+				log.ErrS(err)
+				session.SetFlash(r, session.SessionBad, "Something went wrong reading the friends list")
+				return
+			}
+
+			for _, friend := range friends {
+				playerIDs = append(playerIDs, friend.FriendID)
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		session.SetFlash(r, session.SessionBad, "Invalid Steam IDs: "+strings.Join(invalid, ", "))
+		return
+	}
+
+	if len(playerIDs) == 0 {
+		session.SetFlash(r, session.SessionBad, "No players found, friends lists must be public and already scanned")
+		return
+	}
+
+	added, err := mysql.NewBanWatches(userID, playerIDs, r.PostForm.Get("email") == "1", r.PostForm.Get("discord") == "1")
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong saving your watch list")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, strconv.Itoa(added)+" players added to your watch list")
+
+	// Scan new players, so there is a ban count to compare against
+	playerIDs = helpers.UniqueInt64(playerIDs)
+
+	players, err := mongo.GetPlayersByID(playerIDs, bson.M{"_id": 1})
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	var found = map[int64]bool{}
+	for _, player := range players {
+		found[player.ID] = true
+	}
+
+	ua := r.UserAgent()
+
+	for _, playerID := range playerIDs {
+
+		if found[playerID] {
+			continue
+		}
+
+		err = consumers.ProducePlayer(consumers.PlayerMessage{ID: playerID, UserAgent: &ua, SkipGroupUpdate: true, SkipAchievements: true}, "frontend-ban-watch")
+		err = helpers.IgnoreErrors(err, consumers.ErrInQueue, consumers.ErrIsBot)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+func settingsDeleteBanWatchHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#ban-watch", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		session.SetFlash(r, session.SessionBad, "Invalid watch ID")
+		return
+	}
+
+	err = mysql.DeleteBanWatch(userID, id)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong removing the player")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Player removed from your watch list")
+}
+
+func settingsWebhookDeliveriesAjaxHandler(w http.ResponseWriter, r *http.Request) {
+
+	query := datatable.NewDataTableQuery(r, false)
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		return
+	}
+
+	var wg sync.WaitGroup
+
+	// Get deliveries
+	var deliveries []mongo.WebhookDelivery
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		deliveries, err = mongo.GetWebhookDeliveries(userID, query.GetOffset64())
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	// Get total
+	var total int64
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		var err error
+		total, err = mongo.CountDocuments(mongo.CollectionWebhookDeliveries, bson.D{{Key: "user_id", Value: userID}}, 60)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}()
+
+	wg.Wait()
+
+	var response = datatable.NewDataTablesResponse(r, query, total, total, nil)
+	for _, delivery := range deliveries {
+
+		var event = mysql.WebhookEvent(delivery.Event)
+
+		response.AddRow([]interface{}{
+			delivery.CreatedAt.Unix(),                       // 0
+			delivery.CreatedAt.Format(helpers.DateYearTime), // 1
+			event.String(),                                  // 2
+			delivery.URL,                                    // 3
+			delivery.Attempt,                                // 4
+			delivery.StatusCode,                             // 5
+			delivery.Error,                                  // 6
+			delivery.Duration,                               // 7
+			delivery.Success(),                              // 8
+			delivery.Retrying,                               // 9
+			delivery.DeliveryID,                             // 10
+		})
+	}
+
+	returnJSON(w, r, response)
+}
+
+func settingsAddWebhookHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#webhooks", http.StatusFound)
+	}()
+
+	user, err := getUserFromSession(r)
+	if err != nil {
+		err = helpers.IgnoreErrors(err, ErrLoggedOut)
+		if err != nil {
+			log.ErrS(err)
+		}
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	if user.APIKey == "" {
+		session.SetFlash(r, session.SessionBad, "You need an API key to add webhooks")
+		return
+	}
+
+	err = r.ParseForm()
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	// URL
+	u, err := url.Parse(strings.TrimSpace(r.PostForm.Get("url")))
+	if err != nil || !u.IsAbs() || u.Host == "" {
+		session.SetFlash(r, session.SessionBad, "Invalid URL")
+		return
+	}
+
+	if u.Scheme != "https" && !(config.IsLocal() && u.Scheme == "http") {
+		session.SetFlash(r, session.SessionBad, "Webhook URLs must use https")
+		return
+	}
+
+	// Checked again when sending, in case the DNS changes
+	if !config.IsLocal() {
+		_, err = helpers.LookupPublicIPs(r.Context(), u.Hostname())
+		if err != nil {
+			session.SetFlash(r, session.SessionBad, "Webhook URLs must resolve to a public address")
+			return
+		}
+	}
+
+	// Events
+	var events []mysql.WebhookEvent
+	for _, v := range r.PostForm["events"] {
+		if event := mysql.WebhookEvent(v); event.IsValid() {
+			events = append(events, event)
+		}
+	}
+
+	if len(events) == 0 {
+		session.SetFlash(r, session.SessionBad, "Choose at least one event")
+		return
+	}
+
+	// Filter
+	var appID int
+	if val := strings.TrimSpace(r.PostForm.Get("app_id")); val != "" {
+		appID, err = strconv.Atoi(val)
+		if err != nil || !helpers.IsValidAppID(appID) {
+			session.SetFlash(r, session.SessionBad, "Invalid app ID")
+			return
+		}
+	}
+
+	var playerID int64
+	if val := strings.TrimSpace(r.PostForm.Get("player_id")); val != "" {
+		playerID, err = strconv.ParseInt(val, 10, 64)
+		if err == nil {
+			playerID, err = helpers.IsValidPlayerID(playerID)
+		}
+		if err != nil {
+			session.SetFlash(r, session.SessionBad, "Invalid player ID")
+			return
+		}
+	}
+
+	if appID > 0 && playerID > 0 {
+		session.SetFlash(r, session.SessionBad, "Filter by an app or a player, not both")
+		return
+	}
+
+	// Every player update would be too many
+	if playerID == 0 && helpers.SliceHasString(string(mysql.WebhookEventPlayerUpdated), r.PostForm["events"]) {
+		session.SetFlash(r, session.SessionBad, "Player update webhooks need a player ID")
+		return
+	}
+
+	// Limit
+	count, err := mysql.CountUserWebhooksByUser(user.ID)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong saving your webhook")
+		return
+	}
+
+	if count >= mysql.MaxWebhooksPerUser {
+		session.SetFlash(r, session.SessionBad, "You can only have "+strconv.Itoa(mysql.MaxWebhooksPerUser)+" webhooks")
+		return
+	}
+
+	webhook := mysql.UserWebhook{
+		UserID:   user.ID,
+		URL:      u.String(),
+		AppID:    appID,
+		PlayerID: playerID,
+	}
+	webhook.SetEvents(events)
+
+	err = webhook.SetSecret()
+	if err == nil {
+		err = mysql.NewUserWebhook(webhook)
+	}
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong saving your webhook")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Webhook added")
+}
+
+func settingsDeleteWebhookHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#webhooks", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		session.SetFlash(r, session.SessionBad, "Invalid webhook ID")
+		return
+	}
+
+	err = mysql.DeleteUserWebhook(userID, id)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong removing your webhook")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Webhook removed")
+}
+
+func settingsAddExportHandler(w http.ResponseWriter, r *http.Request) {
+
+	defer func() {
+		session.Save(w, r)
+		http.Redirect(w, r, "/settings#exports", http.StatusFound)
+	}()
+
+	userID := session.GetUserIDFromSesion(r)
+	if userID == 0 {
+		session.SetFlash(r, session.SessionBad, "User not found")
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Could not read form data")
+		return
+	}
+
+	// Defaults to the user's own player
+	var playerID = session.GetPlayerIDFromSesion(r)
+	if r.PostForm.Get("player_id") != "" {
+		playerID, err = strconv.ParseInt(r.PostForm.Get("player_id"), 10, 64)
+		if err != nil {
+			session.SetFlash(r, session.SessionBad, "Invalid player ID")
+			return
+		}
+	}
+
+	if playerID == 0 {
+		session.SetFlash(r, session.SessionBad, "Link your Steam account to export your library")
+		return
+	}
+
+	player, err := mongo.GetPlayer(playerID)
+	if err == mongo.ErrNoDocuments {
+		session.SetFlash(r, session.SessionBad, "Player not found")
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong queuing your export")
+		return
+	}
+
+	// Other players must be public, same as the player page
+	if player.Private && player.ID != session.GetPlayerIDFromSesion(r) {
+		session.SetFlash(r, session.SessionBad, "Private profile")
+		return
+	}
+
+	// Only one at a time
+	pending, err := mongo.HasPendingPlayerExport(userID)
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong queuing your export")
+		return
+	}
+
+	if pending {
+		session.SetFlash(r, session.SessionBad, "Please wait for your current export to finish")
+		return
+	}
+
+	export, err := mongo.NewPlayerExport(userID, player.ID, player.GetName(), session.GetProductCC(r))
+	if err == nil {
+		err = consumers.ProducePlayerExport(export.ID)
+	}
+	if err != nil {
+		log.ErrS(err)
+		session.SetFlash(r, session.SessionBad, "Something went wrong queuing your export")
+		return
+	}
+
+	session.SetFlash(r, session.SessionGood, "Export queued, it will appear below when ready")
+}
+
+func settingsDownloadExportHandler(w http.ResponseWriter, r *http.Request) {
+
+	export, err := mongo.GetPlayerExport(chi.URLParam(r, "id"))
+	if err == mongo.ErrNoDocuments {
+		returnErrorTemplate(w, r, errorTemplate{Code: http.StatusNotFound, Message: "This export has expired"})
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		returnErrorTemplate(w, r, errorTemplate{Code: http.StatusInternalServerError})
+		return
+	}
+
+	if export.UserID != session.GetUserIDFromSesion(r) {
+		returnErrorTemplate(w, r, errorTemplate{Code: http.StatusNotFound, Message: "This export has expired"})
+		return
+	}
+
+	if export.Status != mongo.PlayerExportComplete {
+		returnErrorTemplate(w, r, errorTemplate{Code: http.StatusNotFound, Message: "This export is not ready yet"})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+export.GetFilename()+`"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(export.Archive)))
+
+	_, err = w.Write(export.Archive)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
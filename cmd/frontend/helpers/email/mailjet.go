@@ -11,7 +11,7 @@ import (
 type mailjetProvider struct {
 }
 
-func (mailjetProvider) Send(toEmail, replyToName, replyToEmail, subject string, template emailTemplate) (err error) {
+func (mailjetProvider) Send(toEmail, replyToName, replyToEmail, subject string, template Template) (err error) {
 
 	if config.C.MailjetPublic == "" || config.C.MailjetPrivate == "" {
 		return errors.New("missing mailjet environment variables")
@@ -8,7 +8,7 @@ import (
 )
 
 type EmailProvider interface {
-	Send(toEmail, replyToName, replyToEmail, subject string, template emailTemplate) error
+	Send(toEmail, replyToName, replyToEmail, subject string, template Template) error
 }
 
 func GetProvider() EmailProvider {
@@ -27,7 +27,7 @@ func Init() {
 	}
 }
 
-func renderTemplate(template emailTemplate) (body string, err error) {
+func renderTemplate(template Template) (body string, err error) {
 
 	buf := bytes.Buffer{}
 	err = templatex.ExecuteTemplate(&buf, template.filename(), template)
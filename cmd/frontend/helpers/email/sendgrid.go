@@ -3,7 +3,7 @@ package email
 // type sendgridProvider struct {
 // }
 //
-// func (sendgridProvider) Send(toEmail, replyToName, replyToEmail, subject string, template emailTemplate) (err error) {
+// func (sendgridProvider) Send(toEmail, replyToName, replyToEmail, subject string, template Template) (err error) {
 //
 // 	if config.C.SendGridAPIKey == "" {
 // 		return errors.New("missing environment variables")
@@ -1,6 +1,6 @@
 package email
 
-type emailTemplate interface {
+type Template interface {
 	filename() string
 }
 
@@ -58,3 +58,59 @@ type VerifyTemplate struct {
 func (t VerifyTemplate) filename() string {
 	return "verify"
 }
+
+type PriceAlertTemplate struct {
+	Domain      string
+	ProductName string
+	Path        string
+	Rule        string
+	PriceBefore string
+	PriceAfter  string
+}
+
+func (t PriceAlertTemplate) filename() string {
+	return "price_alert"
+}
+
+type BanWatchTemplate struct {
+	Domain   string
+	Text     string
+	Path     string
+	VACBans  int
+	GameBans int
+}
+
+func (t BanWatchTemplate) filename() string {
+	return "ban_watch"
+}
+
+type WishlistAlertTemplate struct {
+	Domain  string
+	AppName string
+	Text    string
+	Path    string
+}
+
+func (t WishlistAlertTemplate) filename() string {
+	return "wishlist_alert"
+}
+
+type WishlistDigestTemplate struct {
+	Domain string
+	Path   string
+	Count  int
+	Total  string
+	Sales  []WishlistDigestSale
+}
+
+type WishlistDigestSale struct {
+	Name     string
+	Path     string
+	Price    string
+	Discount int
+	Lowest   bool
+}
+
+func (t WishlistDigestTemplate) filename() string {
+	return "wishlist_digest"
+}
@@ -66,8 +66,8 @@ func main() {
 		return
 	}
 
-	// Init modules
-	consumers.Init(consumers.FrontendDefinitions)
+	// Init modules, with every producer so failed messages can be replayed to any queue
+	consumers.Init(consumers.WithAllProducers(consumers.FrontendDefinitions))
 	session.Init()
 	handlers.Init()
 	email.Init()
@@ -102,6 +102,7 @@ func main() {
 	r.Mount("/changes", handlers.ChangesRouter())
 	r.Mount("/commits", handlers.CommitsRouter())
 	r.Mount("/contact", handlers.ContactRouter())
+	r.Mount("/depots", handlers.DepotsRouter())
 	r.Mount("/discord-bot", handlers.ChatBotRouter())
 	r.Mount("/discord-server", handlers.ChatRouter())
 	r.Mount("/donate", handlers.DonateRouter())
@@ -114,6 +115,7 @@ func main() {
 	r.Mount("/health-check", handlers.HealthCheckRouter())
 	r.Mount("/home", handlers.HomeRouter())
 	r.Mount("/info", handlers.InfoRouter())
+	r.Mount("/items", handlers.ItemsRouter())
 	r.Mount("/login", handlers.LoginRouter())
 	r.Mount("/logout", handlers.LogoutRouter())
 	r.Mount("/news", handlers.NewsRouter())
@@ -0,0 +1,232 @@
+package autoscaler
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gamedb/gamedb/cmd/scaler/hosts"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.uber.org/zap"
+)
+
+var ErrNoHost = errors.New("no host")
+
+type Policy struct {
+	Min                 int
+	Max                 int
+	Step                int           // Most consumers to add or remove in one action
+	Cooldown            time.Duration // Time to wait after an action
+	MessagesPerConsumer int64
+}
+
+func PolicyFromConfig() Policy {
+	return Policy{
+		Min:                 config.C.ScalerMin,
+		Max:                 config.C.ScalerMax,
+		Step:                config.C.ScalerStep,
+		Cooldown:            time.Second * time.Duration(config.C.ScalerCooldown),
+		MessagesPerConsumer: int64(config.C.ScalerMessagesPerConsumer),
+	}
+}
+
+// How many consumers the queue depth needs, within the min and max
+func (p Policy) Desired(messages int64) int {
+
+	var desired = 0
+	if p.MessagesPerConsumer > 0 && messages > 0 {
+		desired = int(math.Ceil(float64(messages) / float64(p.MessagesPerConsumer)))
+	}
+
+	if desired > p.Max {
+		desired = p.Max
+	}
+	if desired < p.Min {
+		desired = p.Min
+	}
+
+	return desired
+}
+
+type Decision struct {
+	Messages int64
+	Current  int
+	Desired  int
+	Change   int // Negative to remove consumers
+	Reason   string
+	Time     time.Time
+}
+
+type QueueDepthFunc func() (messages int64, err error)
+
+type Scaler struct {
+	Host       hosts.Host
+	Policy     Policy
+	QueueDepth QueueDepthFunc
+	Now        func() time.Time
+	lastAction time.Time
+	last       Decision
+	mutex      sync.Mutex
+}
+
+func New(host hosts.Host, policy Policy, depth QueueDepthFunc) *Scaler {
+	return &Scaler{
+		Host:       host,
+		Policy:     policy,
+		QueueDepth: depth,
+		Now:        time.Now,
+	}
+}
+
+// Works out the change, without touching the host
+func (s *Scaler) Decide(messages int64, current int) (decision Decision) {
+
+	decision = Decision{
+		Messages: messages,
+		Current:  current,
+		Desired:  s.Policy.Desired(messages),
+		Time:     s.Now(),
+	}
+
+	var change = decision.Desired - current
+	if change == 0 {
+		decision.Reason = "at desired size"
+		return decision
+	}
+
+	if !s.lastAction.IsZero() && decision.Time.Sub(s.lastAction) < s.Policy.Cooldown {
+		decision.Reason = "cooling down"
+		return decision
+	}
+
+	var step = s.Policy.Step
+	if step < 1 {
+		step = 1
+	}
+
+	if change > step {
+		change = step
+	} else if change < -step {
+		change = -step
+	}
+
+	decision.Change = change
+	if change > 0 {
+		decision.Reason = "scaling up by " + strconv.Itoa(change)
+	} else {
+		decision.Reason = "scaling down by " + strconv.Itoa(-change)
+	}
+
+	return decision
+}
+
+// Reads the queues and applies one decision to the host
+func (s *Scaler) Tick() (decision Decision, err error) {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.Host == nil {
+		return decision, ErrNoHost
+	}
+
+	messages, err := s.QueueDepth()
+	if err != nil {
+		return decision, err
+	}
+
+	consumers, err := s.managedConsumers()
+	if err != nil {
+		return decision, err
+	}
+
+	decision = s.Decide(messages, len(consumers))
+
+	if decision.Change > 0 {
+
+		for i := 0; i < decision.Change; i++ {
+
+			_, err = s.Host.CreateConsumer()
+			if err != nil {
+				break
+			}
+			s.lastAction = decision.Time
+		}
+
+	} else if decision.Change < 0 {
+
+		// Remove the servers with the least paid time left
+		sort.Slice(consumers, func(i, j int) bool {
+			return consumers[i].SecondsLeftOfHour(decision.Time) < consumers[j].SecondsLeftOfHour(decision.Time)
+		})
+
+		var removed = 0
+		for _, consumer := range consumers {
+
+			if removed >= -decision.Change {
+				break
+			}
+			if !consumer.CanDelete() {
+				continue
+			}
+
+			err = s.Host.DeleteConsumer(consumer.ID)
+			if err != nil {
+				break
+			}
+			s.lastAction = decision.Time
+			removed++
+		}
+	}
+
+	s.last = decision
+
+	return decision, err
+}
+
+// Ticks until the process ends
+func (s *Scaler) Run(interval time.Duration) {
+
+	for range time.NewTicker(interval).C {
+
+		decision, err := s.Tick()
+		if err != nil {
+			log.ErrS(err)
+			continue
+		}
+
+		if decision.Change != 0 {
+			log.Info("Autoscaling", zap.Int64("messages", decision.Messages), zap.Int("current", decision.Current), zap.Int("desired", decision.Desired), zap.String("reason", decision.Reason))
+		}
+	}
+}
+
+func (s *Scaler) LastDecision() Decision {
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.last
+}
+
+// Consumers made by the scaler, other servers are left alone
+func (s *Scaler) managedConsumers() (consumers []hosts.Consumer, err error) {
+
+	all, err := s.Host.ListConsumers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range all {
+		if helpers.SliceHasString(hosts.ConsumerTag, v.Tags) {
+			consumers = append(consumers, v)
+		}
+	}
+
+	return consumers, nil
+}
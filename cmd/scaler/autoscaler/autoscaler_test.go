@@ -0,0 +1,175 @@
+package autoscaler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gamedb/gamedb/cmd/scaler/hosts"
+)
+
+var testPolicy = Policy{
+	Min:                 1,
+	Max:                 5,
+	Step:                2,
+	Cooldown:            time.Minute * 10,
+	MessagesPerConsumer: 1000,
+}
+
+func newTestScaler(messages *int64) (*Scaler, *hosts.Fake, *time.Time) {
+
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	fake := hosts.NewFake()
+	fake.Now = func() time.Time { return now }
+
+	scaler := New(fake, testPolicy, func() (int64, error) { return *messages, nil })
+	scaler.Now = func() time.Time { return now }
+
+	return scaler, fake, &now
+}
+
+func countConsumers(t *testing.T, fake *hosts.Fake) int {
+
+	consumers, err := fake.ListConsumers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(consumers)
+}
+
+func TestPolicyDesired(t *testing.T) {
+
+	tests := map[int64]int{
+		0:     1, // Min
+		1:     1,
+		1000:  1,
+		1001:  2,
+		3500:  4,
+		99999: 5, // Max
+	}
+
+	for messages, expected := range tests {
+		if desired := testPolicy.Desired(messages); desired != expected {
+			t.Errorf("%d messages: expected %d consumers, got %d", messages, expected, desired)
+		}
+	}
+}
+
+func TestScaleUpByStep(t *testing.T) {
+
+	var messages int64 = 4500
+	scaler, fake, _ := newTestScaler(&messages)
+
+	decision, err := scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decision.Desired != 5 || decision.Change != 2 {
+		t.Errorf("expected desired 5 and change 2, got %d and %d", decision.Desired, decision.Change)
+	}
+
+	if count := countConsumers(t, fake); count != 2 {
+		t.Errorf("expected 2 consumers, got %d", count)
+	}
+}
+
+func TestCooldown(t *testing.T) {
+
+	var messages int64 = 4500
+	scaler, fake, now := newTestScaler(&messages)
+
+	_, err := scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Still cooling down
+	*now = now.Add(time.Minute * 5)
+
+	decision, err := scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decision.Change != 0 || countConsumers(t, fake) != 2 {
+		t.Errorf("expected no change during cool-down, got %d", decision.Change)
+	}
+
+	// Cool-down over
+	*now = now.Add(time.Minute * 6)
+
+	decision, err = scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decision.Change != 2 || countConsumers(t, fake) != 4 {
+		t.Errorf("expected to add 2 after cool-down, got %d", decision.Change)
+	}
+}
+
+func TestScaleDown(t *testing.T) {
+
+	var messages int64 = 0
+	scaler, fake, now := newTestScaler(&messages)
+
+	// Not made by the scaler
+	fake.AddConsumer(hosts.Consumer{ID: 1, CreatedAt: now.Unix()})
+
+	// Locked
+	fake.AddConsumer(hosts.Consumer{ID: 2, Tags: []string{hosts.ConsumerTag}, CreatedAt: now.Add(-time.Minute * 59).Unix(), Locked: true})
+
+	// Least paid time left
+	fake.AddConsumer(hosts.Consumer{ID: 3, Tags: []string{hosts.ConsumerTag}, CreatedAt: now.Add(-time.Minute * 50).Unix()})
+	fake.AddConsumer(hosts.Consumer{ID: 4, Tags: []string{hosts.ConsumerTag}, CreatedAt: now.Add(-time.Minute * 10).Unix()})
+	fake.AddConsumer(hosts.Consumer{ID: 5, Tags: []string{hosts.ConsumerTag}, CreatedAt: now.Add(-time.Minute * 40).Unix()})
+
+	decision, err := scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decision.Current != 4 || decision.Desired != 1 || decision.Change != -2 {
+		t.Errorf("expected 4 current, 1 desired and -2 change, got %d, %d and %d", decision.Current, decision.Desired, decision.Change)
+	}
+
+	consumers, err := fake.ListConsumers()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []int
+	for _, v := range consumers {
+		ids = append(ids, v.ID)
+	}
+
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 4 {
+		t.Errorf("expected consumers 1, 2 & 4 to remain, got %v", ids)
+	}
+}
+
+func TestHostError(t *testing.T) {
+
+	var messages int64 = 4500
+	scaler, fake, _ := newTestScaler(&messages)
+
+	var errFake = errors.New("provider down")
+	fake.FailNext = errFake
+
+	_, err := scaler.Tick()
+	if err != errFake {
+		t.Errorf("expected the host error, got %v", err)
+	}
+
+	// The failed tick should not start a cool-down
+	decision, err := scaler.Tick()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decision.Change != 2 {
+		t.Errorf("expected to scale up after the error, got %d", decision.Change)
+	}
+}
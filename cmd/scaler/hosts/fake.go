@@ -0,0 +1,107 @@
+package hosts
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var ErrFakeNotFound = errors.New("consumer not found")
+
+// An in-memory host, for running the scaler without a provider
+type Fake struct {
+	Now       func() time.Time
+	FailNext  error // Returned by the next call, then cleared
+	consumers map[int]Consumer
+	lastID    int
+	mutex     sync.Mutex
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		Now:       time.Now,
+		consumers: map[int]Consumer{},
+	}
+}
+
+func (f *Fake) ListConsumers() (consumers []Consumer, err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if err = f.popError(); err != nil {
+		return nil, err
+	}
+
+	for _, v := range f.consumers {
+		consumers = append(consumers, v)
+	}
+
+	sort.Slice(consumers, func(i, j int) bool {
+		return consumers[i].ID < consumers[j].ID
+	})
+
+	return consumers, nil
+}
+
+func (f *Fake) CreateConsumer() (consumer Consumer, err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if err = f.popError(); err != nil {
+		return consumer, err
+	}
+
+	f.lastID++
+
+	consumer = Consumer{
+		ID:        f.lastID,
+		Name:      "fake-consumer-" + strconv.Itoa(f.lastID),
+		IP:        "-",
+		Tags:      []string{ConsumerTag},
+		CreatedAt: f.Now().Unix(),
+	}
+
+	f.consumers[consumer.ID] = consumer
+
+	return consumer, nil
+}
+
+func (f *Fake) DeleteConsumer(id int) (err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if err = f.popError(); err != nil {
+		return err
+	}
+
+	if _, ok := f.consumers[id]; !ok {
+		return ErrFakeNotFound
+	}
+
+	delete(f.consumers, id)
+
+	return nil
+}
+
+// Adds a consumer as-is, for servers the scaler did not create
+func (f *Fake) AddConsumer(consumer Consumer) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if consumer.ID > f.lastID {
+		f.lastID = consumer.ID
+	}
+
+	f.consumers[consumer.ID] = consumer
+}
+
+func (f *Fake) popError() (err error) {
+	err, f.FailNext = f.FailNext, nil
+	return err
+}
@@ -7,14 +7,14 @@ import (
 	"time"
 
 	"github.com/Jleagle/go-durationfmt"
+	"github.com/gamedb/gamedb/pkg/config"
 	"github.com/gamedb/gamedb/pkg/helpers"
 )
 
 const (
-	selectedService = hostHetzner
-
-	hostDO      = "do"
-	hostHetzner = "hetzner"
+	HostDO      = "do"
+	HostHetzner = "hetzner"
+	HostFake    = "fake"
 	// hostVU      = "vultr"
 
 	ConsumerTag = "scaler"
@@ -26,14 +26,25 @@ type Host interface {
 	DeleteConsumer(int) error
 }
 
+var fakeHost = NewFake()
+
+// The provider set in config, or nil if it is unknown
 func GetHost() Host {
-	if selectedService == hostDO {
+	return GetHostByName(config.C.ScalerProvider)
+}
+
+func GetHostByName(name string) Host {
+
+	switch name {
+	case HostDO:
 		return DigitalOcean{}
-	}
-	if selectedService == hostHetzner {
+	case HostHetzner:
 		return Hetzner{}
+	case HostFake:
+		return fakeHost
+	default:
+		return nil
 	}
-	return nil
 }
 
 type Consumer struct {
@@ -56,11 +67,17 @@ func (c Consumer) CanDelete() bool {
 
 func (c Consumer) LeftOfHour() (string, error) {
 
-	diff := time.Now().Unix() - c.CreatedAt
+	var seconds = c.SecondsLeftOfHour(time.Now())
 
-	f := float64(diff) / float64(3600)
+	return durationfmt.Format(time.Second*time.Duration(seconds), "%mm %ss")
+}
 
-	var seconds = (int64(math.Ceil(f)) * 3600) - diff
+// Servers are billed by the hour, so this is how long is already paid for
+func (c Consumer) SecondsLeftOfHour(now time.Time) int64 {
 
-	return durationfmt.Format(time.Second*time.Duration(seconds), "%mm %ss")
+	diff := now.Unix() - c.CreatedAt
+
+	f := float64(diff) / float64(3600)
+
+	return (int64(math.Ceil(f)) * 3600) - diff
 }
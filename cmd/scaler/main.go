@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/dustin/go-humanize"
+	"github.com/gamedb/gamedb/cmd/scaler/autoscaler"
 	"github.com/gamedb/gamedb/cmd/scaler/hosts"
 	"github.com/gamedb/gamedb/pkg/config"
 	"github.com/gamedb/gamedb/pkg/helpers"
@@ -17,8 +18,11 @@ import (
 	"github.com/gamedb/gamedb/pkg/mongo"
 	"github.com/gamedb/gamedb/pkg/mysql"
 	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
 )
 
+var autoScaler *autoscaler.Scaler
+
 func main() {
 
 	err := config.Init(helpers.GetIP())
@@ -29,6 +33,18 @@ func main() {
 		return
 	}
 
+	host := hosts.GetHost()
+	if host == nil {
+		log.Err("Unknown scaler provider", zap.String("provider", config.C.ScalerProvider))
+		return
+	}
+
+	autoScaler = autoscaler.New(host, autoscaler.PolicyFromConfig(), queueDepth)
+
+	if config.C.ScalerEnabled {
+		go autoScaler.Run(time.Minute)
+	}
+
 	// Web server
 	r := chi.NewRouter()
 	r.Get("/", listHandler)
@@ -63,8 +79,9 @@ func main() {
 func listHandler(w http.ResponseWriter, _ *http.Request) {
 
 	funcs := template.FuncMap{
-		"join":  func(a []string) string { return strings.Join(a, ", ") },
-		"comma": func(a int) string { return humanize.Comma(int64(a)) },
+		"join":    func(a []string) string { return strings.Join(a, ", ") },
+		"comma":   func(a int) string { return humanize.Comma(int64(a)) },
+		"comma64": func(a int64) string { return humanize.Comma(a) },
 	}
 
 	t, err := template.New("t").Funcs(funcs).ParseFiles("list.gohtml")
@@ -77,6 +94,10 @@ func listHandler(w http.ResponseWriter, _ *http.Request) {
 
 	// Get template data
 	data := HomeTemplate{}
+	data.Provider = config.C.ScalerProvider
+	data.Enabled = config.C.ScalerEnabled
+	data.Policy = autoScaler.Policy
+	data.Decision = autoScaler.LastDecision()
 	data.Consumers, err = host.ListConsumers()
 	if err != nil {
 		fmt.Println(err)
@@ -91,6 +112,10 @@ func listHandler(w http.ResponseWriter, _ *http.Request) {
 
 type HomeTemplate struct {
 	Consumers []hosts.Consumer
+	Provider  string
+	Enabled   bool
+	Policy    autoscaler.Policy
+	Decision  autoscaler.Decision
 }
 
 func createHandler(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+
+	"github.com/Jleagle/influxql"
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/influx"
+	"github.com/gamedb/gamedb/pkg/log"
+)
+
+// Messages in these are waiting, not work for more servers
+var ignoredQueues = []rabbit.QueueName{
+	consumers.QueueDelay,
+	consumers.QueueFailed,
+	consumers.QueueTest,
+}
+
+// Queues read by the consumer servers, the rest are read by the frontend, chatbot and steam servers
+func watchedQueues() (queues []string) {
+
+	for _, v := range consumers.ConsumersDefinitions {
+
+		if !v.HasConsumer() {
+			continue
+		}
+
+		var ignored bool
+		for _, queue := range ignoredQueues {
+			if v.Name == queue {
+				ignored = true
+			}
+		}
+
+		if !ignored {
+			queues = append(queues, string(v.Name))
+		}
+	}
+
+	return queues
+}
+
+// Messages waiting in every consumer queue, from the latest RabbitMQ stats
+func queueDepth() (messages int64, err error) {
+
+	builder := influxql.NewBuilder()
+	builder.AddSelect(`LAST("messages")`, "messages")
+	builder.SetFrom(influx.InfluxTelegrafDB, influx.InfluxRetentionPolicy14Day.String(), influx.InfluxMeasurementRabbitQueue.String())
+	builder.AddWhere("time", ">=", "now() - 5m")
+	builder.AddWhereRaw(`"queue" =~ /^GDB_/`)
+	builder.AddGroupBy("queue")
+
+	resp, err := influx.InfluxQuery(builder)
+	if err != nil {
+		log.ErrS(builder.String())
+		return 0, err
+	}
+
+	if len(resp.Results) == 0 {
+		return 0, nil
+	}
+
+	var watched = watchedQueues()
+
+	for _, series := range resp.Results[0].Series {
+
+		if !helpers.SliceHasString(series.Tags["queue"], watched) {
+			continue
+		}
+
+		if len(series.Values) > 0 && len(series.Values[0]) > 1 {
+			if val, ok := series.Values[0][1].(json.Number); ok {
+				i, err := val.Int64()
+				if err != nil {
+					log.ErrS(err)
+					continue
+				}
+				messages += i
+			}
+		}
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/helpers"
+)
+
+func TestWatchedQueues(t *testing.T) {
+
+	var watched = watchedQueues()
+
+	// Read by the consumer servers
+	for _, queue := range []string{string(consumers.QueueApps), string(consumers.QueuePlayers), string(consumers.QueueWebhooks)} {
+		if !helpers.SliceHasString(queue, watched) {
+			t.Error(queue, "not watched")
+		}
+	}
+
+	// Read by other servers, or waiting
+	for _, queue := range []string{
+		string(consumers.QueueBanWatch),
+		string(consumers.QueuePriceAlerts),
+		string(consumers.QueueWishlistAlerts),
+		string(consumers.QueueWishlistDigests),
+		string(consumers.QueueChatBotFeeds),
+		string(consumers.QueueSteam),
+		string(consumers.QueueWebsockets),
+		string(consumers.QueueDelay),
+		string(consumers.QueueFailed),
+	} {
+		if helpers.SliceHasString(queue, watched) {
+			t.Error(queue, "watched")
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Usage:
+// test failed-messages list [-queue GDB_Apps] [-reason text] [-replayed true|false] [-limit 100]
+// test failed-messages inspect <id>
+// test failed-messages edit <id> <file|->
+// test failed-messages replay [-queue GDB_Apps] [-reason text] [id...]
+// test failed-messages delete [-queue GDB_Apps] [-reason text] [-replayed true|false] [-all] [id...]
+type failedMessages struct{}
+
+func (failedMessages) name() string {
+	return "failed-messages"
+}
+
+func (failedMessages) run() {
+
+	if len(os.Args) < 3 {
+		fmt.Println("missing command: list, inspect, edit, replay, delete")
+		return
+	}
+
+	var args = os.Args[3:]
+
+	switch os.Args[2] {
+	case "list":
+		failedMessagesList(args)
+	case "inspect":
+		failedMessagesInspect(args)
+	case "edit":
+		failedMessagesEdit(args)
+	case "replay":
+		failedMessagesReplay(args)
+	case "delete":
+		failedMessagesDelete(args)
+	default:
+		fmt.Println("unknown command: " + os.Args[2])
+	}
+}
+
+// Returns a filter from the flags, or from the IDs if any are given
+func failedMessagesFilter(name string, args []string, replayedDefault string) (filter bson.D, limit int64, ok bool) {
+
+	set := flag.NewFlagSet(name, flag.ContinueOnError)
+	queue := set.String("queue", "", "comma separated queue names")
+	reason := set.String("reason", "", "failure reason, case insensitive regex")
+	replayed := set.String("replayed", replayedDefault, "true, false or empty for both")
+	limitFlag := set.Int64("limit", 100, "max messages, 0 for no limit")
+
+	var all *bool
+	if name == "delete" {
+		all = set.Bool("all", false, "allow deleting every message when no IDs or filters are given")
+	}
+
+	err := set.Parse(args)
+	if err != nil {
+		return filter, 0, false
+	}
+
+	if set.NArg() > 0 {
+		return bson.D{{Key: "_id", Value: bson.M{"$in": set.Args()}}}, 0, true
+	}
+
+	var queues []string
+	for _, v := range strings.Split(*queue, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			queues = append(queues, v)
+		}
+	}
+
+	var replayedPtr *bool
+	if *replayed != "" {
+		b, err := strconv.ParseBool(*replayed)
+		if err != nil {
+			fmt.Println("invalid -replayed value")
+			return filter, 0, false
+		}
+		replayedPtr = &b
+	}
+
+	filter = mongo.FailedMessagesFilter(queues, *reason, replayedPtr)
+
+	// An empty filter would delete every message
+	if all != nil && !*all && len(filter) == 0 {
+		fmt.Println("no IDs or filters given, use -all to delete every message")
+		return filter, 0, false
+	}
+
+	return filter, *limitFlag, true
+}
+
+func failedMessagesList(args []string) {
+
+	filter, limit, ok := failedMessagesFilter("list", args, "")
+	if !ok {
+		return
+	}
+
+	messages, err := mongo.GetFailedMessages(0, limit, filter)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	for _, fm := range messages {
+
+		var replayed = "-"
+		if fm.IsReplayed() {
+			replayed = fm.ReplayedAt.Format(helpers.DateSQL)
+		}
+
+		fmt.Println(strings.Join([]string{
+			fm.UUID,
+			fm.CreatedAt.Format(helpers.DateSQL),
+			fm.Queue,
+			strconv.Itoa(fm.Attempt),
+			replayed,
+			fm.Reason,
+			fm.GetBodyShort(),
+		}, "\t"))
+	}
+
+	fmt.Println(strconv.Itoa(len(messages)) + " messages")
+}
+
+func failedMessagesInspect(args []string) {
+
+	if len(args) < 1 {
+		fmt.Println("missing message id")
+		return
+	}
+
+	fm, err := mongo.GetFailedMessage(args[0])
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	b, err := json.MarshalIndent(fm, "", "    ")
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	fmt.Println(string(b))
+}
+
+func failedMessagesEdit(args []string) {
+
+	if len(args) < 2 {
+		fmt.Println("usage: edit <id> <file|->")
+		return
+	}
+
+	var b []byte
+	var err error
+
+	if args[1] == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(args[1])
+	}
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	if !json.Valid(b) {
+		fmt.Println("payload is not valid json")
+		return
+	}
+
+	err = mongo.UpdateFailedMessageBody(args[0], strings.TrimSpace(string(b)))
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	fmt.Println("payload saved")
+}
+
+func failedMessagesReplay(args []string) {
+
+	filter, limit, ok := failedMessagesFilter("replay", args, "false")
+	if !ok {
+		return
+	}
+
+	messages, err := mongo.GetFailedMessages(0, limit, filter)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	// The CLI can produce to every queue
+	consumers.Init(consumers.AllProducerDefinitions)
+
+	replayed, err := consumers.ReplayFailedMessages(messages)
+	if err != nil {
+		log.ErrS(err)
+	}
+
+	fmt.Println(strconv.Itoa(len(replayed)) + " replayed, " + strconv.Itoa(len(messages)-len(replayed)) + " skipped")
+}
+
+func failedMessagesDelete(args []string) {
+
+	filter, _, ok := failedMessagesFilter("delete", args, "")
+	if !ok {
+		return
+	}
+
+	count, err := mongo.DeleteFailedMessages(filter)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	fmt.Println(strconv.FormatInt(count, 10) + " deleted")
+}
@@ -14,6 +14,7 @@ type util interface {
 
 var utils = []util{
 	chatCommands{},
+	failedMessages{},
 	queuePackages{},
 	saveFromPics{},
 	syncStates{},
@@ -71,7 +71,7 @@ require (
 	github.com/mattn/go-runewidth v0.0.13 // indirect
 	github.com/mattn/go-sqlite3 v2.0.1+incompatible // indirect
 	github.com/mborgerson/GoTruncateHtml v0.0.0-20150507032438-125d9154cd1e
-	github.com/memcachier/mc/v3 v3.0.3 // indirect
+	github.com/memcachier/mc/v3 v3.0.3
 	github.com/microcosm-cc/bluemonday v1.0.15
 	github.com/montanaflynn/stats v0.6.6
 	github.com/mssola/user_agent v0.5.3
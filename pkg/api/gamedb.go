@@ -11,12 +11,18 @@ import (
 )
 
 const (
-	tagGames    = "Games"
-	tagPlayers  = "Players"
-	tagArticles = "Articles"
-	tagPackages = "Packages"
-	tagGroups   = "Groups"
-	TagPublic   = "Free"
+	tagGames      = "Games"
+	tagPlayers    = "Players"
+	tagArticles   = "Articles"
+	tagPackages   = "Packages"
+	tagGroups     = "Groups"
+	tagPrices     = "Prices"
+	tagChanges    = "Changes"
+	tagDepots     = "Depots"
+	tagFranchises = "Franchises"
+	tagItems      = "Items"
+	tagBundles    = "Bundles"
+	TagPublic     = "Free"
 )
 
 func GetGlobalSteam() (swagger *openapi3.T) {
@@ -44,6 +50,12 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 			&openapi3.Tag{Name: tagArticles},
 			&openapi3.Tag{Name: tagPackages},
 			&openapi3.Tag{Name: tagGroups},
+			&openapi3.Tag{Name: tagPrices},
+			&openapi3.Tag{Name: tagChanges},
+			&openapi3.Tag{Name: tagDepots},
+			&openapi3.Tag{Name: tagFranchises},
+			&openapi3.Tag{Name: tagItems},
+			&openapi3.Tag{Name: tagBundles},
 			&openapi3.Tag{Name: TagPublic},
 		},
 		Security: openapi3.SecurityRequirements{
@@ -68,6 +80,9 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 				"order-param-desc": {
 					Value: openapi3.NewQueryParameter("order").WithSchema(openapi3.NewStringSchema().WithEnum("asc", "desc").WithDefault("desc")),
 				},
+				"cc-param": {
+					Value: openapi3.NewQueryParameter("cc").WithSchema(openapi3.NewStringSchema().WithDefault("us").WithMaxLength(2)),
+				},
 			},
 			Schemas: map[string]*openapi3.SchemaRef{
 				"pagination-schema": {
@@ -196,6 +211,329 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 						},
 					},
 				},
+				"price-change-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "package_id", "name", "icon", "cc", "currency", "price_before", "price_after", "difference", "difference_percent", "created_at"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":             {Value: openapi3.NewInt32Schema()},
+							"package_id":         {Value: openapi3.NewInt32Schema()},
+							"name":               {Value: openapi3.NewStringSchema()},
+							"icon":               {Value: openapi3.NewStringSchema()},
+							"cc":                 {Value: openapi3.NewStringSchema()},
+							"currency":           {Value: openapi3.NewStringSchema()},
+							"price_before":       {Value: openapi3.NewInt32Schema()},
+							"price_after":        {Value: openapi3.NewInt32Schema()},
+							"difference":         {Value: openapi3.NewInt32Schema()},
+							"difference_percent": {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"created_at":         {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
+				"sale-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "sub_id", "app_name", "app_icon", "app_type", "app_rating", "prices", "lowest_prices", "sale_type", "sale_name", "sale_percent", "sale_start", "sale_end", "sale_end_estimate"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":            {Value: openapi3.NewInt32Schema()},
+							"sub_id":            {Value: openapi3.NewInt32Schema()},
+							"app_name":          {Value: openapi3.NewStringSchema()},
+							"app_icon":          {Value: openapi3.NewStringSchema()},
+							"app_type":          {Value: openapi3.NewStringSchema()},
+							"app_rating":        {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"prices":            {Value: &openapi3.Schema{Type: "object", AdditionalProperties: &openapi3.SchemaRef{Value: openapi3.NewInt32Schema()}}},
+							"lowest_prices":     {Value: &openapi3.Schema{Type: "object", AdditionalProperties: &openapi3.SchemaRef{Value: openapi3.NewInt32Schema()}}},
+							"sale_type":         {Value: openapi3.NewStringSchema()},
+							"sale_name":         {Value: openapi3.NewStringSchema()},
+							"sale_percent":      {Value: openapi3.NewInt32Schema()},
+							"sale_start":        {Value: openapi3.NewInt64Schema()},
+							"sale_end":          {Value: openapi3.NewInt64Schema()},
+							"sale_end_estimate": {Value: openapi3.NewBoolSchema()},
+						},
+					},
+				},
+				"change-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"id", "created_at", "apps", "packages"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"id":         {Value: openapi3.NewInt32Schema()},
+							"created_at": {Value: openapi3.NewInt64Schema()},
+							"apps":       {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"packages":   {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+						},
+					},
+				},
+				"depot-history-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "change_number", "created_at", "type", "branch", "depot_id", "depot_name", "before", "after", "time_updated"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":        {Value: openapi3.NewInt32Schema()},
+							"change_number": {Value: openapi3.NewInt32Schema()},
+							"created_at":    {Value: openapi3.NewInt64Schema()},
+							"type":          {Value: openapi3.NewStringSchema().WithEnum("build", "manifest")},
+							"branch":        {Value: openapi3.NewStringSchema()},
+							"depot_id":      {Value: openapi3.NewInt32Schema()},
+							"depot_name":    {Value: openapi3.NewStringSchema()},
+							"before":        {Value: openapi3.NewStringSchema()},
+							"after":         {Value: openapi3.NewStringSchema()},
+							"time_updated":  {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
+				"pics-history-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "package_id", "change_number", "created_at", "field", "key", "action", "before", "after"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":        {Value: openapi3.NewInt32Schema()},
+							"package_id":    {Value: openapi3.NewInt32Schema()},
+							"change_number": {Value: openapi3.NewInt32Schema()},
+							"created_at":    {Value: openapi3.NewInt64Schema()},
+							"field":         {Value: openapi3.NewStringSchema().WithEnum("common", "extended", "config", "ufs", "depots", "launch", "package")},
+							"key":           {Value: openapi3.NewStringSchema()},
+							"action":        {Value: openapi3.NewStringSchema().WithEnum("added", "removed", "modified")},
+							"before":        {Value: openapi3.NewStringSchema()},
+							"after":         {Value: openapi3.NewStringSchema()},
+						},
+					},
+				},
+				"review-language-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"language", "positive", "negative"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"language": {Value: openapi3.NewStringSchema()},
+							"positive": {Value: openapi3.NewInt32Schema()},
+							"negative": {Value: openapi3.NewInt32Schema()},
+						},
+					},
+				},
+				"review-history-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"date", "positive", "negative", "score", "new_positive", "new_negative", "days", "review_bomb", "languages"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"date":         {Value: openapi3.NewInt64Schema()},
+							"positive":     {Value: openapi3.NewInt32Schema()},
+							"negative":     {Value: openapi3.NewInt32Schema()},
+							"score":        {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"new_positive": {Value: openapi3.NewInt32Schema()},
+							"new_negative": {Value: openapi3.NewInt32Schema()},
+							"days":         {Value: openapi3.NewInt32Schema().WithMin(1)},
+							"review_bomb":  {Value: openapi3.NewBoolSchema()},
+							"languages": {
+								Value: &openapi3.Schema{
+									Description: "From a sample of the latest reviews in all languages, not totals",
+									Type:        "array",
+									Items: &openapi3.SchemaRef{
+										Ref: "#/components/schemas/review-language-schema",
+									},
+								},
+							},
+						},
+					},
+				},
+				"item-recipe-input-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"item_def_id", "tag", "quantity"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"item_def_id": {Value: openapi3.NewInt32Schema()},
+							"tag":         {Value: openapi3.NewStringSchema()},
+							"quantity":    {Value: openapi3.NewInt32Schema()},
+						},
+					},
+				},
+				"item-recipe-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"inputs"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"inputs": {
+								Value: &openapi3.Schema{
+									Type: "array",
+									Items: &openapi3.SchemaRef{
+										Ref: "#/components/schemas/item-recipe-input-schema",
+									},
+								},
+							},
+						},
+					},
+				},
+				"item-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "item_def_id", "name", "description", "type", "quality", "tags", "tradable", "marketable", "commodity", "icon", "market_link", "prices", "recipes"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":      {Value: openapi3.NewInt32Schema()},
+							"item_def_id": {Value: openapi3.NewInt32Schema()},
+							"name":        {Value: openapi3.NewStringSchema()},
+							"description": {Value: openapi3.NewStringSchema()},
+							"type":        {Value: openapi3.NewStringSchema()},
+							"quality":     {Value: openapi3.NewStringSchema()},
+							"tags":        {Value: openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema())},
+							"tradable":    {Value: openapi3.NewBoolSchema()},
+							"marketable":  {Value: openapi3.NewBoolSchema()},
+							"commodity":   {Value: openapi3.NewBoolSchema()},
+							"icon":        {Value: openapi3.NewStringSchema()},
+							"market_link": {Value: openapi3.NewStringSchema()},
+							"prices":      {Value: &openapi3.Schema{Type: "object", AdditionalProperties: &openapi3.SchemaRef{Value: openapi3.NewInt32Schema()}}},
+							"recipes": {
+								Value: &openapi3.Schema{
+									Type: "array",
+									Items: &openapi3.SchemaRef{
+										Ref: "#/components/schemas/item-recipe-schema",
+									},
+								},
+							},
+						},
+					},
+				},
+				"bundle-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"id", "name", "type", "giftable", "apps", "packages", "discount", "price", "price_sale", "price_individual", "saving_percent", "image", "link", "created_at", "updated_at"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"id":               {Value: openapi3.NewInt32Schema()},
+							"name":             {Value: openapi3.NewStringSchema()},
+							"type":             {Value: openapi3.NewStringSchema().WithEnum("cts", "pt")},
+							"giftable":         {Value: openapi3.NewBoolSchema()},
+							"apps":             {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"packages":         {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"discount":         {Value: openapi3.NewInt32Schema()},
+							"price":            {Value: openapi3.NewInt32Schema()},
+							"price_sale":       {Value: openapi3.NewInt32Schema()},
+							"price_individual": {Value: openapi3.NewInt32Schema()},
+							"saving_percent":   {Value: openapi3.NewInt32Schema()},
+							"image":            {Value: openapi3.NewStringSchema()},
+							"link":             {Value: openapi3.NewStringSchema()},
+							"created_at":       {Value: openapi3.NewInt64Schema()},
+							"updated_at":       {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
+				"bundle-value-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"cc", "currency", "price", "individual", "saving", "saving_percent", "complete_the_set", "packages", "packages_owned"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"cc":               {Value: openapi3.NewStringSchema()},
+							"currency":         {Value: openapi3.NewStringSchema()},
+							"price":            {Value: openapi3.NewInt32Schema()},
+							"individual":       {Value: openapi3.NewInt32Schema()},
+							"saving":           {Value: openapi3.NewInt32Schema()},
+							"saving_percent":   {Value: openapi3.NewInt32Schema()},
+							"complete_the_set": {Value: openapi3.NewInt32Schema()},
+							"packages":         {Value: openapi3.NewInt32Schema()},
+							"packages_owned":   {Value: openapi3.NewInt32Schema()},
+						},
+					},
+				},
+				"region-price-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"rank", "cc", "currency", "price", "normalised", "normalised_currency", "difference_percent"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"rank":                {Value: openapi3.NewInt32Schema()},
+							"cc":                  {Value: openapi3.NewStringSchema()},
+							"currency":            {Value: openapi3.NewStringSchema()},
+							"price":               {Value: openapi3.NewInt32Schema()},
+							"normalised":          {Value: openapi3.NewInt32Schema()},
+							"normalised_currency": {Value: openapi3.NewStringSchema()},
+							"difference_percent":  {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+						},
+					},
+				},
+				"price-stats-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"cc", "currency", "current_price", "regular_price", "all_time_low", "all_time_low_at", "recent_low", "discount_count", "average_discount", "discount_cadence_days", "deal_score", "deal_rating"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"cc":                    {Value: openapi3.NewStringSchema()},
+							"currency":              {Value: openapi3.NewStringSchema()},
+							"current_price":         {Value: openapi3.NewInt32Schema()},
+							"regular_price":         {Value: openapi3.NewInt32Schema()},
+							"all_time_low":          {Value: openapi3.NewInt32Schema()},
+							"all_time_low_at":       {Value: openapi3.NewInt64Schema()},
+							"recent_low":            {Value: openapi3.NewInt32Schema()},
+							"discount_count":        {Value: openapi3.NewInt32Schema()},
+							"average_discount":      {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"discount_cadence_days": {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"deal_score":            {Value: openapi3.NewInt32Schema()},
+							"deal_rating":           {Value: openapi3.NewStringSchema()},
+						},
+					},
+				},
+				"coop-game-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"id", "name", "icon", "path", "score", "owners", "missing", "coop_tags", "recent_minutes", "reviews_score", "price", "missing_price"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"id":             {Value: openapi3.NewInt32Schema()},
+							"name":           {Value: openapi3.NewStringSchema()},
+							"icon":           {Value: openapi3.NewStringSchema()},
+							"path":           {Value: openapi3.NewStringSchema()},
+							"score":          {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"owners":         {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt64Schema())},
+							"missing":        {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt64Schema())},
+							"coop_tags":      {Value: openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema())},
+							"recent_minutes": {Value: openapi3.NewInt32Schema()},
+							"reviews_score":  {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"price":          {Value: openapi3.NewInt32Schema()},
+							"missing_price":  {Value: openapi3.NewInt32Schema()},
+						},
+					},
+				},
+				"achievement-stats-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"app_id", "players", "completionists", "completionist_percent", "sample_players", "median_completion_seconds_since_first_achievement", "rarity", "hardest", "unlock_order", "updated_at"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"app_id":                {Value: openapi3.NewInt32Schema()},
+							"players":               {Value: openapi3.NewInt32Schema()},
+							"completionists":        {Value: openapi3.NewInt32Schema()},
+							"completionist_percent": {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"sample_players":        {Value: openapi3.NewInt32Schema()},
+							"median_completion_seconds_since_first_achievement": {
+								Value: &openapi3.Schema{
+									Description: "From the first to the last achievement, Steam does not record when a game was first played",
+									Type:        "integer",
+									Format:      "int64",
+								},
+							},
+							"rarity": {
+								Value: openapi3.NewArraySchema().WithItems(&openapi3.Schema{
+									Required: []string{"name", "count"},
+									Properties: map[string]*openapi3.SchemaRef{
+										"name":  {Value: openapi3.NewStringSchema()},
+										"count": {Value: openapi3.NewInt32Schema()},
+									},
+								}),
+							},
+							"hardest":      {Value: openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema())},
+							"unlock_order": {Value: openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema())},
+							"updated_at":   {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
+				"franchise-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"id", "name", "path", "icon", "apps", "app_ids", "publishers", "developers", "players_week", "players_alltime", "owners", "reviews_positive", "reviews_negative", "reviews_score", "price"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"id":               {Value: openapi3.NewStringSchema()},
+							"name":             {Value: openapi3.NewStringSchema()},
+							"path":             {Value: openapi3.NewStringSchema()},
+							"icon":             {Value: openapi3.NewStringSchema()},
+							"apps":             {Value: openapi3.NewInt32Schema()},
+							"app_ids":          {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"publishers":       {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"developers":       {Value: openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema())},
+							"players_week":     {Value: openapi3.NewInt32Schema()},
+							"players_alltime":  {Value: openapi3.NewInt32Schema()},
+							"owners":           {Value: openapi3.NewInt64Schema()},
+							"reviews_positive": {Value: openapi3.NewInt32Schema()},
+							"reviews_negative": {Value: openapi3.NewInt32Schema()},
+							"reviews_score":    {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"price":            {Value: openapi3.NewInt32Schema()},
+						},
+					},
+				},
+				"exchange-rate-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"currency", "rate", "created_at"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"currency":   {Value: openapi3.NewStringSchema()},
+							"rate":       {Value: openapi3.NewFloat64Schema().WithFormat("double")},
+							"created_at": {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
 				"stat-schema": {
 					Value: &openapi3.Schema{
 						Required: []string{"id", "name"},
@@ -205,6 +543,21 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 						},
 					},
 				},
+				"player-history-schema": {
+					Value: &openapi3.Schema{
+						Required: []string{"type", "text", "item_id", "item_name", "item_path", "before", "after", "created_at"},
+						Properties: map[string]*openapi3.SchemaRef{
+							"type":       {Value: openapi3.NewStringSchema()},
+							"text":       {Value: openapi3.NewStringSchema()},
+							"item_id":    {Value: openapi3.NewInt64Schema()},
+							"item_name":  {Value: openapi3.NewStringSchema()},
+							"item_path":  {Value: openapi3.NewStringSchema()},
+							"before":     {Value: openapi3.NewStringSchema()},
+							"after":      {Value: openapi3.NewStringSchema()},
+							"created_at": {Value: openapi3.NewInt64Schema()},
+						},
+					},
+				},
 				"player-schema": {
 					Value: &openapi3.Schema{
 						Required: []string{"id", "name", "avatar", "badges", "games", "groups", "level", "playtime", "country", "continent", "state", "vanity_url"},
@@ -397,6 +750,50 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 						}),
 					},
 				},
+				"price-changes-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of price changes"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "prices", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"prices": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/price-change-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"sales-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of sales"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "sales", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"sales": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/sale-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
 				"player-response": {
 					Value: &openapi3.Response{
 						Description: helpers.StringPointer("A player"),
@@ -409,6 +806,28 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 						}),
 					},
 				},
+				"player-history-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Profile events for a player, newest first"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "events", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"events": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/player-history-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
 				"players-response": {
 					Value: &openapi3.Response{
 						Description: helpers.StringPointer("List of players"),
@@ -431,70 +850,348 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 						}),
 					},
 				},
-			},
-		},
-		Paths: openapi3.Paths{
-			"/articles": &openapi3.PathItem{
-				Get: &openapi3.Operation{
-					Tags:    []string{tagArticles},
-					Summary: "List Articles",
-					Parameters: openapi3.Parameters{
-						{Ref: "#/components/parameters/offset-param"},
-						{Ref: "#/components/parameters/limit-param"},
-						{Ref: "#/components/parameters/order-param-desc"},
-						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema())},
-						{Value: openapi3.NewQueryParameter("ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
-						{Value: openapi3.NewQueryParameter("app_ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
-						{Value: openapi3.NewQueryParameter("feed").WithSchema(openapi3.NewStringSchema())},
-					},
-					Responses: map[string]*openapi3.ResponseRef{
-						"200": {Ref: "#/components/responses/articles-response"},
-						"400": {Ref: "#/components/responses/articles-response"},
-						"401": {Ref: "#/components/responses/articles-response"},
-						"404": {Ref: "#/components/responses/articles-response"},
-						"500": {Ref: "#/components/responses/articles-response"},
+				"depot-history-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of build and manifest changes"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "history", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"history": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/depot-history-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
 					},
 				},
-			},
-			// "/articles/{id}": &openapi3.PathItem{
-			// 	// Get: &openapi3.Operation{
-			// 	// 	Tags: []string{TagPublic},
-			// 	// },
-			// },
-			"/games": &openapi3.PathItem{
-				Get: &openapi3.Operation{
-					Tags:    []string{tagGames},
-					Summary: "List Games",
-					Parameters: openapi3.Parameters{
-						{Ref: "#/components/parameters/offset-param"},
-						{Ref: "#/components/parameters/limit-param"},
-						{Ref: "#/components/parameters/order-param-desc"},
-						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema())},
-						{Value: openapi3.NewQueryParameter("ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
-						{Value: openapi3.NewQueryParameter("tags").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
-						{Value: openapi3.NewQueryParameter("genres").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
-						{Value: openapi3.NewQueryParameter("categories").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
-						{Value: openapi3.NewQueryParameter("developers").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
-						{Value: openapi3.NewQueryParameter("publishers").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
-						{Value: openapi3.NewQueryParameter("platforms").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema()).WithMaxItems(3))},
-					},
-					Responses: map[string]*openapi3.ResponseRef{
-						"200": {Ref: "#/components/responses/games-response"},
-						"400": {Ref: "#/components/responses/games-response"},
-						"401": {Ref: "#/components/responses/games-response"},
-						"404": {Ref: "#/components/responses/games-response"},
-						"500": {Ref: "#/components/responses/games-response"},
+				"pics-history-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of PICS keys that were added, removed or modified"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "history", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"history": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/pics-history-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
 					},
 				},
-			},
-			"/games/{id}": &openapi3.PathItem{
-				Get: &openapi3.Operation{
-					Tags:    []string{tagGames, TagPublic},
-					Summary: "Retrieve Game",
-					Parameters: openapi3.Parameters{
-						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
-					},
-					Responses: map[string]*openapi3.ResponseRef{
+				"review-history-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Daily review totals, newest first"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "history", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"history": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/review-history-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"item-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("An inventory item"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"item", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"item":  {Ref: "#/components/schemas/item-schema"},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"bundle-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("A bundle and its value against the individual package prices"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"bundle", "value", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"bundle": {Ref: "#/components/schemas/bundle-schema"},
+								"value":  {Ref: "#/components/schemas/bundle-value-schema"},
+								"error":  {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"bundles-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of bundles"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "bundles", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"bundles": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/bundle-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"items-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of inventory items"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "items", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"items": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/item-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"region-prices-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Prices in every region, converted into one currency, cheapest first"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"regions", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"regions": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/region-price-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"price-stats-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Historical lows and a deal score for the current price"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"stats", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"stats": {Ref: "#/components/schemas/price-stats-schema"},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"coop-games-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Games to play together, best first"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"games", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"games": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/coop-game-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"achievement-stats-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("Achievement analytics for a game"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"stats", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"stats": {Ref: "#/components/schemas/achievement-stats-schema"},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"franchise-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("A franchise"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"franchise", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"franchise": {Ref: "#/components/schemas/franchise-schema"},
+								"error":     {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"franchises-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of franchises"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"pagination", "franchises", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"pagination": {
+									Ref: "#/components/schemas/pagination-schema",
+								},
+								"franchises": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/franchise-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"exchange-rates-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("List of exchange rates, per US dollar"),
+						Content: openapi3.NewContentWithJSONSchema(&openapi3.Schema{
+							Required: []string{"rates", "error"},
+							Properties: map[string]*openapi3.SchemaRef{
+								"rates": {
+									Value: &openapi3.Schema{
+										Type: "array",
+										Items: &openapi3.SchemaRef{
+											Ref: "#/components/schemas/exchange-rate-schema",
+										},
+									},
+								},
+								"error": {Value: openapi3.NewStringSchema()},
+							},
+						}),
+					},
+				},
+				"changes-stream-response": {
+					Value: &openapi3.Response{
+						Description: helpers.StringPointer("A stream of changes, oldest first"),
+						Content: openapi3.Content{
+							"text/event-stream": openapi3.NewMediaType().WithSchemaRef(&openapi3.SchemaRef{
+								Ref: "#/components/schemas/change-schema",
+							}),
+						},
+					},
+				},
+			},
+		},
+		Paths: openapi3.Paths{
+			"/articles": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagArticles},
+					Summary: "List Articles",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/order-param-desc"},
+						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema())},
+						{Value: openapi3.NewQueryParameter("ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
+						{Value: openapi3.NewQueryParameter("app_ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
+						{Value: openapi3.NewQueryParameter("feed").WithSchema(openapi3.NewStringSchema())},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/articles-response"},
+						"400": {Ref: "#/components/responses/articles-response"},
+						"401": {Ref: "#/components/responses/articles-response"},
+						"404": {Ref: "#/components/responses/articles-response"},
+						"500": {Ref: "#/components/responses/articles-response"},
+					},
+				},
+			},
+			// "/articles/{id}": &openapi3.PathItem{
+			// 	// Get: &openapi3.Operation{
+			// 	// 	Tags: []string{TagPublic},
+			// 	// },
+			// },
+			"/games": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames},
+					Summary: "List Games",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/order-param-desc"},
+						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema())},
+						{Value: openapi3.NewQueryParameter("ids").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(100))},
+						{Value: openapi3.NewQueryParameter("tags").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
+						{Value: openapi3.NewQueryParameter("genres").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
+						{Value: openapi3.NewQueryParameter("categories").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
+						{Value: openapi3.NewQueryParameter("developers").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
+						{Value: openapi3.NewQueryParameter("publishers").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewInt32Schema()).WithMaxItems(10))},
+						{Value: openapi3.NewQueryParameter("platforms").WithSchema(openapi3.NewArraySchema().WithItems(openapi3.NewStringSchema()).WithMaxItems(3))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/games-response"},
+						"400": {Ref: "#/components/responses/games-response"},
+						"401": {Ref: "#/components/responses/games-response"},
+						"404": {Ref: "#/components/responses/games-response"},
+						"500": {Ref: "#/components/responses/games-response"},
+					},
+				},
+			},
+			"/games/coop": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagPlayers},
+					Summary: "List games owned by all or most of a group of players, for playing together",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewQueryParameter("players").WithRequired(true).WithSchema(openapi3.NewArraySchema().WithMinItems(1).WithMaxItems(10).WithItems(openapi3.NewInt64Schema()))},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/coop-games-response"},
+						"400": {Ref: "#/components/responses/coop-games-response"},
+						"401": {Ref: "#/components/responses/coop-games-response"},
+						"404": {Ref: "#/components/responses/coop-games-response"},
+						"500": {Ref: "#/components/responses/coop-games-response"},
+					},
+				},
+			},
+			"/games/{id}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, TagPublic},
+					Summary: "Retrieve Game",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
 						"200": {Ref: "#/components/responses/game-response"},
 						"400": {Ref: "#/components/responses/message-response"},
 						"401": {Ref: "#/components/responses/message-response"},
@@ -519,6 +1216,219 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 					},
 				},
 			},
+			"/games/{id}/prices": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagPrices},
+					Summary: "List game price history",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/price-changes-response"},
+						"400": {Ref: "#/components/responses/price-changes-response"},
+						"401": {Ref: "#/components/responses/price-changes-response"},
+						"404": {Ref: "#/components/responses/price-changes-response"},
+						"500": {Ref: "#/components/responses/price-changes-response"},
+					},
+				},
+			},
+			"/games/{id}/depots": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagDepots},
+					Summary: "List game build and manifest history",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("branch").WithSchema(openapi3.NewStringSchema())},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/depot-history-response"},
+						"400": {Ref: "#/components/responses/depot-history-response"},
+						"401": {Ref: "#/components/responses/depot-history-response"},
+						"404": {Ref: "#/components/responses/depot-history-response"},
+						"500": {Ref: "#/components/responses/depot-history-response"},
+					},
+				},
+			},
+			"/depots/{id}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagDepots},
+					Summary: "List depot manifest history",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/depot-history-response"},
+						"400": {Ref: "#/components/responses/depot-history-response"},
+						"401": {Ref: "#/components/responses/depot-history-response"},
+						"404": {Ref: "#/components/responses/depot-history-response"},
+						"500": {Ref: "#/components/responses/depot-history-response"},
+					},
+				},
+			},
+			"/games/{id}/pics-history": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagChanges},
+					Summary: "List game PICS key changes",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("key").WithSchema(openapi3.NewStringSchema())},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/pics-history-response"},
+						"400": {Ref: "#/components/responses/pics-history-response"},
+						"401": {Ref: "#/components/responses/pics-history-response"},
+						"404": {Ref: "#/components/responses/pics-history-response"},
+						"500": {Ref: "#/components/responses/pics-history-response"},
+					},
+				},
+			},
+			"/games/{id}/reviews": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames},
+					Summary: "List game review history",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/review-history-response"},
+						"400": {Ref: "#/components/responses/review-history-response"},
+						"401": {Ref: "#/components/responses/review-history-response"},
+						"404": {Ref: "#/components/responses/review-history-response"},
+						"500": {Ref: "#/components/responses/review-history-response"},
+					},
+				},
+			},
+			"/games/{id}/items": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagItems},
+					Summary: "List game inventory items",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/items-response"},
+						"400": {Ref: "#/components/responses/items-response"},
+						"401": {Ref: "#/components/responses/items-response"},
+						"404": {Ref: "#/components/responses/items-response"},
+						"500": {Ref: "#/components/responses/items-response"},
+					},
+				},
+			},
+			"/games/{id}/items/{item}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagItems},
+					Summary: "Retrieve game inventory item",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Value: openapi3.NewPathParameter("item").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/item-response"},
+						"400": {Ref: "#/components/responses/item-response"},
+						"401": {Ref: "#/components/responses/item-response"},
+						"404": {Ref: "#/components/responses/item-response"},
+						"500": {Ref: "#/components/responses/item-response"},
+					},
+				},
+			},
+			"/games/{id}/price-stats": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagPrices},
+					Summary: "Retrieve game price statistics and deal score",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/price-stats-response"},
+						"400": {Ref: "#/components/responses/price-stats-response"},
+						"401": {Ref: "#/components/responses/price-stats-response"},
+						"404": {Ref: "#/components/responses/price-stats-response"},
+						"500": {Ref: "#/components/responses/price-stats-response"},
+					},
+				},
+			},
+			"/games/{id}/regions": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames, tagPrices},
+					Summary: "List game prices in every region, cheapest first",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/region-prices-response"},
+						"400": {Ref: "#/components/responses/region-prices-response"},
+						"401": {Ref: "#/components/responses/region-prices-response"},
+						"404": {Ref: "#/components/responses/region-prices-response"},
+						"500": {Ref: "#/components/responses/region-prices-response"},
+					},
+				},
+			},
+			"/games/{id}/achievement-stats": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagGames},
+					Summary: "Retrieve achievement rarity, unlock order and completionists for a game",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/achievement-stats-response"},
+						"400": {Ref: "#/components/responses/achievement-stats-response"},
+						"401": {Ref: "#/components/responses/achievement-stats-response"},
+						"404": {Ref: "#/components/responses/achievement-stats-response"},
+						"500": {Ref: "#/components/responses/achievement-stats-response"},
+					},
+				},
+			},
+			"/franchises": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagFranchises},
+					Summary: "List Franchises",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/order-param-desc"},
+						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema().WithEnum("name", "apps", "players", "owners", "score").WithDefault("players"))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/franchises-response"},
+						"400": {Ref: "#/components/responses/franchises-response"},
+						"401": {Ref: "#/components/responses/franchises-response"},
+						"404": {Ref: "#/components/responses/franchises-response"},
+						"500": {Ref: "#/components/responses/franchises-response"},
+					},
+				},
+			},
+			"/franchises/{id}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagFranchises},
+					Summary: "Retrieve Franchise",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewStringSchema().WithMinLength(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/franchise-response"},
+						"400": {Ref: "#/components/responses/franchise-response"},
+						"401": {Ref: "#/components/responses/franchise-response"},
+						"404": {Ref: "#/components/responses/franchise-response"},
+						"500": {Ref: "#/components/responses/franchise-response"},
+					},
+				},
+			},
 			"/groups": &openapi3.PathItem{
 				Get: &openapi3.Operation{
 					Tags:    []string{tagGroups},
@@ -544,6 +1454,46 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 			// 	// 	Tags: []string{TagPublic},
 			// 	// },
 			// },
+			"/bundles": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagBundles},
+					Summary: "List Bundles",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/order-param-desc"},
+						{Ref: "#/components/parameters/cc-param"},
+						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema().WithEnum("id", "name", "discount", "price", "savings", "apps", "created_at").WithDefault("id"))},
+						{Value: openapi3.NewQueryParameter("type").WithSchema(openapi3.NewStringSchema().WithEnum("cts", "pt"))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/bundles-response"},
+						"400": {Ref: "#/components/responses/bundles-response"},
+						"401": {Ref: "#/components/responses/bundles-response"},
+						"404": {Ref: "#/components/responses/bundles-response"},
+						"500": {Ref: "#/components/responses/bundles-response"},
+					},
+				},
+			},
+			"/bundles/{id}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:        []string{tagBundles, tagPrices},
+					Summary:     "Retrieve bundle value against individual package prices",
+					Description: "Pass a player ID to get their complete the set price, with owned packages removed",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+						{Value: openapi3.NewQueryParameter("player_id").WithSchema(openapi3.NewInt64Schema().WithMin(1))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/bundle-response"},
+						"400": {Ref: "#/components/responses/bundle-response"},
+						"401": {Ref: "#/components/responses/bundle-response"},
+						"404": {Ref: "#/components/responses/bundle-response"},
+						"500": {Ref: "#/components/responses/bundle-response"},
+					},
+				},
+			},
 			"/packages": &openapi3.PathItem{
 				Get: &openapi3.Operation{
 					Tags:    []string{tagPackages},
@@ -567,6 +1517,76 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 					},
 				},
 			},
+			"/packages/{id}/prices": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPackages, tagPrices},
+					Summary: "List package price history",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/price-changes-response"},
+						"400": {Ref: "#/components/responses/price-changes-response"},
+						"401": {Ref: "#/components/responses/price-changes-response"},
+						"404": {Ref: "#/components/responses/price-changes-response"},
+						"500": {Ref: "#/components/responses/price-changes-response"},
+					},
+				},
+			},
+			"/packages/{id}/price-stats": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPackages, tagPrices},
+					Summary: "Retrieve package price statistics and deal score",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/price-stats-response"},
+						"400": {Ref: "#/components/responses/price-stats-response"},
+						"401": {Ref: "#/components/responses/price-stats-response"},
+						"404": {Ref: "#/components/responses/price-stats-response"},
+						"500": {Ref: "#/components/responses/price-stats-response"},
+					},
+				},
+			},
+			"/packages/{id}/regions": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPackages, tagPrices},
+					Summary: "List package prices in every region, cheapest first",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/cc-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/region-prices-response"},
+						"400": {Ref: "#/components/responses/region-prices-response"},
+						"401": {Ref: "#/components/responses/region-prices-response"},
+						"404": {Ref: "#/components/responses/region-prices-response"},
+						"500": {Ref: "#/components/responses/region-prices-response"},
+					},
+				},
+			},
+			"/packages/{id}/pics-history": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPackages, tagChanges},
+					Summary: "List package PICS key changes",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("key").WithSchema(openapi3.NewStringSchema())},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/pics-history-response"},
+						"400": {Ref: "#/components/responses/pics-history-response"},
+						"401": {Ref: "#/components/responses/pics-history-response"},
+						"404": {Ref: "#/components/responses/pics-history-response"},
+						"500": {Ref: "#/components/responses/pics-history-response"},
+					},
+				},
+			},
 			// "/packages/{id}": &openapi3.PathItem{
 			// 	// Get: &openapi3.Operation{
 			// 	// 	Tags: []string{TagPublic},
@@ -617,16 +1637,162 @@ func GetGlobalSteam() (swagger *openapi3.T) {
 					},
 				},
 			},
+			"/players/{id}/history": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPlayers},
+					Summary: "List profile events for a player",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("id").WithRequired(true).WithSchema(openapi3.NewInt64Schema().WithMin(1))},
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("type").WithSchema(openapi3.NewStringSchema().WithEnum("level", "badge", "game", "completed", "name", "vac_ban", "game_ban", "friend_added", "friend_removed"))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/player-history-response"},
+						"400": {Ref: "#/components/responses/player-history-response"},
+						"401": {Ref: "#/components/responses/player-history-response"},
+						"500": {Ref: "#/components/responses/player-history-response"},
+					},
+				},
+			},
+			"/prices": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPrices},
+					Summary: "List latest price changes",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Ref: "#/components/parameters/cc-param"},
+						{Value: openapi3.NewQueryParameter("type").WithSchema(openapi3.NewStringSchema().WithEnum("app", "package"))},
+						{Value: openapi3.NewQueryParameter("percent_min").WithSchema(openapi3.NewFloat64Schema())},
+						{Value: openapi3.NewQueryParameter("percent_max").WithSchema(openapi3.NewFloat64Schema())},
+						{Value: openapi3.NewQueryParameter("price_min").WithSchema(openapi3.NewInt32Schema().WithMin(0))},
+						{Value: openapi3.NewQueryParameter("price_max").WithSchema(openapi3.NewInt32Schema().WithMin(0))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/price-changes-response"},
+						"400": {Ref: "#/components/responses/price-changes-response"},
+						"401": {Ref: "#/components/responses/price-changes-response"},
+						"404": {Ref: "#/components/responses/price-changes-response"},
+						"500": {Ref: "#/components/responses/price-changes-response"},
+					},
+				},
+			},
+			"/exchange-rates": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPrices},
+					Summary: "List latest exchange rates",
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/exchange-rates-response"},
+						"400": {Ref: "#/components/responses/exchange-rates-response"},
+						"401": {Ref: "#/components/responses/exchange-rates-response"},
+						"404": {Ref: "#/components/responses/exchange-rates-response"},
+						"500": {Ref: "#/components/responses/exchange-rates-response"},
+					},
+				},
+			},
+			"/exchange-rates/{currency}": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPrices},
+					Summary: "List exchange rate history for a currency",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewPathParameter("currency").WithRequired(true).WithSchema(openapi3.NewStringSchema().WithMinLength(3).WithMaxLength(3))},
+						{Ref: "#/components/parameters/limit-param"},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/exchange-rates-response"},
+						"400": {Ref: "#/components/responses/exchange-rates-response"},
+						"401": {Ref: "#/components/responses/exchange-rates-response"},
+						"404": {Ref: "#/components/responses/exchange-rates-response"},
+						"500": {Ref: "#/components/responses/exchange-rates-response"},
+					},
+				},
+			},
+			"/sales": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:    []string{tagPrices},
+					Summary: "List current sales",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("order").WithSchema(openapi3.NewStringSchema().WithEnum("asc", "desc").WithDefault("asc"))},
+						{Value: openapi3.NewQueryParameter("sort").WithSchema(openapi3.NewStringSchema().WithEnum("ends", "discount", "rating", "release_date").WithDefault("ends"))},
+						{Value: openapi3.NewQueryParameter("sale_type").WithSchema(openapi3.NewArraySchema().WithMaxItems(10).WithItems(openapi3.NewStringSchema()))},
+						{Value: openapi3.NewQueryParameter("app_type").WithSchema(openapi3.NewArraySchema().WithMaxItems(10).WithItems(openapi3.NewStringSchema()))},
+						{Value: openapi3.NewQueryParameter("discount_min").WithSchema(openapi3.NewInt32Schema().WithMin(0).WithMax(100))},
+						{Value: openapi3.NewQueryParameter("discount_max").WithSchema(openapi3.NewInt32Schema().WithMin(0).WithMax(100))},
+						{Value: openapi3.NewQueryParameter("app_ids").WithSchema(openapi3.NewArraySchema().WithMaxItems(100).WithItems(openapi3.NewInt32Schema()))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/sales-response"},
+						"400": {Ref: "#/components/responses/sales-response"},
+						"401": {Ref: "#/components/responses/sales-response"},
+						"404": {Ref: "#/components/responses/sales-response"},
+						"500": {Ref: "#/components/responses/sales-response"},
+					},
+				},
+			},
+			"/changes/pics-history": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:        []string{tagChanges},
+					Summary:     "Search PICS key changes",
+					Description: "Needs a `change_number` or a `key`. Depots and launch options are flattened to keys like `441.manifests.public` and `0.executable`.",
+					Parameters: openapi3.Parameters{
+						{Ref: "#/components/parameters/offset-param"},
+						{Ref: "#/components/parameters/limit-param"},
+						{Value: openapi3.NewQueryParameter("change_number").WithSchema(openapi3.NewInt32Schema().WithMin(1))},
+						{Value: openapi3.NewQueryParameter("field").WithSchema(openapi3.NewStringSchema().WithEnum("common", "extended", "config", "ufs", "depots", "launch", "package"))},
+						{Value: openapi3.NewQueryParameter("key").WithSchema(openapi3.NewStringSchema())},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/pics-history-response"},
+						"400": {Ref: "#/components/responses/pics-history-response"},
+						"401": {Ref: "#/components/responses/pics-history-response"},
+						"404": {Ref: "#/components/responses/pics-history-response"},
+						"500": {Ref: "#/components/responses/pics-history-response"},
+					},
+				},
+			},
+			"/changes/stream": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:        []string{tagChanges},
+					Summary:     "Stream PICS changes",
+					Description: "Server-Sent Events, one `change` event per change number. Resume with `since` or the `Last-Event-ID` header.",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewQueryParameter("since").WithSchema(openapi3.NewInt32Schema().WithMin(0))},
+						{Value: openapi3.NewQueryParameter("app_ids").WithSchema(openapi3.NewArraySchema().WithMaxItems(100).WithItems(openapi3.NewInt32Schema()))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"200": {Ref: "#/components/responses/changes-stream-response"},
+						"400": {Ref: "#/components/responses/message-response"},
+						"401": {Ref: "#/components/responses/message-response"},
+						"429": {Ref: "#/components/responses/message-response"},
+						"500": {Ref: "#/components/responses/message-response"},
+					},
+				},
+			},
+			"/changes/ws": &openapi3.PathItem{
+				Get: &openapi3.Operation{
+					Tags:        []string{tagChanges},
+					Summary:     "Stream PICS changes over a WebSocket",
+					Description: "One JSON change per message. Resume with `since`.",
+					Parameters: openapi3.Parameters{
+						{Value: openapi3.NewQueryParameter("since").WithSchema(openapi3.NewInt32Schema().WithMin(0))},
+						{Value: openapi3.NewQueryParameter("app_ids").WithSchema(openapi3.NewArraySchema().WithMaxItems(100).WithItems(openapi3.NewInt32Schema()))},
+					},
+					Responses: map[string]*openapi3.ResponseRef{
+						"101": {Ref: "#/components/responses/changes-stream-response"},
+						"400": {Ref: "#/components/responses/message-response"},
+						"401": {Ref: "#/components/responses/message-response"},
+						"429": {Ref: "#/components/responses/message-response"},
+						"500": {Ref: "#/components/responses/message-response"},
+					},
+				},
+			},
 			// "/app - players",
-			// "/app - price changes",
-			// "/bundles",
-			// "/bundles",
-			// "/bundles/{id}",
-			// "/changes",
 			// "/players/{id}/update"
 			// "/players/{id}/badges"
 			// "/players/{id}/games"
-			// "/players/{id}/history"
 			// "/stats/Categories"
 			// "/stats/Genres"
 			// "/stats/Publishers"
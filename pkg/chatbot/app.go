@@ -63,7 +63,7 @@ func (c CommandApp) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandApp) Output(_ string, region steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandApp) Output(_ string, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["game"] == "" {
 		message.Content = "Missing game name"
@@ -83,7 +83,7 @@ func (c CommandApp) Output(_ string, region steamapi.ProductCC, inputs map[strin
 		return message, err
 	}
 
-	message.Embed = getAppEmbed(c.ID(), app, region)
+	message.Embed = getAppEmbed(c.ID(), app, region, lang)
 
 	return message, nil
 }
@@ -65,7 +65,7 @@ func (c CommandAppFollowers) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandAppFollowers) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandAppFollowers) Output(_ string, _ steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["game"] == "" {
 		message.Content = "Missing game name"
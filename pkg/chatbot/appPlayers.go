@@ -65,7 +65,7 @@ func (c CommandAppPlayers) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandAppPlayers) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandAppPlayers) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["game"] == "" {
 		message.Content = "Missing game name"
@@ -99,17 +99,17 @@ func (c CommandAppPlayers) Output(_ string, _ steamapi.ProductCC, inputs map[str
 		Image:     &discordgo.MessageEmbedImage{URL: charts.GetAppPlayersChart(c.ID(), app.ID, "10m", "7d", "Players (1 Week)")},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Now",
+				Name:   T(lang, "field.now"),
 				Value:  humanize.Comma(i),
 				Inline: true,
 			},
 			{
-				Name:   "7 Days",
+				Name:   T(lang, "field.seven_days"),
 				Value:  humanize.Comma(int64(app.PlayerPeakWeek)),
 				Inline: true,
 			},
 			{
-				Name:   "All Time",
+				Name:   T(lang, "field.all_time"),
 				Value:  humanize.Comma(int64(app.PlayerPeakAllTime)),
 				Inline: true,
 			},
@@ -9,6 +9,8 @@ import (
 	"github.com/gamedb/gamedb/pkg/config"
 	"github.com/gamedb/gamedb/pkg/elasticsearch"
 	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
 )
 
 type CommandAppPrice struct {
@@ -74,7 +76,7 @@ func (c CommandAppPrice) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandAppPrice) Output(_ string, region steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandAppPrice) Output(_ string, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["game"] == "" {
 		message.Content = "Missing game name"
@@ -123,5 +125,27 @@ func (c CommandAppPrice) Output(_ string, region steamapi.ProductCC, inputs map[
 		Image:       &discordgo.MessageEmbedImage{URL: charts.GetPriceChart(region, c.ID(), app.ID, "Price History")},
 	}
 
+	// Cheapest region, converted into the requested region's currency
+	rates, err := mongo.GetExchangeRates()
+	if err != nil {
+		log.ErrS(err)
+	} else {
+
+		cheapest := app.Prices.RegionPrices(rates, region).Cheapest()
+		if cheapest.Rank > 0 && cheapest.ProductCC != region {
+
+			var value = strings.ToUpper(string(cheapest.ProductCC)) + ": " + cheapest.Price.GetFinal() + " (≈ " + cheapest.GetNormalised()
+			if price.Exists {
+				value += ", " + cheapest.GetDifferencePercent()
+			}
+
+			message.Embed.Fields = append(message.Embed.Fields, &discordgo.MessageEmbedField{
+				Name:   T(lang, "field.cheapest_region"),
+				Value:  value + ")",
+				Inline: true,
+			})
+		}
+	}
+
 	return message, nil
 }
@@ -0,0 +1,166 @@
+package chatbot
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/pkg/chatbot/charts"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+type CommandAppPriceHistory struct {
+}
+
+func (c CommandAppPriceHistory) ID() string {
+	return CAppPriceHistory
+}
+
+func (CommandAppPriceHistory) Regex() string {
+	return `^[.|!]price-history\s?([a-zA-Z]{2})?\s(.+)`
+}
+
+func (CommandAppPriceHistory) DisableCache() bool {
+	return false
+}
+
+func (CommandAppPriceHistory) PerProdCode() bool {
+	return true
+}
+
+func (CommandAppPriceHistory) AllowDM() bool {
+	return false
+}
+
+func (CommandAppPriceHistory) Example() string {
+	return ".price-history {region}? {game}"
+}
+
+func (CommandAppPriceHistory) Description() string {
+	return "Retrieve a chart of a game's price history in a region"
+}
+
+func (CommandAppPriceHistory) Type() CommandType {
+	return TypeGame
+}
+
+func (c CommandAppPriceHistory) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+
+	region, game := matches[1], matches[2]
+
+	// Games can start with two letter words, e.g. Ys Origin
+	if region != "" && !i18n.IsValidProdCC(steamapi.ProductCC(strings.ToLower(region))) {
+		game = region + " " + game
+		region = ""
+	}
+
+	return map[string]string{
+		"region": region,
+		"game":   game,
+	}
+}
+
+func (c CommandAppPriceHistory) Slash() []*discordgo.ApplicationCommandOption {
+
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "game",
+			Description: "The name or ID of the game",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+		},
+		{
+			Name:        "region",
+			Description: "The region code",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+		},
+	}
+}
+
+func (c CommandAppPriceHistory) Output(_ string, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	if inputs["game"] == "" {
+		message.Content = "Missing game name"
+		return message, nil
+	}
+
+	if inputs["region"] != "" {
+		val, ok := i18n.ProductCountryCodes[steamapi.ProductCC(strings.ToLower(inputs["region"]))]
+		if ok {
+			if val.Enabled {
+				region = val.ProductCode
+			} else {
+				message.Content = "We are not currently tracking " + strings.ToUpper(inputs["region"])
+				return message, nil
+			}
+		} else {
+			message.Content = "Invalid region: " + strings.ToUpper(inputs["region"])
+			return message, nil
+		}
+	}
+
+	apps, err := elasticsearch.SearchAppsSimple(1, inputs["game"])
+	if err != nil {
+		return message, err
+	} else if len(apps) == 0 {
+		message.Content = "Game **" + inputs["game"] + "** not found on Steam"
+		return message, nil
+	}
+
+	app := apps[0]
+	price := app.Prices.Get(region)
+
+	message.Embed = &discordgo.MessageEmbed{
+		Title:     app.GetName() + " (" + strings.ToUpper(string(region)) + ")",
+		URL:       config.C.GlobalSteamDomain + app.GetPath() + "#prices",
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: app.GetHeaderImage(), Width: 460, Height: 215},
+		Footer:    getFooter(),
+		Color:     greenHexDec,
+		Image:     &discordgo.MessageEmbedImage{URL: charts.GetPriceChart(region, c.ID(), app.ID, "Price History")},
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   T(lang, "field.price"),
+				Value:  price.GetFinal(),
+				Inline: true,
+			},
+		},
+	}
+
+	stats, err := mongo.GetProductPriceStatsForApps([]int{app.ID}, region)
+	if err != nil {
+		return message, err
+	}
+
+	if stat, ok := stats[app.ID]; ok {
+
+		var currency = i18n.GetProdCC(region).CurrencyCode
+
+		message.Embed.Fields = append(message.Embed.Fields,
+			&discordgo.MessageEmbedField{
+				Name:   T(lang, "field.all_time_low"),
+				Value:  i18n.FormatPrice(currency, stat.AllTimeLow) + " (" + stat.AllTimeLowAt.Format(helpers.DateYear) + ")",
+				Inline: true,
+			},
+			&discordgo.MessageEmbedField{
+				Name:   T(lang, "field.regular_price"),
+				Value:  i18n.FormatPrice(currency, stat.RegularPrice),
+				Inline: true,
+			},
+			&discordgo.MessageEmbedField{
+				Name:   T(lang, "field.discounts"),
+				Value:  strconv.Itoa(stat.DiscountCount),
+				Inline: true,
+			},
+		)
+	}
+
+	return message, nil
+}
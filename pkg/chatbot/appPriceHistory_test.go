@@ -0,0 +1,27 @@
+package chatbot
+
+import (
+	"testing"
+)
+
+func TestPriceHistoryLegacyInputs(t *testing.T) {
+
+	tests := map[string][2]string{
+		".price-history tf2":                        {"", "tf2"},
+		".price-history uk tf2":                     {"uk", "tf2"},
+		".price-history US Dota 2":                  {"US", "Dota 2"},
+		".price-history Ys Origin":                  {"", "Ys Origin"},
+		"!price-history uk Ys Origin":               {"uk", "Ys Origin"},
+		".price-history Go Home Dad":                {"", "Go Home Dad"},
+		".price-history Ys VIII: Lacrimosa of DANA": {"", "Ys VIII: Lacrimosa of DANA"},
+	}
+
+	for input, expected := range tests {
+
+		inputs := CommandAppPriceHistory{}.LegacyInputs(input)
+
+		if inputs["region"] != expected[0] || inputs["game"] != expected[1] {
+			t.Error(input, "got", inputs)
+		}
+	}
+}
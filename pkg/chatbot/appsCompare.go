@@ -0,0 +1,158 @@
+package chatbot
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/dustin/go-humanize"
+	"github.com/gamedb/gamedb/pkg/chatbot/charts"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+const maxAppsToCompare = 4
+
+// "vs" needs spaces on both sides, so titles like "Plants vs. Zombies" are not split
+var regexCompareSeparator = regexp.MustCompile(`(?i)\s*[,|]\s*|\s+vs\s+`)
+
+type CommandAppsCompare struct {
+}
+
+func (c CommandAppsCompare) ID() string {
+	return CAppsCompare
+}
+
+func (CommandAppsCompare) Regex() string {
+	return `^[.|!]compare (.+)`
+}
+
+func (CommandAppsCompare) DisableCache() bool {
+	return false
+}
+
+func (CommandAppsCompare) PerProdCode() bool {
+	return true
+}
+
+func (CommandAppsCompare) AllowDM() bool {
+	return false
+}
+
+func (CommandAppsCompare) Example() string {
+	return ".compare {game}, {game}, {game}?, {game}?"
+}
+
+func (CommandAppsCompare) Description() string {
+	return "Compare two to four games side by side"
+}
+
+func (CommandAppsCompare) Type() CommandType {
+	return TypeGame
+}
+
+func (c CommandAppsCompare) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+	games := regexCompareSeparator.Split(matches[1], -1)
+
+	var inputs = map[string]string{}
+	for k, v := range games {
+		if k < maxAppsToCompare {
+			inputs["game"+strconv.Itoa(k+1)] = v
+		}
+	}
+	return inputs
+}
+
+func (c CommandAppsCompare) Slash() []*discordgo.ApplicationCommandOption {
+
+	var options []*discordgo.ApplicationCommandOption
+	for i := 1; i <= maxAppsToCompare; i++ {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Name:        "game" + strconv.Itoa(i),
+			Description: "The name or ID of a game",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    i <= 2,
+		})
+	}
+	return options
+}
+
+func (c CommandAppsCompare) Output(_ string, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	var apps []mongo.App
+	var seen = map[int]bool{}
+
+	for i := 1; i <= maxAppsToCompare; i++ {
+
+		search := strings.TrimSpace(inputs["game"+strconv.Itoa(i)])
+		if search == "" {
+			continue
+		}
+
+		results, err := elasticsearch.SearchAppsSimple(1, search)
+		if err != nil {
+			return message, err
+		} else if len(results) == 0 {
+			message.Content = "Game **" + search + "** not found on Steam"
+			return message, nil
+		}
+
+		if seen[results[0].ID] {
+			continue
+		}
+		seen[results[0].ID] = true
+
+		app, err := mongo.GetApp(results[0].ID)
+		if err != nil {
+			return message, err
+		}
+
+		apps = append(apps, app)
+	}
+
+	if len(apps) < 2 {
+		message.Content = "Please enter at least two different games"
+		return message, nil
+	}
+
+	var ids []int
+	var idStrings []string
+	var names []string
+
+	message.Embed = &discordgo.MessageEmbed{
+		Footer: getFooter(),
+		Color:  greenHexDec,
+	}
+
+	for _, app := range apps {
+
+		ids = append(ids, app.ID)
+		idStrings = append(idStrings, strconv.Itoa(app.ID))
+		names = append(names, app.GetName())
+
+		var lines = []string{
+			"**" + T(lang, "field.max_weekly_players") + "**: " + humanize.Comma(int64(app.GetPlayersPeakWeek())),
+			"**" + T(lang, "field.review_score") + "**: " + app.GetReviewScore(),
+			"**" + T(lang, "field.price") + "**: " + app.GetPrices().Get(region).GetFinal(),
+			"**" + T(lang, "field.followers") + "**: " + app.GetFollowers(),
+		}
+
+		message.Embed.Fields = append(message.Embed.Fields, &discordgo.MessageEmbedField{
+			Name:   app.GetName(),
+			Value:  strings.Join(lines, "\n"),
+			Inline: true,
+		})
+	}
+
+	message.Embed.Title = helpers.TruncateString(strings.Join(names, " vs "), 256, "...")
+	message.Embed.URL = config.C.GlobalSteamDomain + "/games/compare/" + strings.Join(idStrings, ",")
+	message.Embed.Image = &discordgo.MessageEmbedImage{URL: charts.GetAppsPlayersChart(c.ID(), ids, names, "Players (90 Days)")}
+
+	return message, nil
+}
@@ -0,0 +1,36 @@
+package chatbot
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCompareLegacyInputs(t *testing.T) {
+
+	tests := map[string][]string{
+		".compare tf2, csgo":                            {"tf2", "csgo"},
+		".compare tf2 vs csgo":                          {"tf2", "csgo"},
+		".compare tf2 VS csgo":                          {"tf2", "csgo"},
+		".compare tf2 | csgo|dota 2":                    {"tf2", "csgo", "dota 2"},
+		".compare Plants vs. Zombies, Terraria":         {"Plants vs. Zombies", "Terraria"},
+		".compare Plants vs. Zombies vs Terraria":       {"Plants vs. Zombies", "Terraria"},
+		".compare a, b, c, d, e":                        {"a", "b", "c", "d"},
+		"!compare Plants vs. Zombies: Game of the Year": {"Plants vs. Zombies: Game of the Year"},
+	}
+
+	for input, expected := range tests {
+
+		inputs := CommandAppsCompare{}.LegacyInputs(input)
+
+		if len(inputs) != len(expected) {
+			t.Error(input, "got", inputs)
+			continue
+		}
+
+		for k, v := range expected {
+			if inputs["game"+strconv.Itoa(k+1)] != v {
+				t.Error(input, "got", inputs)
+			}
+		}
+	}
+}
@@ -54,7 +54,7 @@ func (c CommandAppsNew) Slash() []*discordgo.ApplicationCommandOption {
 	return []*discordgo.ApplicationCommandOption{}
 }
 
-func (CommandAppsNew) Output(authorID string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (CommandAppsNew) Output(authorID string, _ steamapi.ProductCC, _ Language, _ map[string]string) (message discordgo.MessageSend, err error) {
 
 	message.Embed = &discordgo.MessageEmbed{
 		Title:  "Popular New Apps",
@@ -54,7 +54,7 @@ func (c CommandAppsPopular) Slash() []*discordgo.ApplicationCommandOption {
 	return []*discordgo.ApplicationCommandOption{}
 }
 
-func (CommandAppsPopular) Output(authorID string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (CommandAppsPopular) Output(authorID string, _ steamapi.ProductCC, _ Language, _ map[string]string) (message discordgo.MessageSend, err error) {
 
 	message.Embed = &discordgo.MessageEmbed{
 		Title:  "Popular Games",
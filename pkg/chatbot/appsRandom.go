@@ -64,7 +64,7 @@ func (c CommandAppRandom) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandAppRandom) Output(_ string, region steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandAppRandom) Output(_ string, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	var filters = []elastic.Query{
 		elastic.NewTermsQuery("type", "game", ""),
@@ -95,7 +95,7 @@ func (c CommandAppRandom) Output(_ string, region steamapi.ProductCC, inputs map
 		return message, err
 	}
 
-	message.Embed = getAppEmbed(c.ID(), app, region)
+	message.Embed = getAppEmbed(c.ID(), app, region, lang)
 
 	return message, nil
 }
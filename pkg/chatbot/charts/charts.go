@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Jleagle/influxql"
@@ -58,17 +59,69 @@ func GetAppPlayersChart(commandID string, appID int, groupBy string, time string
 	return path
 }
 
+// Overlays each app's players, in the order given
+func GetAppsPlayersChart(commandID string, appIDs []int, names []string, title string) (path string) {
+
+	if len(appIDs) == 0 || len(appIDs) != len(names) {
+		return ""
+	}
+
+	var ids []string
+	for _, v := range appIDs {
+		ids = append(ids, strconv.Itoa(v))
+	}
+
+	builder := influxql.NewBuilder()
+	builder.AddSelect("MAX(player_count)", "max_player_count")
+	builder.SetFrom(influx.InfluxGameDB, influx.InfluxRetentionPolicyAllTime.String(), influx.InfluxMeasurementApps.String())
+	builder.AddWhere("time", ">", "NOW()-90d")
+	builder.AddWhereRaw(`"app_id" =~ /^(` + strings.Join(ids, "|") + `)$/`)
+	builder.AddGroupByTime("1d")
+	builder.AddGroupBy("app_id")
+	builder.SetFillNone()
+
+	resp, err := influx.InfluxQuery(builder)
+	if err != nil {
+		log.Err(err.Error())
+		return ""
+	}
+
+	var series []chartSeries
+	if len(resp.Results) > 0 {
+		for k, id := range ids {
+			for _, row := range resp.Results[0].Series {
+				if row.Tags["app_id"] == id {
+
+					x, y := influx.InfluxResponseToImageChartData(row)
+					series = append(series, chartSeries{Name: names[k], X: x, Y: y})
+				}
+			}
+		}
+	}
+
+	path, err = getMultiChart(series, strings.Join(ids, "-"), title, commandID)
+	if err != nil {
+		log.Err(err.Error())
+	}
+	return path
+}
+
 func GetPlayerChart(commandID string, playerID int64, field schemas.PlayerField, title string) (path string) {
 
+	return GetPlayerHistoryChart(commandID, playerID, field, "1d", "365d", title)
+}
+
+func GetPlayerHistoryChart(commandID string, playerID int64, field schemas.PlayerField, groupBy string, time string, title string) (path string) {
+
 	builder := influxql.NewBuilder()
 	builder.AddSelect("MAX("+string(field)+")", "max_"+string(field))
 	builder.SetFrom(influx.InfluxGameDB, influx.InfluxRetentionPolicyAllTime.String(), influx.InfluxMeasurementPlayers.String())
-	builder.AddWhere("time", ">", "NOW()-365d")
+	builder.AddWhere("time", ">", "NOW()-"+time)
 	builder.AddWhere("player_id", "=", playerID)
-	builder.AddGroupByTime("1d")
+	builder.AddGroupByTime(groupBy)
 	builder.SetFillNone()
 
-	path, err := getInfluxChart(commandID, builder, strconv.FormatInt(playerID, 10), title)
+	path, err := getInfluxChart(commandID, builder, strconv.FormatInt(playerID, 10)+"-"+string(field)+"-"+time, title)
 	if err != nil {
 		log.Err(err.Error())
 	}
@@ -121,7 +174,7 @@ func GetPriceChart(code steamapi.ProductCC, commandID string, id int, title stri
 		y = append(y, y[len(y)-1])
 	}
 
-	path, err = getChart(x, y, strconv.Itoa(id), title, commandID)
+	path, err = getChart(x, y, strconv.Itoa(id)+"-"+string(code), title, commandID)
 	if err != nil {
 		log.Err(err.Error())
 		return ""
@@ -130,25 +183,73 @@ func GetPriceChart(code steamapi.ProductCC, commandID string, id int, title stri
 	return path
 }
 
+type chartSeries struct {
+	Name string
+	X    []time.Time
+	Y    []float64
+}
+
+var (
+	colourDark   = drawing.ColorFromHex("1b2738")
+	colourLight  = drawing.ColorFromHex("e9ecef")
+	colourSeries = []drawing.Color{
+		drawing.ColorFromHex("28a745"), // Green
+		drawing.ColorFromHex("007bff"), // Blue
+		drawing.ColorFromHex("fd7e14"), // Orange
+		drawing.ColorFromHex("e83e8c"), // Pink
+	}
+)
+
 func getChart(x []time.Time, y []float64, id string, title string, commandID string) (path string, err error) {
 
-	if len(x) < 1 || len(y) < 1 {
-		return "", nil
+	return getMultiChart([]chartSeries{{X: x, Y: y}}, id, title, commandID)
+}
+
+// A legend is added when there is more than one series
+func getMultiChart(series []chartSeries, id string, title string, commandID string) (path string, err error) {
+
+	var all []float64
+	var timeSeries []chart.Series
+
+	// Overlapping fills would hide the other lines
+	var fill = colourDark
+	if len(series) > 1 {
+		fill = drawing.ColorTransparent
 	}
 
-	min := helpers.Max(helpers.Min(y...)-1, 0)
-	max := helpers.Max(y...) + 1
+	for k, v := range series {
 
-	if len(x) == 1 {
-		x = append(x, x[0].Add(-time.Hour))
-		y = append(y, y[0])
+		if len(v.X) < 1 || len(v.Y) < 1 {
+			continue
+		}
+
+		if len(v.X) == 1 {
+			v.X = append(v.X, v.X[0].Add(-time.Hour))
+			v.Y = append(v.Y, v.Y[0])
+		}
+
+		all = append(all, v.Y...)
+
+		timeSeries = append(timeSeries, chart.TimeSeries{
+			Name:  v.Name,
+			YAxis: chart.YAxisPrimary,
+			Style: chart.Style{
+				Show:        true,
+				StrokeColor: colourSeries[k%len(colourSeries)],
+				StrokeWidth: 2,
+				FillColor:   fill,
+			},
+			XValues: v.X,
+			YValues: v.Y,
+		})
 	}
 
-	var (
-		colourDark  = drawing.ColorFromHex("1b2738")
-		colourLight = drawing.ColorFromHex("e9ecef")
-		colourGreen = drawing.ColorFromHex("28a745")
-	)
+	if len(all) < 1 {
+		return "", nil
+	}
+
+	min := helpers.Max(helpers.Min(all...)-1, 0)
+	max := helpers.Max(all...) + 1
 
 	graph := chart.Chart{
 		Title: title,
@@ -192,19 +293,15 @@ func getChart(x []time.Time, y []float64, id string, title string, commandID str
 				return humanize.Commaf(helpers.RoundFloatTo2DP(v.(float64)))
 			},
 		},
-		Series: []chart.Series{
-			chart.TimeSeries{
-				YAxis: chart.YAxisPrimary,
-				Style: chart.Style{
-					Show:        true,
-					StrokeColor: colourGreen,
-					StrokeWidth: 2,
-					FillColor:   colourDark,
-				},
-				XValues: x,
-				YValues: y,
-			},
-		},
+		Series: timeSeries,
+	}
+
+	if len(timeSeries) > 1 {
+		graph.Elements = []chart.Renderable{chart.LegendThin(&graph, chart.Style{
+			FillColor:   colourDark,
+			FontColor:   colourLight,
+			StrokeColor: colourLight,
+		})}
 	}
 
 	buffer := bytes.NewBuffer([]byte{})
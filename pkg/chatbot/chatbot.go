@@ -53,7 +53,7 @@ type Command interface {
 	Regex() string
 	DisableCache() bool
 	PerProdCode() bool
-	Output(authorID string, region steamapi.ProductCC, inputs map[string]string) (discordgo.MessageSend, error)
+	Output(authorID string, region steamapi.ProductCC, lang Language, inputs map[string]string) (discordgo.MessageSend, error)
 	Example() string
 	Description() string
 	Type() CommandType
@@ -62,31 +62,47 @@ type Command interface {
 	AllowDM() bool
 }
 
+// Commands that act on the guild channel they were requested from
+type GuildCommand interface {
+	GuildOutput(authorID string, guild GuildContext, region steamapi.ProductCC, lang Language, inputs map[string]string) (discordgo.MessageSend, error)
+}
+
+type GuildContext struct {
+	GuildID   string
+	ChannelID string
+	Admin     bool // Has the Manage Server permission
+}
+
 // These are the discord slash command names, if changed, the old one needs to be deleted
 const (
-	CApp            = "game"            //
-	CAppFollowers   = "followers"       //
-	CAppPlayers     = "players"         //
-	CAppPrice       = "price"           //
-	CAppsRandom     = "random"          //
-	CAppsNew        = "new"             //
-	CAppsPopular    = "top"             //
-	CAppsTrending   = "trending-games"  //
-	CGroup          = "group"           //
-	CGroupsTrending = "trending-groups" //
-	CPlayer         = "player"          //
-	CPlayerApps     = "games"           // Count
-	CPlayerLevel    = "level"           //
-	CPlayerPlaytime = "playtime"        //
-	CPlayerRecent   = "recent"          //
-	CPlayerUpdate   = "update"          //
-	CPlayerWishlist = "wishlist"        //
-	CPlayerLibrary  = "library"         //
-	CHelp           = "help"            //
-	CFeedback       = "feedback"        //
-	CInvite         = "invite"          //
-	CSettings       = "settings"        //
-	CSteamOnline    = "online"          //
+	CApp             = "game"            //
+	CAppFollowers    = "followers"       //
+	CAppPlayers      = "players"         //
+	CAppPrice        = "price"           //
+	CAppPriceHistory = "price-history"   //
+	CAppsRandom      = "random"          //
+	CAppsNew         = "new"             //
+	CAppsPopular     = "top"             //
+	CAppsTrending    = "trending-games"  //
+	CAppsCompare     = "compare"         //
+	CGroup           = "group"           //
+	CGroupsTrending  = "trending-groups" //
+	CPlayer          = "player"          //
+	CPlayerApps      = "games"           // Count
+	CPlayerLevel     = "level"           //
+	CPlayerPlaytime  = "playtime"        //
+	CPlayerRecent    = "recent"          //
+	CPlayerUpdate    = "update"          //
+	CPlayerWishlist  = "wishlist"        //
+	CPlayerLibrary   = "library"         //
+	CPlayerCoop      = "coop"            //
+	CPlayerHistory   = "history"         //
+	CHelp            = "help"            //
+	CFeedback        = "feedback"        //
+	CInvite          = "invite"          //
+	CSettings        = "settings"        //
+	CSteamOnline     = "online"          //
+	CSubscribe       = "subscribe"       //
 )
 
 var CommandRegister = []Command{
@@ -97,8 +113,10 @@ var CommandRegister = []Command{
 	&CommandAppRandom{},
 	&CommandAppsNew{},
 	&CommandAppPrice{},
+	&CommandAppPriceHistory{},
 	&CommandAppsPopular{},
 	&CommandAppsTrending{},
+	&CommandAppsCompare{},
 	&CommandGroup{},
 	&CommandGroupsTrending{},
 	&CommandPlayer{},
@@ -107,11 +125,14 @@ var CommandRegister = []Command{
 	&CommandPlayerPlaytime{},
 	&CommandPlayerRecent{},
 	&CommandPlayerLibrary{},
+	&CommandPlayerCoop{},
+	&CommandPlayerHistory{},
 	&CommandPlayerUpdate{},
 	&CommandPlayerWishlist{},
 	&CommandHelp{},
 	&CommandInvite{},
 	&CommandSettings{},
+	&CommandSubscribe{},
 	&CommandFeedback{},
 }
 
@@ -182,7 +203,7 @@ type Player interface {
 	GetLastBan() time.Time
 }
 
-func getAppEmbed(commandID string, app App, code steamapi.ProductCC) *discordgo.MessageEmbed {
+func getAppEmbed(commandID string, app App, code steamapi.ProductCC, lang Language) *discordgo.MessageEmbed {
 
 	var image string
 	if app.GetPlayersPeakWeek() > 0 {
@@ -200,12 +221,12 @@ func getAppEmbed(commandID string, app App, code steamapi.ProductCC) *discordgo.
 		Image:     &discordgo.MessageEmbedImage{URL: image},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Max Weekly Players",
+				Name:   T(lang, "field.max_weekly_players"),
 				Value:  humanize.Comma(int64(app.GetPlayersPeakWeek())),
 				Inline: true,
 			},
 			{
-				Name:   "Followers",
+				Name:   T(lang, "field.followers"),
 				Value:  app.GetFollowers(),
 				Inline: true,
 			},
@@ -215,12 +236,12 @@ func getAppEmbed(commandID string, app App, code steamapi.ProductCC) *discordgo.
 				Inline: true,
 			},
 			{
-				Name:   "Price",
+				Name:   T(lang, "field.price"),
 				Value:  app.GetPrices().Get(code).GetFinal(),
 				Inline: true,
 			},
 			{
-				Name:   "Review Score",
+				Name:   T(lang, "field.review_score"),
 				Value:  app.GetReviewScore(),
 				Inline: true,
 			},
@@ -230,7 +251,7 @@ func getAppEmbed(commandID string, app App, code steamapi.ProductCC) *discordgo.
 				Inline: true,
 			},
 			{
-				Name:   "Release Date",
+				Name:   T(lang, "field.release_date"),
 				Value:  app.GetReleaseDateNice(),
 				Inline: true,
 			},
@@ -64,7 +64,7 @@ func (c CommandGroup) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandGroup) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandGroup) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["group"] == "" {
 		message.Content = "Missing group name"
@@ -99,7 +99,7 @@ func (c CommandGroup) Output(_ string, _ steamapi.ProductCC, inputs map[string]s
 		Image:       &discordgo.MessageEmbedImage{URL: charts.GetGroupChart(c.ID(), groups[0].ID, "Members")},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:  "Members",
+				Name:  T(lang, "field.members"),
 				Value: humanize.Comma(int64(groups[0].Members)),
 			},
 		},
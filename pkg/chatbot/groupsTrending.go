@@ -53,7 +53,7 @@ func (c CommandGroupsTrending) Slash() []*discordgo.ApplicationCommandOption {
 	return []*discordgo.ApplicationCommandOption{}
 }
 
-func (CommandGroupsTrending) Output(authorID string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (CommandGroupsTrending) Output(authorID string, _ steamapi.ProductCC, _ Language, _ map[string]string) (message discordgo.MessageSend, err error) {
 
 	message.Embed = &discordgo.MessageEmbed{
 		Title:  "Trending Groups",
@@ -48,7 +48,7 @@ func (c CommandFeedback) Slash() []*discordgo.ApplicationCommandOption {
 	return []*discordgo.ApplicationCommandOption{}
 }
 
-func (CommandFeedback) Output(_ string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (CommandFeedback) Output(_ string, _ steamapi.ProductCC, _ Language, _ map[string]string) (message discordgo.MessageSend, err error) {
 
 	message.Content = "https://discord.gg/c5zrcus"
 
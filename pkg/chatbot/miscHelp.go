@@ -1,6 +1,8 @@
 package chatbot
 
 import (
+	"strings"
+
 	"github.com/Jleagle/steam-go/steamapi"
 	"github.com/bwmarrin/discordgo"
 	"github.com/gamedb/gamedb/pkg/config"
@@ -14,7 +16,7 @@ func (c CommandHelp) ID() string {
 }
 
 func (CommandHelp) Regex() string {
-	return `^[.|!]help`
+	return `^[.|!]help\s?(.*)`
 }
 
 func (CommandHelp) DisableCache() bool {
@@ -29,7 +31,7 @@ func (CommandHelp) AllowDM() bool {
 	return true
 }
 func (CommandHelp) Example() string {
-	return ".help"
+	return ".help {command}?"
 }
 
 func (CommandHelp) Description() string {
@@ -40,17 +42,56 @@ func (CommandHelp) Type() CommandType {
 	return TypeOther
 }
 
-func (CommandHelp) LegacyInputs(_ string) map[string]string {
-	return map[string]string{}
+func (c CommandHelp) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+
+	return map[string]string{
+		"command": matches[1],
+	}
 }
 
 func (c CommandHelp) Slash() []*discordgo.ApplicationCommandOption {
-	return []*discordgo.ApplicationCommandOption{}
+
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "command",
+			Description: "The command to show help for",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+		},
+	}
 }
 
-func (CommandHelp) Output(_ string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandHelp) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	var id = strings.TrimLeft(strings.ToLower(strings.TrimSpace(inputs["command"])), ".!/")
+
+	if id == "" {
+		message.Content = T(lang, "help.see") + " <" + config.C.GlobalSteamDomain + "/discord-bot>"
+		return message, nil
+	}
+
+	command, ok := CommandCache[id]
+	if !ok {
+		message.Content = T(lang, "help.not_found") + ", " + strings.ToLower(T(lang, "help.see")) + " <" + config.C.GlobalSteamDomain + "/discord-bot>"
+		return message, nil
+	}
+
+	message.Embed = &discordgo.MessageEmbed{
+		Title:       command.Example(),
+		Description: GetDescription(command, lang),
+		URL:         config.C.GlobalSteamDomain + "/discord-bot",
+		Footer:      getFooter(),
+		Color:       greenHexDec,
+	}
 
-	message.Content = "See <" + config.C.GlobalSteamDomain + "/discord-bot>"
+	for _, option := range command.Slash() {
+		message.Embed.Fields = append(message.Embed.Fields, &discordgo.MessageEmbedField{
+			Name:  option.Name,
+			Value: GetOptionDescription(command, option, lang),
+		})
+	}
 
 	return message, nil
 }
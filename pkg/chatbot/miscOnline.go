@@ -53,7 +53,7 @@ func (c CommandSteamOnline) Slash() []*discordgo.ApplicationCommandOption {
 	return []*discordgo.ApplicationCommandOption{}
 }
 
-func (c CommandSteamOnline) Output(_ string, _ steamapi.ProductCC, _ map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandSteamOnline) Output(_ string, _ steamapi.ProductCC, lang Language, _ map[string]string) (message discordgo.MessageSend, err error) {
 
 	var app = mongo.App{}
 
@@ -76,12 +76,12 @@ func (c CommandSteamOnline) Output(_ string, _ steamapi.ProductCC, _ map[string]
 		Image:     &discordgo.MessageEmbedImage{URL: charts.GetAppPlayersChart(c.ID(), 0, "10m", "7d", "Online (1 Week)")},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Online",
+				Name:   T(lang, "field.online"),
 				Value:  humanize.Comma(i),
 				Inline: true,
 			},
 			{
-				Name:   "In Game",
+				Name:   T(lang, "field.in_game"),
 				Value:  humanize.Comma(i2),
 				Inline: true,
 			},
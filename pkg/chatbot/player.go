@@ -71,7 +71,7 @@ func (c CommandPlayer) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayer) Output(authorID string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayer) Output(authorID string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	var player Player
 
@@ -189,31 +189,31 @@ func (c CommandPlayer) Output(authorID string, _ steamapi.ProductCC, inputs map[
 		Color:     greenHexDec,
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:  "Level",
+				Name:  T(lang, "field.level"),
 				Value: level,
 			},
 			{
-				Name:  "Games",
+				Name:  T(lang, "field.games"),
 				Value: games,
 			},
 			{
-				Name:  "Achievements",
+				Name:  T(lang, "field.achievements"),
 				Value: achievements,
 			},
 			{
-				Name:  "Badges",
+				Name:  T(lang, "field.badges"),
 				Value: badges,
 			},
 			{
-				Name:  "Foil Badges",
+				Name:  T(lang, "field.foil_badges"),
 				Value: foils,
 			},
 			{
-				Name:  "Playtime",
+				Name:  T(lang, "field.playtime"),
 				Value: playtime,
 			},
 			{
-				Name:  "Bans",
+				Name:  T(lang, "field.bans"),
 				Value: bans,
 			},
 		},
@@ -67,7 +67,7 @@ func (c CommandPlayerApps) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerApps) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerApps) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 		message.Content = "Missing player name"
@@ -100,12 +100,12 @@ func (c CommandPlayerApps) Output(_ string, _ steamapi.ProductCC, inputs map[str
 			Image:     &discordgo.MessageEmbedImage{URL: charts.GetPlayerChart(c.ID(), player.ID, schemas.InfPlayersGames, "Games")},
 			Fields: []*discordgo.MessageEmbedField{
 				{
-					Name:   "Games",
+					Name:   T(lang, "field.games"),
 					Value:  strconv.Itoa(player.Games),
 					Inline: true,
 				},
 				{
-					Name:   "Rank",
+					Name:   T(lang, "field.rank"),
 					Value:  rank,
 					Inline: true,
 				},
@@ -0,0 +1,171 @@
+package chatbot
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"github.com/gamedb/gamedb/pkg/oauth"
+)
+
+const coopMaxMentions = 4
+
+var coopMentionRegex = regexp.MustCompile(`<@!?([0-9]+)>`)
+
+type CommandPlayerCoop struct {
+}
+
+func (c CommandPlayerCoop) ID() string {
+	return CPlayerCoop
+}
+
+func (CommandPlayerCoop) Regex() string {
+	return `^[.|!]coop (.+)`
+}
+
+func (CommandPlayerCoop) DisableCache() bool {
+	return true // Depends on the author
+}
+
+func (CommandPlayerCoop) PerProdCode() bool {
+	return true
+}
+
+func (CommandPlayerCoop) AllowDM() bool {
+	return false
+}
+
+func (CommandPlayerCoop) Example() string {
+	return ".coop {@user} {@user}?"
+}
+
+func (CommandPlayerCoop) Description() string {
+	return "Find games to play with friends, using their linked Steam accounts"
+}
+
+func (CommandPlayerCoop) Type() CommandType {
+	return TypePlayer
+}
+
+func (c CommandPlayerCoop) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+
+	var inputs = map[string]string{}
+	for k, v := range coopMentionRegex.FindAllStringSubmatch(matches[1], coopMaxMentions) {
+		inputs["user"+strconv.Itoa(k+1)] = v[1]
+	}
+
+	return inputs
+}
+
+func (c CommandPlayerCoop) Slash() []*discordgo.ApplicationCommandOption {
+
+	var options []*discordgo.ApplicationCommandOption
+	for i := 1; i <= coopMaxMentions; i++ {
+		options = append(options, &discordgo.ApplicationCommandOption{
+			Name:        "user" + strconv.Itoa(i),
+			Description: "A friend to play with",
+			Type:        discordgo.ApplicationCommandOptionUser,
+			Required:    i == 1,
+		})
+	}
+
+	return options
+}
+
+func (c CommandPlayerCoop) Output(authorID string, region steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	var discordIDs = []string{authorID}
+	for i := 1; i <= coopMaxMentions; i++ {
+		if id := inputs["user"+strconv.Itoa(i)]; id != "" {
+			discordIDs = append(discordIDs, id)
+		}
+	}
+
+	discordIDs = helpers.UniqueString(discordIDs)
+
+	if len(discordIDs) < 2 {
+		message.Content = "Please mention at least one friend"
+		return message, nil
+	}
+
+	var playerIDs []int64
+	var unlinked []string
+
+	for _, discordID := range discordIDs {
+
+		playerID, err := getPlayerIDFromDiscord(discordID)
+		if err != nil {
+			unlinked = append(unlinked, "<@"+discordID+">")
+			continue
+		}
+
+		playerIDs = append(playerIDs, playerID)
+	}
+
+	if len(unlinked) > 0 {
+		message.Content = strings.Join(unlinked, ", ") + " need to connect Discord and Steam on Global Steam first: <" + config.C.GlobalSteamDomain + "/settings>"
+		return message, nil
+	}
+
+	games, err := mongo.GetCoopGames(playerIDs, region, 10)
+	if err != nil {
+		return message, err
+	}
+
+	if len(games) == 0 {
+		message.Content = "No co-op games found, your profiles may be set to private"
+		return message, nil
+	}
+
+	var code []string
+	for k, game := range games {
+
+		line := fmt.Sprintf("%2d", k+1) + ": " + game.App.GetName() + " (" + strconv.Itoa(len(game.Owners)) + "/" + strconv.Itoa(len(playerIDs)) + " own"
+		if !game.OwnedByAll() && game.MissingPrice > 0 {
+			line += ", " + game.GetMissingPrice() + " to buy"
+		}
+		line += ")"
+
+		code = append(code, line)
+	}
+
+	var ids []string
+	for _, v := range playerIDs {
+		ids = append(ids, strconv.FormatInt(v, 10))
+	}
+
+	message.Embed = &discordgo.MessageEmbed{
+		Title:       "Co-op Games",
+		URL:         config.C.GlobalSteamDomain + "/games/coop/" + strings.Join(ids, ","),
+		Author:      getAuthor(authorID),
+		Color:       greenHexDec,
+		Description: "```" + strings.Join(code, "\n") + "```",
+	}
+
+	return message, nil
+}
+
+// The Steam account linked to a Discord user
+func getPlayerIDFromDiscord(discordID string) (playerID int64, err error) {
+
+	provider, err := mysql.GetUserProviderByProviderID(oauth.ProviderDiscord, discordID)
+	if err != nil {
+		return 0, err
+	}
+
+	provider, err = mysql.GetUserProviderByUserID(oauth.ProviderSteam, provider.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(provider.ID, 10, 64)
+}
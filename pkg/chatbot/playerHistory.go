@@ -0,0 +1,152 @@
+package chatbot
+
+import (
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/dustin/go-humanize"
+	"github.com/gamedb/gamedb/pkg/chatbot/charts"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
+	"github.com/gamedb/gamedb/pkg/influx/schemas"
+)
+
+type CommandPlayerHistory struct {
+}
+
+func (c CommandPlayerHistory) ID() string {
+	return CPlayerHistory
+}
+
+func (CommandPlayerHistory) Regex() string {
+	return `^[.|!]history\s(?:(level|games)\s)?(?:(month|year|all)\s)?(.+)`
+}
+
+func (CommandPlayerHistory) DisableCache() bool {
+	return false
+}
+
+func (CommandPlayerHistory) PerProdCode() bool {
+	return false
+}
+
+func (CommandPlayerHistory) AllowDM() bool {
+	return false
+}
+
+func (CommandPlayerHistory) Example() string {
+	return ".history {level|games}? {month|year|all}? {player}"
+}
+
+func (CommandPlayerHistory) Description() string {
+	return "Retrieve a chart of a player's level or games over time"
+}
+
+func (CommandPlayerHistory) Type() CommandType {
+	return TypePlayer
+}
+
+func (c CommandPlayerHistory) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+
+	return map[string]string{
+		"metric": matches[1],
+		"period": matches[2],
+		"player": matches[3],
+	}
+}
+
+func (c CommandPlayerHistory) Slash() []*discordgo.ApplicationCommandOption {
+
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "player",
+			Description: "The name or ID of the player",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+		},
+		{
+			Name:        "metric",
+			Description: "What to chart, defaults to level",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "Level", Value: "level"},
+				{Name: "Games", Value: "games"},
+			},
+		},
+		{
+			Name:        "period",
+			Description: "How far back to chart, defaults to a year",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{Name: "Month", Value: "month"},
+				{Name: "Year", Value: "year"},
+				{Name: "All Time", Value: "all"},
+			},
+		},
+	}
+}
+
+func (c CommandPlayerHistory) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	if inputs["player"] == "" {
+		message.Content = "Missing player name"
+		return message, nil
+	}
+
+	player, err := searchForPlayer(inputs["player"])
+	if err == elasticsearch.ErrNoResult || err == steamapi.ErrProfileMissing {
+
+		message.Content = "Player **" + inputs["player"] + "** not found, they may be set to private, please enter a user's vanity URL"
+		return message, nil
+
+	} else if err != nil {
+		return message, err
+	}
+
+	var field = schemas.InfPlayersLevel
+	var fieldName = T(lang, "field.level")
+	var value = player.Level
+	var title = "Level"
+
+	if strings.ToLower(inputs["metric"]) == "games" {
+		field = schemas.InfPlayersGames
+		fieldName = T(lang, "field.games")
+		value = player.Games
+		title = "Games"
+	}
+
+	var groupBy, period = "1d", "365d"
+
+	switch strings.ToLower(inputs["period"]) {
+	case "month":
+		period = "30d"
+		title += " (30 Days)"
+	case "all":
+		groupBy, period = "7d", "3650d"
+		title += " (All Time)"
+	default:
+		title += " (1 Year)"
+	}
+
+	message.Embed = &discordgo.MessageEmbed{
+		Title:     player.GetName(),
+		URL:       player.GetPathAbsolute(),
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: player.GetAvatarAbsolute(), Width: 184, Height: 184},
+		Footer:    getFooter(),
+		Color:     greenHexDec,
+		Image:     &discordgo.MessageEmbedImage{URL: charts.GetPlayerHistoryChart(c.ID(), player.ID, field, groupBy, period, title)},
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   fieldName,
+				Value:  humanize.Comma(int64(value)),
+				Inline: true,
+			},
+		},
+	}
+
+	return message, nil
+}
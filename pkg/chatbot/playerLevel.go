@@ -66,7 +66,7 @@ func (c CommandPlayerLevel) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerLevel) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerLevel) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 		message.Content = "Missing player name"
@@ -98,12 +98,12 @@ func (c CommandPlayerLevel) Output(_ string, _ steamapi.ProductCC, inputs map[st
 		Image:     &discordgo.MessageEmbedImage{URL: charts.GetPlayerChart(c.ID(), player.ID, schemas.InfPlayersLevel, "Level")},
 		Fields: []*discordgo.MessageEmbedField{
 			{
-				Name:   "Level",
+				Name:   T(lang, "field.level"),
 				Value:  humanize.Comma(int64(player.Level)),
 				Inline: true,
 			},
 			{
-				Name:   "Rank",
+				Name:   T(lang, "field.rank"),
 				Value:  rank,
 				Inline: true,
 			},
@@ -67,7 +67,7 @@ func (c CommandPlayerLibrary) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerLibrary) Output(authorID string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerLibrary) Output(authorID string, _ steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 		message.Content = "Missing player name"
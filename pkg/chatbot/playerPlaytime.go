@@ -65,7 +65,7 @@ func (c CommandPlayerPlaytime) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerPlaytime) Output(_ string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerPlaytime) Output(_ string, _ steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 		message.Content = "Missing player name"
@@ -101,12 +101,12 @@ func (c CommandPlayerPlaytime) Output(_ string, _ steamapi.ProductCC, inputs map
 			Image:     &discordgo.MessageEmbedImage{URL: charts.GetPlayerChart(c.ID(), player.ID, schemas.InfPlayersPlaytime, "Playtime")},
 			Fields: []*discordgo.MessageEmbedField{
 				{
-					Name:   "Playtime",
+					Name:   T(lang, "field.playtime"),
 					Value:  helpers.GetTimeLong(player.PlayTime, 0),
 					Inline: true,
 				},
 				{
-					Name:   "Rank",
+					Name:   T(lang, "field.rank"),
 					Value:  rank,
 					Inline: true,
 				},
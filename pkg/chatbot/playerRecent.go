@@ -68,7 +68,7 @@ func (c CommandPlayerRecent) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerRecent) Output(authorID string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerRecent) Output(authorID string, _ steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 		message.Content = "Missing player name"
@@ -70,7 +70,7 @@ func (c CommandPlayerUpdate) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandPlayerUpdate) Output(authorID string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandPlayerUpdate) Output(authorID string, _ steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["player"] == "" {
 
@@ -1,6 +1,7 @@
 package chatbot
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/Jleagle/steam-go/steamapi"
@@ -18,7 +19,7 @@ func (c CommandSettings) ID() string {
 }
 
 func (CommandSettings) Regex() string {
-	return `^[.|!]settings (region)\s?([a-zA-Z]{2})?`
+	return `^[.|!]settings (region|language|server-language)\s?([a-zA-Z-]{2,5})?`
 }
 
 func (CommandSettings) DisableCache() bool {
@@ -65,6 +66,8 @@ func (c CommandSettings) Slash() []*discordgo.ApplicationCommandOption {
 			Required:    true,
 			Choices: []*discordgo.ApplicationCommandOptionChoice{
 				{"Region", "region"},
+				{"Language", "language"},
+				{"Server Language", "server-language"},
 			},
 		},
 		{
@@ -76,7 +79,7 @@ func (c CommandSettings) Slash() []*discordgo.ApplicationCommandOption {
 	}
 }
 
-func (c CommandSettings) Output(authorID string, _ steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+func (c CommandSettings) Output(authorID string, _ steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
 
 	if inputs["setting"] == "" {
 		message.Content = "Missing setting name"
@@ -120,6 +123,42 @@ func (c CommandSettings) Output(authorID string, _ steamapi.ProductCC, inputs ma
 			text = "Invalid region, see .help"
 		}
 
+	case "language":
+
+		if value == "" {
+			if lang, ok := GetLanguage(authorSettings.Language); ok {
+				message.Content = "Your language is set to " + Languages[lang]
+			} else {
+				message.Content = "Your language is set to auto, using the server language or your Discord language"
+			}
+			return message, nil
+		}
+
+		if value == "auto" {
+			value = ""
+		} else if lang, ok := GetLanguage(value); ok {
+			value = string(lang)
+		} else {
+			message.Content = "Invalid language, options are auto, " + c.languageCodes()
+			return message, nil
+		}
+
+		err = mysql.SetChatBotSettings(authorID, func(s *mysql.ChatBotSetting) { s.Language = value })
+		if err != nil {
+			log.ErrS(err)
+			return
+		}
+
+		if value == "" {
+			text = "Language set to auto"
+		} else {
+			text = "Language set to " + Languages[Language(value)]
+		}
+
+	case "server-language":
+
+		text = "This setting needs to be requested from a guild channel"
+
 	default:
 		text = "Invalid setting, see .help"
 	}
@@ -127,3 +166,67 @@ func (c CommandSettings) Output(authorID string, _ steamapi.ProductCC, inputs ma
 	message.Content = text
 	return message, nil
 }
+
+// For server settings, which need the guild
+func (c CommandSettings) GuildOutput(authorID string, guild GuildContext, region steamapi.ProductCC, lang Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	if strings.ToLower(inputs["setting"]) != "server-language" {
+		return c.Output(authorID, region, lang, inputs)
+	}
+
+	var value = strings.ToLower(inputs["value"])
+
+	if value == "" {
+
+		settings, err := mysql.GetChatBotGuildSettings(guild.GuildID)
+		if err != nil {
+			return message, err
+		}
+
+		if lang, ok := GetLanguage(settings.Language); ok {
+			message.Content = "This server's language is set to " + Languages[lang]
+		} else {
+			message.Content = "This server's language is set to auto, using each member's Discord language"
+		}
+		return message, nil
+	}
+
+	if !guild.Admin {
+		message.Content = "You need the Manage Server permission to change server settings"
+		return message, nil
+	}
+
+	if value == "auto" {
+		value = ""
+	} else if lang, ok := GetLanguage(value); ok {
+		value = string(lang)
+	} else {
+		message.Content = "Invalid language, options are auto, " + c.languageCodes()
+		return message, nil
+	}
+
+	err = mysql.SetChatBotGuildSettings(guild.GuildID, func(s *mysql.ChatBotGuildSetting) { s.Language = value })
+	if err != nil {
+		return message, err
+	}
+
+	if value == "" {
+		message.Content = "Server language set to auto"
+	} else {
+		message.Content = "Server language set to " + Languages[Language(value)]
+	}
+
+	return message, nil
+}
+
+func (c CommandSettings) languageCodes() string {
+
+	var codes []string
+	for k := range Languages {
+		codes = append(codes, string(k))
+	}
+
+	sort.Strings(codes)
+
+	return strings.Join(codes, ", ")
+}
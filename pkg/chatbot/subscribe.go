@@ -0,0 +1,253 @@
+package chatbot
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/mysql"
+)
+
+type CommandSubscribe struct {
+}
+
+func (c CommandSubscribe) ID() string {
+	return CSubscribe
+}
+
+func (CommandSubscribe) Regex() string {
+	return `^[.|!]subscribe\s(add|remove|list)\s?(releases|prices|pics|news|trending)?\s?(.*)`
+}
+
+func (CommandSubscribe) DisableCache() bool {
+	return true
+}
+
+// For the price drops region
+func (CommandSubscribe) PerProdCode() bool {
+	return true
+}
+
+func (CommandSubscribe) AllowDM() bool {
+	return false
+}
+
+func (CommandSubscribe) Example() string {
+	return ".subscribe {add|remove|list} {feed}? {game|id}?"
+}
+
+func (CommandSubscribe) Description() string {
+	return "Post new releases, price drops, PICS updates, news or trending games into this channel"
+}
+
+func (CommandSubscribe) Type() CommandType {
+	return TypeOther
+}
+
+func (c CommandSubscribe) LegacyInputs(input string) map[string]string {
+
+	matches := RegexCache[c.Regex()].FindStringSubmatch(input)
+
+	return map[string]string{
+		"action": matches[1],
+		"feed":   matches[2],
+		"target": matches[3],
+	}
+}
+
+func (c CommandSubscribe) Slash() []*discordgo.ApplicationCommandOption {
+
+	var feeds []*discordgo.ApplicationCommandOptionChoice
+	for _, v := range mysql.ChatBotFeeds {
+		feeds = append(feeds, &discordgo.ApplicationCommandOptionChoice{Name: v.String(), Value: string(v)})
+	}
+
+	return []*discordgo.ApplicationCommandOption{
+		{
+			Name:        "action",
+			Description: "Add or remove a subscription, or list this server's subscriptions",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    true,
+			Choices: []*discordgo.ApplicationCommandOptionChoice{
+				{"Add", "add"},
+				{"Remove", "remove"},
+				{"List", "list"},
+			},
+		},
+		{
+			Name:        "feed",
+			Description: "The feed to add",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+			Choices:     feeds,
+		},
+		{
+			Name:        "target",
+			Description: "The game name or ID when adding, the subscription ID when removing",
+			Type:        discordgo.ApplicationCommandOptionString,
+			Required:    false,
+		},
+	}
+}
+
+// Subscriptions belong to a guild channel, see GuildOutput
+func (c CommandSubscribe) Output(_ string, _ steamapi.ProductCC, _ Language, _ map[string]string) (message discordgo.MessageSend, err error) {
+
+	message.Content = "This command needs to be requested from a guild channel"
+	return message, nil
+}
+
+func (c CommandSubscribe) GuildOutput(authorID string, guild GuildContext, region steamapi.ProductCC, _ Language, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	var action = strings.ToLower(inputs["action"])
+
+	if action != "list" && !guild.Admin {
+		message.Content = "You need the Manage Server permission to change subscriptions"
+		return message, nil
+	}
+
+	switch action {
+	case "add":
+		return c.add(authorID, guild, region, inputs)
+	case "remove":
+		return c.remove(guild, inputs)
+	case "list":
+		return c.list(guild)
+	default:
+		message.Content = "Invalid action, see .help"
+		return message, nil
+	}
+}
+
+func (c CommandSubscribe) add(authorID string, guild GuildContext, region steamapi.ProductCC, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	var feed = mysql.ChatBotFeed(strings.ToLower(inputs["feed"]))
+	var target = strings.TrimSpace(inputs["target"])
+
+	if !feed.IsValid() {
+		message.Content = "Invalid feed, see .help"
+		return message, nil
+	}
+
+	count, err := mysql.CountChatBotSubscriptionsByGuild(guild.GuildID)
+	if err != nil {
+		return message, err
+	}
+
+	if count >= mysql.MaxChatBotSubscriptionsPerGuild {
+		message.Content = "This server already has the maximum of " + strconv.Itoa(mysql.MaxChatBotSubscriptionsPerGuild) + " subscriptions"
+		return message, nil
+	}
+
+	subscription := mysql.ChatBotSubscription{
+		GuildID:   guild.GuildID,
+		ChannelID: guild.ChannelID,
+		Feed:      feed,
+		CreatedBy: authorID,
+	}
+
+	if feed.NeedsApp() {
+
+		if target == "" {
+			message.Content = "Missing game name"
+			return message, nil
+		}
+
+		apps, err := elasticsearch.SearchAppsSimple(1, target)
+		if err != nil {
+			return message, err
+		} else if len(apps) == 0 {
+			message.Content = "Game **" + target + "** not found on Steam"
+			return message, nil
+		}
+
+		subscription.AppID = apps[0].ID
+		subscription.AppName = apps[0].GetName()
+
+		if feed == mysql.ChatBotFeedPrices {
+			subscription.ProductCC = region
+		}
+	}
+
+	err = mysql.NewChatBotSubscription(subscription)
+	if err == mysql.ErrChatBotSubscriptionExists {
+		message.Content = "This channel is already subscribed to " + c.describe(subscription)
+		return message, nil
+	} else if err != nil {
+		return message, err
+	}
+
+	message.Content = "This channel is now subscribed to " + c.describe(subscription)
+	return message, nil
+}
+
+func (c CommandSubscribe) remove(guild GuildContext, inputs map[string]string) (message discordgo.MessageSend, err error) {
+
+	id, err := strconv.Atoi(strings.TrimSpace(inputs["target"]))
+	if err != nil || id < 1 {
+		message.Content = "Invalid subscription ID, see .subscribe list"
+		return message, nil
+	}
+
+	subscription, err := mysql.GetChatBotSubscription(id)
+	if err == mysql.ErrRecordNotFound || (err == nil && subscription.GuildID != guild.GuildID) {
+		message.Content = "Subscription " + strconv.Itoa(id) + " not found, see .subscribe list"
+		return message, nil
+	} else if err != nil {
+		return message, err
+	}
+
+	err = mysql.DeleteChatBotSubscription(guild.GuildID, id)
+	if err != nil {
+		return message, err
+	}
+
+	message.Content = "Removed the subscription to " + c.describe(subscription)
+	return message, nil
+}
+
+func (c CommandSubscribe) list(guild GuildContext) (message discordgo.MessageSend, err error) {
+
+	subscriptions, err := mysql.GetChatBotSubscriptionsByGuild(guild.GuildID)
+	if err != nil {
+		return message, err
+	}
+
+	if len(subscriptions) == 0 {
+		message.Content = "This server has no subscriptions, see .help"
+		return message, nil
+	}
+
+	var lines []string
+	for _, v := range subscriptions {
+		lines = append(lines, "`"+strconv.Itoa(v.ID)+"` <#"+v.ChannelID+"> "+c.describe(v))
+	}
+
+	message.Embed = &discordgo.MessageEmbed{
+		Title:       "Subscriptions (" + strconv.Itoa(len(subscriptions)) + "/" + strconv.Itoa(mysql.MaxChatBotSubscriptionsPerGuild) + ")",
+		Description: strings.Join(lines, "\n"),
+		Author:      getAuthor(guild.GuildID),
+		Footer:      getFooter(),
+		Color:       greenHexDec,
+	}
+
+	return message, nil
+}
+
+func (c CommandSubscribe) describe(subscription mysql.ChatBotSubscription) (s string) {
+
+	s = "**" + subscription.Feed.String() + "**"
+
+	if subscription.Feed.NeedsApp() {
+		s += " for " + helpers.GetAppName(subscription.AppID, subscription.AppName)
+	}
+
+	if subscription.Feed == mysql.ChatBotFeedPrices {
+		s += " (" + strings.ToUpper(string(subscription.ProductCC)) + ")"
+	}
+
+	return s
+}
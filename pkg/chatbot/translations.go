@@ -0,0 +1,374 @@
+package chatbot
+
+import (
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+type Language string
+
+const (
+	LanguageEnglish Language = "en"
+	LanguageGerman  Language = "de"
+	LanguageFrench  Language = "fr"
+	LanguageSpanish Language = "es"
+)
+
+var Languages = map[Language]string{
+	LanguageEnglish: "English",
+	LanguageGerman:  "Deutsch",
+	LanguageFrench:  "Français",
+	LanguageSpanish: "Español",
+}
+
+// Takes a language code or a Discord locale, like es-ES
+func GetLanguage(locale string) (Language, bool) {
+
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	locale = strings.Split(locale, "-")[0]
+
+	if _, ok := Languages[Language(locale)]; ok {
+		return Language(locale), true
+	}
+	return LanguageEnglish, false
+}
+
+// Falls back to English, English command and option descriptions come from the commands themselves
+func T(lang Language, key string) string {
+
+	if val, ok := translations[lang][key]; ok {
+		return val
+	}
+	if val, ok := translations[LanguageEnglish][key]; ok {
+		return val
+	}
+	return key
+}
+
+func GetDescription(c Command, lang Language) string {
+
+	if val, ok := translations[lang][descriptionKey(c.ID())]; ok {
+		return val
+	}
+	return c.Description()
+}
+
+func GetOptionDescription(c Command, option *discordgo.ApplicationCommandOption, lang Language) string {
+
+	if val, ok := translations[lang][optionKey(c.ID(), option.Name)]; ok {
+		return val
+	}
+	return option.Description
+}
+
+func descriptionKey(commandID string) string {
+	return "description." + commandID
+}
+
+func optionKey(commandID string, option string) string {
+	return "option." + commandID + "." + option
+}
+
+var translations = map[Language]map[string]string{
+	LanguageEnglish: {
+		"field.achievements":       "Achievements",
+		"field.all_time":           "All Time",
+		"field.all_time_low":       "All Time Low",
+		"field.badges":             "Badges",
+		"field.bans":               "Bans",
+		"field.cheapest_region":    "Cheapest Region",
+		"field.discounts":          "Discounts",
+		"field.followers":          "Followers",
+		"field.foil_badges":        "Foil Badges",
+		"field.games":              "Games",
+		"field.in_game":            "In Game",
+		"field.level":              "Level",
+		"field.max_weekly_players": "Max Weekly Players",
+		"field.members":            "Members",
+		"field.now":                "Now",
+		"field.online":             "Online",
+		"field.playtime":           "Playtime",
+		"field.price":              "Price",
+		"field.rank":               "Rank",
+		"field.regular_price":      "Regular Price",
+		"field.release_date":       "Release Date",
+		"field.review_score":       "Review Score",
+		"field.seven_days":         "7 Days",
+		"help.not_found":           "Command not found",
+		"help.see":                 "See",
+	},
+	LanguageGerman: {
+		"description." + CApp:             "Informationen über ein Spiel abrufen",
+		"description." + CAppFollowers:    "Informationen über die Follower eines Spiels abrufen",
+		"description." + CAppPlayers:      "Die Anzahl der Spieler eines Spiels abrufen",
+		"description." + CAppPrice:        "Informationen über den Preis eines Spiels abrufen",
+		"description." + CAppsRandom:      "Ein zufälliges Spiel abrufen, optional nach Tag",
+		"description." + CAppsNew:         "Die beliebtesten neu erschienenen Spiele abrufen",
+		"description." + CAppsPopular:     "Die beliebtesten Spiele dieser Woche abrufen",
+		"description." + CAppsTrending:    "Die Spiele mit dem stärksten Trend abrufen",
+		"description." + CGroup:           "Informationen über eine Gruppe abrufen",
+		"description." + CGroupsTrending:  "Die Gruppen mit dem stärksten Trend abrufen",
+		"description." + CPlayer:          "Informationen über einen Spieler abrufen",
+		"description." + CPlayerApps:      "Die Anzahl der Spiele in der Bibliothek eines Spielers abrufen",
+		"description." + CPlayerLevel:     "Das Level eines Spielers abrufen",
+		"description." + CPlayerPlaytime:  "Die gesamte Spielzeit eines Spielers abrufen",
+		"description." + CPlayerRecent:    "Die zuletzt geöffneten Spiele eines Spielers abrufen",
+		"description." + CPlayerUpdate:    "Das Global Steam Profil eines Spielers aktualisieren",
+		"description." + CPlayerWishlist:  "Die Wunschliste eines Spielers abrufen",
+		"description." + CPlayerLibrary:   "Die meistgespielten Spiele eines Spielers abrufen",
+		"description." + CPlayerCoop:      "Spiele finden, die du mit Freunden über ihre verknüpften Steam Konten spielen kannst",
+		"description." + CHelp:            "Eine vollständige Liste der Befehle abrufen",
+		"description." + CFeedback:        "Einen Link zum Senden von Feedback abrufen",
+		"description." + CInvite:          "Einen Einladungslink für deinen Discord Server abrufen",
+		"description." + CSettings:        "Eine GS Benutzereinstellung ändern oder abrufen",
+		"description." + CSteamOnline:     "Die Anzahl der Personen abrufen, die gerade auf Steam sind",
+		"description." + CSubscribe:       "Neue Spiele, Preissenkungen, PICS Updates, News oder Trends in diesem Kanal posten",
+		"description." + CAppPriceHistory: "Ein Diagramm des Preisverlaufs eines Spiels in einer Region abrufen",
+		"description." + CAppsCompare:     "Zwei bis vier Spiele nebeneinander vergleichen",
+		"description." + CPlayerHistory:   "Ein Diagramm des Levels oder der Spiele eines Spielers im Zeitverlauf abrufen",
+
+		"option." + CApp + ".game":               "Der Name oder die ID des Spiels",
+		"option." + CAppFollowers + ".game":      "Der Name oder die ID des Spiels",
+		"option." + CAppPlayers + ".game":        "Der Name oder die ID des Spiels, leer für ganz Steam",
+		"option." + CAppPrice + ".game":          "Der Name oder die ID des Spiels",
+		"option." + CAppPrice + ".region":        "Der Regionscode",
+		"option." + CAppsRandom + ".tag":         "Tag",
+		"option." + CGroup + ".group":            "Der Name oder die ID der Gruppe",
+		"option." + CPlayer + ".player":          "Der Name oder die ID des Spielers",
+		"option." + CPlayerApps + ".player":      "Der Name oder die ID des Spielers",
+		"option." + CPlayerLevel + ".player":     "Der Name oder die ID des Spielers",
+		"option." + CPlayerPlaytime + ".player":  "Der Name oder die ID des Spielers",
+		"option." + CPlayerRecent + ".player":    "Der Name oder die ID des Spielers",
+		"option." + CPlayerLibrary + ".player":   "Der Name oder die ID des Spielers",
+		"option." + CPlayerCoop + ".user1":       "Ein Freund zum Mitspielen",
+		"option." + CPlayerCoop + ".user2":       "Ein Freund zum Mitspielen",
+		"option." + CPlayerCoop + ".user3":       "Ein Freund zum Mitspielen",
+		"option." + CPlayerCoop + ".user4":       "Ein Freund zum Mitspielen",
+		"option." + CPlayerUpdate + ".player":    "Der Name oder die ID des Spielers",
+		"option." + CPlayerWishlist + ".player":  "Der Name oder die ID des Spielers",
+		"option." + CHelp + ".command":           "Der Befehl, zu dem Hilfe angezeigt werden soll",
+		"option." + CSettings + ".setting":       "Die Einstellung, die gesetzt oder abgerufen werden soll",
+		"option." + CSettings + ".value":         "Der neue Wert, leer lassen um den Wert abzurufen",
+		"option." + CSubscribe + ".action":       "Ein Abonnement hinzufügen oder entfernen, oder die Abonnements dieses Servers auflisten",
+		"option." + CSubscribe + ".feed":         "Der Feed, der hinzugefügt werden soll",
+		"option." + CSubscribe + ".target":       "Beim Hinzufügen der Name oder die ID des Spiels, beim Entfernen die ID des Abonnements",
+		"option." + CAppPriceHistory + ".game":   "Der Name oder die ID des Spiels",
+		"option." + CAppPriceHistory + ".region": "Der Regionscode",
+		"option." + CAppsCompare + ".game1":      "Der Name oder die ID eines Spiels",
+		"option." + CAppsCompare + ".game2":      "Der Name oder die ID eines Spiels",
+		"option." + CAppsCompare + ".game3":      "Der Name oder die ID eines Spiels",
+		"option." + CAppsCompare + ".game4":      "Der Name oder die ID eines Spiels",
+		"option." + CPlayerHistory + ".player":   "Der Name oder die ID des Spielers",
+		"option." + CPlayerHistory + ".metric":   "Was dargestellt werden soll, standardmäßig das Level",
+		"option." + CPlayerHistory + ".period":   "Wie weit zurück, standardmäßig ein Jahr",
+
+		"field.achievements":       "Erfolge",
+		"field.all_time":           "Insgesamt",
+		"field.all_time_low":       "Tiefstpreis",
+		"field.badges":             "Abzeichen",
+		"field.bans":               "Sperren",
+		"field.cheapest_region":    "Günstigste Region",
+		"field.discounts":          "Rabatte",
+		"field.followers":          "Follower",
+		"field.foil_badges":        "Folienabzeichen",
+		"field.games":              "Spiele",
+		"field.in_game":            "Im Spiel",
+		"field.level":              "Level",
+		"field.max_weekly_players": "Max. Spieler pro Woche",
+		"field.members":            "Mitglieder",
+		"field.now":                "Jetzt",
+		"field.online":             "Online",
+		"field.playtime":           "Spielzeit",
+		"field.price":              "Preis",
+		"field.rank":               "Rang",
+		"field.regular_price":      "Normalpreis",
+		"field.release_date":       "Erscheinungsdatum",
+		"field.review_score":       "Bewertung",
+		"field.seven_days":         "7 Tage",
+		"help.not_found":           "Befehl nicht gefunden",
+		"help.see":                 "Siehe",
+	},
+	LanguageFrench: {
+		"description." + CApp:             "Obtenir des informations sur un jeu",
+		"description." + CAppFollowers:    "Obtenir des informations sur les abonnés d'un jeu",
+		"description." + CAppPlayers:      "Obtenir le nombre de personnes qui jouent à un jeu",
+		"description." + CAppPrice:        "Obtenir des informations sur le prix d'un jeu",
+		"description." + CAppsRandom:      "Obtenir un jeu au hasard, éventuellement par tag",
+		"description." + CAppsNew:         "Obtenir les nouveaux jeux les plus populaires",
+		"description." + CAppsPopular:     "Obtenir les jeux les plus populaires de la semaine",
+		"description." + CAppsTrending:    "Obtenir les jeux les plus tendance",
+		"description." + CGroup:           "Obtenir des informations sur un groupe",
+		"description." + CGroupsTrending:  "Obtenir les groupes les plus tendance",
+		"description." + CPlayer:          "Obtenir des informations sur un joueur",
+		"description." + CPlayerApps:      "Obtenir le nombre de jeux dans la bibliothèque d'un joueur",
+		"description." + CPlayerLevel:     "Obtenir le niveau d'un joueur",
+		"description." + CPlayerPlaytime:  "Obtenir le temps de jeu total d'un joueur",
+		"description." + CPlayerRecent:    "Obtenir les derniers jeux lancés par un joueur",
+		"description." + CPlayerUpdate:    "Mettre à jour le profil Global Steam d'un joueur",
+		"description." + CPlayerWishlist:  "Obtenir la liste de souhaits d'un joueur",
+		"description." + CPlayerLibrary:   "Obtenir les jeux les plus joués d'un joueur",
+		"description." + CPlayerCoop:      "Trouver des jeux à jouer entre amis, grâce à leurs comptes Steam liés",
+		"description." + CHelp:            "Obtenir la liste complète des commandes",
+		"description." + CFeedback:        "Obtenir un lien pour envoyer vos commentaires",
+		"description." + CInvite:          "Obtenir un lien d'invitation du bot pour votre serveur Discord",
+		"description." + CSettings:        "Modifier ou obtenir un paramètre utilisateur GS",
+		"description." + CSteamOnline:     "Obtenir le nombre de personnes actuellement sur Steam",
+		"description." + CSubscribe:       "Publier les sorties, baisses de prix, mises à jour PICS, actualités ou tendances dans ce salon",
+		"description." + CAppPriceHistory: "Obtenir un graphique de l'historique des prix d'un jeu dans une région",
+		"description." + CAppsCompare:     "Comparer deux à quatre jeux côte à côte",
+		"description." + CPlayerHistory:   "Obtenir un graphique du niveau ou des jeux d'un joueur dans le temps",
+
+		"option." + CApp + ".game":               "Le nom ou l'ID du jeu",
+		"option." + CAppFollowers + ".game":      "Le nom ou l'ID du jeu",
+		"option." + CAppPlayers + ".game":        "Le nom ou l'ID du jeu, ou vide pour tout Steam",
+		"option." + CAppPrice + ".game":          "Le nom ou l'ID du jeu",
+		"option." + CAppPrice + ".region":        "Le code de la région",
+		"option." + CAppsRandom + ".tag":         "Tag",
+		"option." + CGroup + ".group":            "Le nom ou l'ID du groupe",
+		"option." + CPlayer + ".player":          "Le nom ou l'ID du joueur",
+		"option." + CPlayerApps + ".player":      "Le nom ou l'ID du joueur",
+		"option." + CPlayerLevel + ".player":     "Le nom ou l'ID du joueur",
+		"option." + CPlayerPlaytime + ".player":  "Le nom ou l'ID du joueur",
+		"option." + CPlayerRecent + ".player":    "Le nom ou l'ID du joueur",
+		"option." + CPlayerLibrary + ".player":   "Le nom ou l'ID du joueur",
+		"option." + CPlayerCoop + ".user1":       "Un ami avec qui jouer",
+		"option." + CPlayerCoop + ".user2":       "Un ami avec qui jouer",
+		"option." + CPlayerCoop + ".user3":       "Un ami avec qui jouer",
+		"option." + CPlayerCoop + ".user4":       "Un ami avec qui jouer",
+		"option." + CPlayerUpdate + ".player":    "Le nom ou l'ID du joueur",
+		"option." + CPlayerWishlist + ".player":  "Le nom ou l'ID du joueur",
+		"option." + CHelp + ".command":           "La commande pour laquelle afficher l'aide",
+		"option." + CSettings + ".setting":       "Le paramètre à modifier ou à obtenir",
+		"option." + CSettings + ".value":         "La valeur à définir, laisser vide pour obtenir la valeur",
+		"option." + CSubscribe + ".action":       "Ajouter ou supprimer un abonnement, ou lister les abonnements de ce serveur",
+		"option." + CSubscribe + ".feed":         "Le flux à ajouter",
+		"option." + CSubscribe + ".target":       "Le nom ou l'ID du jeu pour un ajout, l'ID de l'abonnement pour une suppression",
+		"option." + CAppPriceHistory + ".game":   "Le nom ou l'ID du jeu",
+		"option." + CAppPriceHistory + ".region": "Le code de la région",
+		"option." + CAppsCompare + ".game1":      "Le nom ou l'ID d'un jeu",
+		"option." + CAppsCompare + ".game2":      "Le nom ou l'ID d'un jeu",
+		"option." + CAppsCompare + ".game3":      "Le nom ou l'ID d'un jeu",
+		"option." + CAppsCompare + ".game4":      "Le nom ou l'ID d'un jeu",
+		"option." + CPlayerHistory + ".player":   "Le nom ou l'ID du joueur",
+		"option." + CPlayerHistory + ".metric":   "Ce qui est affiché, le niveau par défaut",
+		"option." + CPlayerHistory + ".period":   "La période affichée, un an par défaut",
+
+		"field.achievements":       "Succès",
+		"field.all_time":           "Depuis toujours",
+		"field.all_time_low":       "Prix le plus bas",
+		"field.badges":             "Badges",
+		"field.bans":               "Bannissements",
+		"field.cheapest_region":    "Région la moins chère",
+		"field.discounts":          "Promotions",
+		"field.followers":          "Abonnés",
+		"field.foil_badges":        "Badges brillants",
+		"field.games":              "Jeux",
+		"field.in_game":            "En jeu",
+		"field.level":              "Niveau",
+		"field.max_weekly_players": "Joueurs max. par semaine",
+		"field.members":            "Membres",
+		"field.now":                "Maintenant",
+		"field.online":             "En ligne",
+		"field.playtime":           "Temps de jeu",
+		"field.price":              "Prix",
+		"field.rank":               "Rang",
+		"field.regular_price":      "Prix normal",
+		"field.release_date":       "Date de sortie",
+		"field.review_score":       "Note des évaluations",
+		"field.seven_days":         "7 jours",
+		"help.not_found":           "Commande introuvable",
+		"help.see":                 "Voir",
+	},
+	LanguageSpanish: {
+		"description." + CApp:             "Obtener información sobre un juego",
+		"description." + CAppFollowers:    "Obtener información sobre los seguidores de un juego",
+		"description." + CAppPlayers:      "Obtener el número de personas que juegan a un juego",
+		"description." + CAppPrice:        "Obtener información sobre el precio de un juego",
+		"description." + CAppsRandom:      "Obtener un juego aleatorio, opcionalmente por etiqueta",
+		"description." + CAppsNew:         "Obtener los juegos recién lanzados más populares",
+		"description." + CAppsPopular:     "Obtener los juegos más populares de esta semana",
+		"description." + CAppsTrending:    "Obtener los juegos en tendencia",
+		"description." + CGroup:           "Obtener información sobre un grupo",
+		"description." + CGroupsTrending:  "Obtener los grupos en tendencia",
+		"description." + CPlayer:          "Obtener información sobre un jugador",
+		"description." + CPlayerApps:      "Obtener el número de juegos en la biblioteca de un jugador",
+		"description." + CPlayerLevel:     "Obtener el nivel de un jugador",
+		"description." + CPlayerPlaytime:  "Obtener el tiempo de juego total de un jugador",
+		"description." + CPlayerRecent:    "Obtener los últimos juegos abiertos por un jugador",
+		"description." + CPlayerUpdate:    "Actualizar el perfil de Global Steam de un jugador",
+		"description." + CPlayerWishlist:  "Obtener la lista de deseados de un jugador",
+		"description." + CPlayerLibrary:   "Obtener los juegos más jugados de un jugador",
+		"description." + CPlayerCoop:      "Encontrar juegos para jugar con amigos, usando sus cuentas de Steam vinculadas",
+		"description." + CHelp:            "Obtener la lista completa de comandos",
+		"description." + CFeedback:        "Obtener un enlace para enviar comentarios",
+		"description." + CInvite:          "Obtener un enlace de invitación del bot para tu servidor de Discord",
+		"description." + CSettings:        "Cambiar u obtener un ajuste de usuario de GS",
+		"description." + CSteamOnline:     "Obtener el número de personas conectadas a Steam ahora",
+		"description." + CSubscribe:       "Publicar lanzamientos, bajadas de precio, actualizaciones de PICS, noticias o tendencias en este canal",
+		"description." + CAppPriceHistory: "Obtener un gráfico del historial de precios de un juego en una región",
+		"description." + CAppsCompare:     "Comparar de dos a cuatro juegos lado a lado",
+		"description." + CPlayerHistory:   "Obtener un gráfico del nivel o los juegos de un jugador a lo largo del tiempo",
+
+		"option." + CApp + ".game":               "El nombre o ID del juego",
+		"option." + CAppFollowers + ".game":      "El nombre o ID del juego",
+		"option." + CAppPlayers + ".game":        "El nombre o ID del juego, o vacío para todo Steam",
+		"option." + CAppPrice + ".game":          "El nombre o ID del juego",
+		"option." + CAppPrice + ".region":        "El código de región",
+		"option." + CAppsRandom + ".tag":         "Etiqueta",
+		"option." + CGroup + ".group":            "El nombre o ID del grupo",
+		"option." + CPlayer + ".player":          "El nombre o ID del jugador",
+		"option." + CPlayerApps + ".player":      "El nombre o ID del jugador",
+		"option." + CPlayerLevel + ".player":     "El nombre o ID del jugador",
+		"option." + CPlayerPlaytime + ".player":  "El nombre o ID del jugador",
+		"option." + CPlayerRecent + ".player":    "El nombre o ID del jugador",
+		"option." + CPlayerLibrary + ".player":   "El nombre o ID del jugador",
+		"option." + CPlayerCoop + ".user1":       "Un amigo con quien jugar",
+		"option." + CPlayerCoop + ".user2":       "Un amigo con quien jugar",
+		"option." + CPlayerCoop + ".user3":       "Un amigo con quien jugar",
+		"option." + CPlayerCoop + ".user4":       "Un amigo con quien jugar",
+		"option." + CPlayerUpdate + ".player":    "El nombre o ID del jugador",
+		"option." + CPlayerWishlist + ".player":  "El nombre o ID del jugador",
+		"option." + CHelp + ".command":           "El comando del que mostrar la ayuda",
+		"option." + CSettings + ".setting":       "El ajuste a cambiar u obtener",
+		"option." + CSettings + ".value":         "El valor a establecer, dejar vacío para obtener el valor",
+		"option." + CSubscribe + ".action":       "Añadir o quitar una suscripción, o listar las suscripciones de este servidor",
+		"option." + CSubscribe + ".feed":         "El feed a añadir",
+		"option." + CSubscribe + ".target":       "El nombre o ID del juego al añadir, el ID de la suscripción al quitar",
+		"option." + CAppPriceHistory + ".game":   "El nombre o ID del juego",
+		"option." + CAppPriceHistory + ".region": "El código de región",
+		"option." + CAppsCompare + ".game1":      "El nombre o ID de un juego",
+		"option." + CAppsCompare + ".game2":      "El nombre o ID de un juego",
+		"option." + CAppsCompare + ".game3":      "El nombre o ID de un juego",
+		"option." + CAppsCompare + ".game4":      "El nombre o ID de un juego",
+		"option." + CPlayerHistory + ".player":   "El nombre o ID del jugador",
+		"option." + CPlayerHistory + ".metric":   "Qué mostrar, el nivel por defecto",
+		"option." + CPlayerHistory + ".period":   "El periodo a mostrar, un año por defecto",
+
+		"field.achievements":       "Logros",
+		"field.all_time":           "Histórico",
+		"field.all_time_low":       "Mínimo histórico",
+		"field.badges":             "Insignias",
+		"field.bans":               "Bloqueos",
+		"field.cheapest_region":    "Región más barata",
+		"field.discounts":          "Descuentos",
+		"field.followers":          "Seguidores",
+		"field.foil_badges":        "Insignias metalizadas",
+		"field.games":              "Juegos",
+		"field.in_game":            "En partida",
+		"field.level":              "Nivel",
+		"field.max_weekly_players": "Máx. jugadores semanales",
+		"field.members":            "Miembros",
+		"field.now":                "Ahora",
+		"field.online":             "Conectados",
+		"field.playtime":           "Tiempo de juego",
+		"field.price":              "Precio",
+		"field.rank":               "Puesto",
+		"field.regular_price":      "Precio normal",
+		"field.release_date":       "Fecha de lanzamiento",
+		"field.review_score":       "Puntuación de reseñas",
+		"field.seven_days":         "7 días",
+		"help.not_found":           "Comando no encontrado",
+		"help.see":                 "Ver",
+	},
+}
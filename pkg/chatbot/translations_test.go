@@ -0,0 +1,60 @@
+package chatbot
+
+import (
+	"testing"
+)
+
+func TestTranslations(t *testing.T) {
+
+	for lang := range Languages {
+
+		if _, ok := translations[lang]; !ok {
+			t.Error(lang, "has no translations")
+			continue
+		}
+
+		// Shared strings
+		for key := range translations[LanguageEnglish] {
+			if _, ok := translations[lang][key]; !ok {
+				t.Error(lang, "missing", key)
+			}
+		}
+
+		if lang == LanguageEnglish {
+			continue
+		}
+
+		// Command and option descriptions
+		for _, command := range CommandRegister {
+
+			if _, ok := translations[lang][descriptionKey(command.ID())]; !ok {
+				t.Error(lang, "missing", descriptionKey(command.ID()))
+			}
+
+			for _, option := range command.Slash() {
+				if _, ok := translations[lang][optionKey(command.ID(), option.Name)]; !ok {
+					t.Error(lang, "missing", optionKey(command.ID(), option.Name))
+				}
+			}
+		}
+	}
+}
+
+func TestGetLanguage(t *testing.T) {
+
+	tests := map[string]Language{
+		"en-US": LanguageEnglish,
+		"en-GB": LanguageEnglish,
+		"de":    LanguageGerman,
+		"FR":    LanguageFrench,
+		"es-ES": LanguageSpanish,
+		"pt-BR": LanguageEnglish,
+		"":      LanguageEnglish,
+	}
+
+	for locale, expected := range tests {
+		if lang, _ := GetLanguage(locale); lang != expected {
+			t.Error(locale, lang, "!=", expected)
+		}
+	}
+}
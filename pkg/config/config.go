@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"flag"
 	"net/url"
 	"strings"
 
@@ -51,6 +52,9 @@ type Config struct {
 	ElasticUsername string `envconfig:"ELASTIC_SEARCH_USERNAME" required:"true"`
 	ElasticPassword string `envconfig:"ELASTIC_SEARCH_PASSWORD"`
 
+	// Exchange Rates
+	ExchangeRatesFile string `envconfig:"EXCHANGE_RATES_FILE"` // Load rates from a file instead of the API
+
 	// GitHub
 	GitHubClient        string `envconfig:"GITHUB_CLIENT"`         // OAuth
 	GitHubSecret        string `envconfig:"GITHUB_SECRET"`         // OAuth
@@ -128,6 +132,15 @@ type Config struct {
 	RollbarSecret string `envconfig:"ROLLBAR_PRIVATE"`
 	RollbarUser   string `envconfig:"ROLLBAR_USER"`
 
+	// Scaler
+	ScalerProvider            string `envconfig:"SCALER_PROVIDER" default:"hetzner"` // hetzner, do or fake
+	ScalerEnabled             bool   `envconfig:"SCALER_ENABLED"`                    // Autoscaling, manual scaling always works
+	ScalerMin                 int    `envconfig:"SCALER_MIN" default:"0"`
+	ScalerMax                 int    `envconfig:"SCALER_MAX" default:"10"`
+	ScalerStep                int    `envconfig:"SCALER_STEP" default:"1"`                     // Most consumers to add or remove at once
+	ScalerCooldown            int    `envconfig:"SCALER_COOLDOWN" default:"600"`               // Seconds between scaling actions
+	ScalerMessagesPerConsumer int    `envconfig:"SCALER_MESSAGES_PER_CONSUMER" default:"5000"` // Queued messages each consumer can handle
+
 	// Sendgrid
 	SendGridSecret string `envconfig:"SENDGRID_WEBHOOK_SECRET"`
 	SendGridAPIKey string `envconfig:"SENDGRID"`
@@ -227,6 +240,11 @@ func IsConsumer() bool {
 	return C.Environment == EnvConsumer
 }
 
+// Test binaries register the testing flags, production binaries never do
+func IsTest() bool {
+	return flag.Lookup("test.v") != nil
+}
+
 func GetSteamKeyTag() string {
 
 	key := C.SteamAPIKey
@@ -0,0 +1,85 @@
+package consumers
+
+import (
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+type AppAchievementStatsMessage struct {
+	AppID int `json:"id"`
+}
+
+func (m AppAchievementStatsMessage) Queue() rabbit.QueueName {
+	return QueueAppsAchievementStats
+}
+
+func appAchievementStatsHandler(message *rabbit.Message) {
+
+	payload := AppAchievementStatsMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	app, err := mongo.GetApp(payload.AppID)
+	if err == mongo.ErrNoDocuments {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	filter := bson.D{
+		{"app_id", payload.AppID},
+		{"active", true},
+		{"deleted", false},
+	}
+
+	achievements, err := mongo.GetAppAchievements(0, 0, filter, nil)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	counts, err := mongo.GetAchievmentCounts(payload.AppID)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	playerIDs, err := mongo.SamplePlayerIDsWithAchievements(payload.AppID, mongo.AppAchievementStatsSample)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	unlocks, err := mongo.GetPlayerAchievementsByPlayersAndApp(playerIDs, payload.AppID)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	stats := mongo.BuildAppAchievementStats(app, achievements, unlocks, counts)
+
+	err = mongo.ReplaceAppAchievementStats(stats)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	message.Ack()
+}
@@ -30,7 +30,7 @@ func appAchievementsHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -223,5 +223,13 @@ func appAchievementsHandler(message *rabbit.Message) {
 		return
 	}
 
+	// Rebuild rarity & unlock analytics
+	if len(schemaResponse.AvailableGameStats.Achievements) > 0 {
+		err = ProduceAppAchievementStats(payload.AppID)
+		if err != nil {
+			log.ErrS(err, payload.AppID)
+		}
+	}
+
 	message.Ack()
 }
@@ -23,7 +23,7 @@ func appsAchievementsSearchHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -39,7 +39,7 @@ func appsAchievementsSearchHandler(message *rabbit.Message) {
 	achievement.AppOwners = payload.AppOwners
 
 	if achievement.ID == "" || achievement.AppID == 0 {
-		sendToFailQueue(message)
+		sendToFailQueue(message, errInvalidPayload)
 		return
 	}
 
@@ -21,7 +21,7 @@ func appDLCHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
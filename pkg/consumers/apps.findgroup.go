@@ -33,7 +33,7 @@ func appsFindGroupHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
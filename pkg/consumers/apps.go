@@ -17,6 +17,7 @@ import (
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/memcache"
 	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
 	"github.com/gamedb/gamedb/pkg/mysql/pics"
 	"github.com/gamedb/gamedb/pkg/steam"
 	"github.com/gamedb/gamedb/pkg/websockets"
@@ -38,7 +39,7 @@ func appHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -46,15 +47,17 @@ func appHandler(message *rabbit.Message) {
 
 	if !helpers.IsValidAppID(id) {
 		log.ErrS(err, payload.ID)
-		sendToFailQueue(message)
+		sendToFailQueue(message, mongo.ErrInvalidAppID)
 		return
 	}
 
 	// Load current app
+	var isNew bool
 	app, err := mongo.GetApp(id, true)
 	if err == mongo.ErrNoDocuments {
 		app = mongo.App{}
 		app.ID = id
+		isNew = true
 	} else if err != nil {
 		log.ErrS(err, payload.ID)
 		sendToRetryQueue(message)
@@ -171,6 +174,20 @@ func appHandler(message *rabbit.Message) {
 			return
 		}
 
+		err = saveAppDepotHistory(appBeforeUpdate, app)
+		if err != nil {
+			log.ErrS(err, payload.ID)
+			sendToRetryQueue(message)
+			return
+		}
+
+		err = saveAppPICSHistory(appBeforeUpdate, app)
+		if err != nil {
+			log.ErrS(err, payload.ID)
+			sendToRetryQueue(message)
+			return
+		}
+
 		err = saveSales(app, sales)
 		if err != nil {
 			log.ErrS(err, payload.ID)
@@ -240,6 +257,41 @@ func appHandler(message *rabbit.Message) {
 		}
 	}()
 
+	// Send webhooks
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		if isNew {
+			triggerWebhooks(mysql.WebhookEventAppNew, WebhookAppPayload{AppID: app.ID, Name: app.GetName(), ChangeNumber: app.ChangeNumber})
+		}
+
+		if payload.ChangeNumber > 0 && app.ChangeNumber > appBeforeUpdate.ChangeNumber {
+			triggerWebhooks(mysql.WebhookEventAppChange, WebhookAppPayload{AppID: app.ID, Name: app.GetName(), ChangeNumber: app.ChangeNumber})
+		}
+	}()
+
+	// Post to subscribed Discord channels
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+
+		if !isNew && appBeforeUpdate.ReleaseState != "released" && app.ReleaseState == "released" && app.GetTypeLower() == "game" {
+
+			embed := chatBotAppEmbed(app.ID, app.GetName(), "Released: "+app.GetName(), app.ShortDescription)
+			ProduceChatBotFeeds(mysql.ChatBotFeedReleases, 0, "", embed)
+		}
+
+		if payload.ChangeNumber > 0 && app.ChangeNumber > appBeforeUpdate.ChangeNumber && appBeforeUpdate.ChangeNumber > 0 {
+
+			description := "Change [" + strconv.Itoa(app.ChangeNumber) + "](" + config.C.GlobalSteamDomain + "/changes/" + strconv.Itoa(app.ChangeNumber) + ")"
+			embed := chatBotAppEmbed(app.ID, app.GetName(), "PICS Update: "+app.GetName(), description)
+			ProduceChatBotFeeds(mysql.ChatBotFeedPICS, app.ID, "", embed)
+		}
+	}()
+
 	wg.Wait()
 
 	if message.ActionTaken {
@@ -1001,11 +1053,24 @@ func saveSales(app mongo.App, newSales []mongo.Sale) (err error) {
 		oldSalesMap[v.GetKey()] = v
 	}
 
+	// Get historical lows
+	stats, err := mongo.GetProductPriceStatsForProduct(helpers.ProductTypeApp, app.ID)
+	if err != nil {
+		return err
+	}
+
+	var lowestPrices = map[steamapi.ProductCC]int{}
+	for _, v := range stats {
+		if v.AllTimeLow > 0 {
+			lowestPrices[v.ProdCC] = v.AllTimeLow
+		}
+	}
+
 	for k, v := range newSales {
 
 		newSales[k].AppName = app.GetName()
 		newSales[k].AppIcon = app.GetIcon()
-		newSales[k].AppLowestPrice = map[steamapi.ProductCC]int{} // todo
+		newSales[k].AppLowestPrice = lowestPrices
 		newSales[k].AppRating = app.ReviewsScore
 		newSales[k].AppReleaseDate = time.Unix(app.ReleaseDateUnix, 0)
 		newSales[k].AppReleaseDateString = app.ReleaseDate
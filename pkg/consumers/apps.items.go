@@ -2,6 +2,7 @@ package consumers
 
 import (
 	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/elasticsearch"
 	"github.com/gamedb/gamedb/pkg/helpers"
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/memcache"
@@ -27,7 +28,7 @@ func appItemsHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -156,8 +157,62 @@ func appItemsHandler(message *rabbit.Message) {
 		return
 	}
 
-	// No need to update in Elastic
+	// Update Elastic
+	app, err := mongo.GetApp(payload.AppID)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
+	err = IndexAppItems(app, newDocuments)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
+	var keysToDelete []string
+	for _, v := range itemIDsToDelete {
+		keysToDelete = append(keysToDelete, elasticsearch.Item{AppID: payload.AppID, ItemDefID: v}.GetKey())
+	}
+
+	err = elasticsearch.DeleteItems(keysToDelete)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
 
 	//
 	message.Ack()
 }
+
+// Also used by the crons to index the existing items
+func IndexAppItems(app mongo.App, items []mongo.AppItem) error {
+
+	var documents = map[string]elasticsearch.Item{}
+	for _, v := range items {
+
+		document := elasticsearch.Item{
+			AppID:       v.AppID,
+			AppName:     app.GetName(),
+			ItemDefID:   v.ItemDefID,
+			Name:        v.Name,
+			Description: v.ShortDescription(),
+			Type:        v.Type,
+			Quality:     v.ItemQuality,
+			Tags:        v.GetTagStrings(),
+			Tradable:    v.Tradable,
+			Marketable:  v.Marketable,
+			Commodity:   v.Commodity,
+			Recipes:     len(v.GetRecipes()),
+			Prices:      v.GetPrices(),
+			Icon:        v.Image(54, true),
+		}
+
+		documents[document.GetKey()] = document
+	}
+
+	return elasticsearch.IndexItemsBulk(documents)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/memcache"
 	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
 	"github.com/gamedb/gamedb/pkg/steam"
 	"github.com/gamedb/gamedb/pkg/websockets"
 	"go.mongodb.org/mongo-driver/bson"
@@ -31,7 +32,7 @@ func appNewsHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -127,6 +128,26 @@ func appNewsHandler(message *rabbit.Message) {
 		return
 	}
 
+	for _, article := range articles {
+
+		// Skip old articles, for when an app's news is fetched for the first time
+		if article.Date.After(time.Now().Add(-time.Hour * 24)) {
+
+			triggerWebhooks(mysql.WebhookEventArticleNew, WebhookArticlePayload{
+				ArticleID: article.ID,
+				AppID:     article.AppID,
+				AppName:   article.AppName,
+				Title:     article.Title,
+				URL:       article.URL,
+				Date:      article.Date.Unix(),
+			})
+
+			embed := chatBotAppEmbed(article.AppID, article.AppName, article.Title, "News from "+helpers.GetAppName(article.AppID, article.AppName))
+			embed.URL = article.URL
+			ProduceChatBotFeeds(mysql.ChatBotFeedNews, article.AppID, "", embed)
+		}
+	}
+
 	// Update app row
 	newsIDs = helpers.UniqueInt64(newsIDs)
 
@@ -18,6 +18,12 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	reviewBombMinNegative  = 50 // New negative reviews in a day, ignores small games
+	reviewBombMultiplier   = 5  // Times the usual daily negative reviews
+	reviewBombBaselineDays = 30
+)
+
 type AppReviewsMessage struct {
 	AppID              int  `json:"id"`
 	SkipMissingPlayers bool `json:"skip_missing_players"`
@@ -34,7 +40,7 @@ func appReviewsHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -147,6 +153,37 @@ func appReviewsHandler(message *rabbit.Message) {
 		return reviews.Reviews[i].VotesGood > reviews.Reviews[j].VotesGood
 	})
 
+	// Language breakdown, only a sample as Steam only returns totals for the requested language
+	var languages = map[string]*helpers.AppReviewLanguage{}
+	for _, review := range respAll.Reviews {
+
+		if _, ok := languages[review.Language]; !ok {
+			languages[review.Language] = &helpers.AppReviewLanguage{Language: review.Language}
+		}
+
+		if review.VotedUp {
+			languages[review.Language].Positive++
+		} else {
+			languages[review.Language].Negative++
+		}
+	}
+
+	for _, v := range languages {
+		reviews.Languages = append(reviews.Languages, *v)
+	}
+
+	sort.Slice(reviews.Languages, func(i, j int) bool {
+		return reviews.Languages[i].GetTotal() > reviews.Languages[j].GetTotal()
+	})
+
+	// Save history
+	history, err := saveAppReviewHistory(payload.AppID, reviews, score)
+	if err != nil {
+		log.ErrS(err, payload.AppID)
+		sendToRetryQueue(message)
+		return
+	}
+
 	// Update in Mongo
 	var update = bson.D{
 		{"reviews_score", score},
@@ -154,6 +191,10 @@ func appReviewsHandler(message *rabbit.Message) {
 		{"reviews_count", reviews.GetTotal()},
 	}
 
+	if history.ReviewBomb {
+		update = append(update, bson.E{Key: "review_bomb_at", Value: history.UpdatedAt})
+	}
+
 	_, err = mongo.UpdateOne(mongo.CollectionApps, bson.D{{"_id", payload.AppID}}, update)
 	if err != nil {
 		log.ErrS(err, payload.AppID)
@@ -204,3 +245,67 @@ func appReviewsHandler(message *rabbit.Message) {
 
 	message.Ack()
 }
+
+// Saves today's totals and checks them against the previous days for a review bomb
+func saveAppReviewHistory(appID int, reviews helpers.AppReviewSummary, score float64) (history mongo.AppReviewHistory, err error) {
+
+	var now = time.Now()
+	var today = now.UTC().Truncate(time.Hour * 24)
+
+	previous, err := mongo.GetAppReviewHistories(appID, 0, reviewBombBaselineDays, bson.D{{"date", bson.M{"$lt": today}}})
+	if err != nil {
+		return history, err
+	}
+
+	history = mongo.AppReviewHistory{
+		AppID:     appID,
+		Date:      today,
+		UpdatedAt: now,
+		Positive:  reviews.Positive,
+		Negative:  reviews.Negative,
+		Score:     score,
+		Languages: reviews.Languages,
+	}
+
+	if len(previous) > 0 {
+
+		// Reviews can be deleted
+		if reviews.Positive > previous[0].Positive {
+			history.NewPositive = reviews.Positive - previous[0].Positive
+		}
+		if reviews.Negative > previous[0].Negative {
+			history.NewNegative = reviews.Negative - previous[0].Negative
+		}
+
+		history.Days = int(today.Sub(previous[0].Date).Hours() / 24)
+
+		history.ReviewBomb = isReviewBomb(history, previous)
+	}
+
+	return history, mongo.ReplaceAppReviewHistory(history)
+}
+
+func isReviewBomb(today mongo.AppReviewHistory, previous []mongo.AppReviewHistory) bool {
+
+	// Compare rates, a week of reviews since the last scan is not a bomb
+	var negative = today.GetNewNegativePerDay()
+
+	if negative < reviewBombMinNegative || today.NewNegative <= today.NewPositive {
+		return false
+	}
+
+	// Earlier review bombs would raise the baseline
+	var total, days int
+	for _, v := range previous {
+		if !v.ReviewBomb {
+			total += v.NewNegative
+			days += v.GetDays()
+		}
+	}
+
+	if days == 0 {
+		return true
+	}
+
+	return negative >= float64(total)/float64(days)*reviewBombMultiplier
+}
@@ -34,7 +34,7 @@ func appsSearchHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -84,7 +84,7 @@ func appsSearchHandler(message *rabbit.Message) {
 	} else {
 
 		log.ErrS(message.Message.Body)
-		sendToFailQueue(message)
+		sendToFailQueue(message, errInvalidPayload)
 		return
 	}
 
@@ -35,13 +35,13 @@ func appYoutubeHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
 	if config.C.YoutubeAPIKey == "" {
 		log.Err("Missing environment variables")
-		sendToFailQueue(message)
+		sendToFailQueue(message, config.ErrMissingEnvironmentVariable)
 		return
 	}
 
@@ -0,0 +1,116 @@
+package consumers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestAppHandler(t *testing.T) {
+
+	defer setupTest(t)()
+
+	// Stats the store API names are matched against
+	err := testMongo.Insert(mongo.CollectionStats,
+		mongo.Stat{Type: mongo.StatsTypeDevelopers, ID: 5, Name: "Hidden Path Entertainment"},
+		mongo.Stat{Type: mongo.StatsTypePublishers, ID: 2, Name: "Valve"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ack := consume(t, appHandler, QueueApps, AppMessage{
+		ID:           440,
+		ChangeNumber: 11223344,
+		VDF: map[string]interface{}{
+			"appinfo": map[string]interface{}{
+				"appid": "440",
+				"common": map[string]interface{}{
+					"name":         "Team Fortress 2",
+					"type":         "Game",
+					"releasestate": "released",
+					"store_tags": map[string]interface{}{
+						"0": "1663",
+						"1": "3859",
+					},
+				},
+			},
+		},
+	})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	var apps []mongo.App
+	err = testMongo.Decode(mongo.CollectionApps, bson.D{{"_id", 440}}, &apps)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(apps) != 1 {
+		t.Fatal("expected 1 app, got", len(apps))
+	}
+
+	app := apps[0]
+
+	// From PICS
+	if app.Name != "Team Fortress 2" || app.ChangeNumber != 11223344 || len(app.Tags) != 2 {
+		t.Error("PICS not saved", app.Name, app.ChangeNumber, app.Tags)
+	}
+
+	// From the store API
+	if app.ShortDescription == "" || len(app.Screenshots) != 1 || len(app.Genres) != 2 {
+		t.Error("app details not saved")
+	}
+
+	// New developer gets the next ID, existing publisher is reused
+	if len(app.Developers) != 1 || app.Developers[0] != 6 || len(app.Publishers) != 1 || app.Publishers[0] != 2 {
+		t.Error("wrong developers or publishers", app.Developers, app.Publishers)
+	}
+
+	// From the store page
+	if len(app.TagCounts) != 3 {
+		t.Error("store page not scraped")
+	}
+
+	var sales []mongo.Sale
+	err = testMongo.Decode(mongo.CollectionAppSales, bson.D{{"app_id", 440}}, &sales)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sales) != 1 || sales[0].SalePercent != -50 || sales[0].SaleType != "daily deal" {
+		t.Error("sale not saved", sales)
+	}
+
+	// Sub queues
+	for _, queue := range []rabbit.QueueName{QueueAppsAchievements, QueueAppsNews, QueueAppsReviews, QueueBundles, QueueWebsockets} {
+		if len(testChannels[queue].Messages()) != 1 {
+			t.Error("expected a message on", queue)
+		}
+	}
+}
+
+func TestAppHandlerUpToDate(t *testing.T) {
+
+	defer setupTest(t)()
+
+	err := testMongo.Insert(mongo.CollectionApps, mongo.App{ID: 440, Name: "Team Fortress 2", ChangeNumber: 11223344, UpdatedAt: time.Now()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ack := consume(t, appHandler, QueueApps, AppMessage{ID: 440, ChangeNumber: 11223344})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	for queue, channel := range testChannels {
+		if len(channel.Messages()) > 0 {
+			t.Error("unexpected message on", queue)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package consumers
+
+import (
+	"strconv"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/email"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"go.uber.org/zap"
+)
+
+const websocketQueueBanWatch = "ban-watch"
+
+type BanWatchMessage struct {
+	WatchID        int    `json:"watch_id"`
+	UserID         int    `json:"user_id"`
+	NotifyEmail    bool   `json:"notify_email"`
+	NotifyDiscord  bool   `json:"notify_discord"`
+	PlayerID       int64  `json:"player_id"`
+	PlayerName     string `json:"player_name"`
+	VACBansBefore  int    `json:"vac_bans_before"`
+	VACBansAfter   int    `json:"vac_bans_after"`
+	GameBansBefore int    `json:"game_bans_before"`
+	GameBansAfter  int    `json:"game_bans_after"`
+}
+
+func (m BanWatchMessage) Queue() rabbit.QueueName {
+	return QueueBanWatch
+}
+
+func (m BanWatchMessage) getPath() string {
+	return helpers.GetPlayerPath(m.PlayerID, m.PlayerName)
+}
+
+func (m BanWatchMessage) getText() string {
+
+	name := helpers.GetPlayerName(m.PlayerID, m.PlayerName)
+
+	switch {
+	case m.VACBansAfter > m.VACBansBefore && m.GameBansAfter > m.GameBansBefore:
+		return name + " has received a VAC ban and a game ban"
+	case m.VACBansAfter > m.VACBansBefore:
+		return name + " has received a VAC ban (" + strconv.Itoa(m.VACBansAfter) + " total)"
+	default:
+		return name + " has received a game ban (" + strconv.Itoa(m.GameBansAfter) + " total)"
+	}
+}
+
+// Called from the player consumer, before is the player row before the update
+func checkBanWatches(before mongo.Player, after mongo.Player) {
+
+	if after.NumberOfVACBans <= before.NumberOfVACBans && after.NumberOfGameBans <= before.NumberOfGameBans {
+		return
+	}
+
+	watches, err := mysql.GetBanWatchesForPlayer(after.ID)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	for _, watch := range watches {
+
+		err = produce(QueueBanWatch, BanWatchMessage{
+			WatchID:        watch.ID,
+			UserID:         watch.UserID,
+			NotifyEmail:    watch.NotifyEmail,
+			NotifyDiscord:  watch.NotifyDiscord,
+			PlayerID:       after.ID,
+			PlayerName:     after.GetName(),
+			VACBansBefore:  before.NumberOfVACBans,
+			VACBansAfter:   after.NumberOfVACBans,
+			GameBansBefore: before.NumberOfGameBans,
+			GameBansAfter:  after.NumberOfGameBans,
+		})
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+func banWatchHandler(message *rabbit.Message) {
+
+	payload := BanWatchMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	user, err := mysql.GetUserByID(payload.UserID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	var name = helpers.GetPlayerName(payload.PlayerID, payload.PlayerName)
+	var text = payload.getText()
+
+	var notification = userNotification{
+		Subject:        "Ban Watch: " + name,
+		WebsocketQueue: websocketQueueBanWatch,
+		WebsocketText:  text,
+		Path:           payload.getPath(),
+	}
+
+	if payload.NotifyEmail {
+		notification.Email = email.BanWatchTemplate{
+			Domain:   config.C.GlobalSteamDomain,
+			Text:     text,
+			Path:     payload.getPath(),
+			VACBans:  payload.VACBansAfter,
+			GameBans: payload.GameBansAfter,
+		}
+	}
+
+	if payload.NotifyDiscord {
+		notification.Discord = &discordgo.MessageEmbed{
+			Title:       "Ban Watch: " + name,
+			Description: text,
+			Footer:      &discordgo.MessageEmbedFooter{Text: "VAC bans: " + strconv.Itoa(payload.VACBansAfter) + " - Game bans: " + strconv.Itoa(payload.GameBansAfter)},
+		}
+	}
+
+	// Only once the user has been told
+	if notifyUser(user, notification) {
+		err = mysql.BanWatch{ID: payload.WatchID}.SetNotified()
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+
+	message.Ack()
+}
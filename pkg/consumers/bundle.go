@@ -36,7 +36,7 @@ func bundleHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -68,6 +68,14 @@ func bundleHandler(message *rabbit.Message) {
 		return
 	}
 
+	// Compare against the packages bought separately
+	bundle.PricesIndividual, err = getBundleIndividualPrices(bundle)
+	if err != nil {
+		log.ErrS(err, payload.ID)
+		sendToRetryQueue(message)
+		return
+	}
+
 	var wg sync.WaitGroup
 
 	// Save new data
@@ -141,6 +149,55 @@ func bundleHandler(message *rabbit.Message) {
 	message.Ack()
 }
 
+func getBundleIndividualPrices(bundle mongo.Bundle) (prices map[steamapi.ProductCC]int, err error) {
+
+	packages, err := mongo.GetPackagesByID(bundle.Packages, bson.M{"_id": 1, "prices": 1})
+	if err != nil {
+		return nil, err
+	}
+
+	// Missing packages would make the bundle look like a better deal than it is
+	if len(packages) != len(bundle.Packages) {
+		return map[steamapi.ProductCC]int{}, nil
+	}
+
+	return mongo.GetBundleIndividualPrices(packages), nil
+}
+
+// Bundles are compared against their packages' current prices, so update them when a package price changes
+func updateBundlesIndividualPrices(packageID int) (err error) {
+
+	bundles, err := mongo.GetBundles(0, 0, nil, bson.D{{"packages", packageID}}, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, bundle := range bundles {
+
+		bundle.PricesIndividual, err = getBundleIndividualPrices(bundle)
+		if err != nil {
+			return err
+		}
+
+		_, err = mongo.UpdateOne(mongo.CollectionBundles, bson.D{{"_id", bundle.ID}}, bson.D{{"prices_individual", bundle.PricesIndividual}})
+		if err != nil {
+			return err
+		}
+
+		err = memcache.Client().Delete(memcache.ItemBundle(bundle.ID).Key)
+		if err != nil {
+			return err
+		}
+
+		err = ProduceBundleSearch(bundle)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateBundle(bundle *mongo.Bundle) (err error) {
 
 	var prices = map[steamapi.ProductCC]int{}
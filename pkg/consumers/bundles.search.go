@@ -24,30 +24,32 @@ func bundleSearchHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
 	bundle := elasticsearch.Bundle{
-		Apps:            len(payload.Bundle.Apps),
-		CreatedAt:       payload.Bundle.CreatedAt.Unix(),
-		Discount:        payload.Bundle.Discount,
-		DiscountHighest: payload.Bundle.DiscountHighest,
-		DiscountLowest:  payload.Bundle.DiscountLowest,
-		DiscountSale:    payload.Bundle.DiscountSale,
-		Giftable:        payload.Bundle.Giftable,
-		Icon:            payload.Bundle.Icon,
-		ID:              payload.Bundle.ID,
-		Image:           payload.Bundle.Image,
-		OnSale:          payload.Bundle.OnSale,
-		Name:            payload.Bundle.Name,
-		Packages:        len(payload.Bundle.Packages),
-		Prices:          payload.Bundle.Prices,
-		PricesSale:      payload.Bundle.PricesSale,
-		Type:            payload.Bundle.Type,
-		UpdatedAt:       payload.Bundle.UpdatedAt.Unix(),
-		NameMarked:      "",
-		Score:           0,
+		Apps:             len(payload.Bundle.Apps),
+		CreatedAt:        payload.Bundle.CreatedAt.Unix(),
+		Discount:         payload.Bundle.Discount,
+		DiscountHighest:  payload.Bundle.DiscountHighest,
+		DiscountLowest:   payload.Bundle.DiscountLowest,
+		DiscountSale:     payload.Bundle.DiscountSale,
+		Giftable:         payload.Bundle.Giftable,
+		Icon:             payload.Bundle.Icon,
+		ID:               payload.Bundle.ID,
+		Image:            payload.Bundle.Image,
+		OnSale:           payload.Bundle.OnSale,
+		Name:             payload.Bundle.Name,
+		Packages:         len(payload.Bundle.Packages),
+		Prices:           payload.Bundle.Prices,
+		PricesSale:       payload.Bundle.PricesSale,
+		PricesIndividual: payload.Bundle.PricesIndividual,
+		Savings:          payload.Bundle.GetSavings(),
+		Type:             payload.Bundle.Type,
+		UpdatedAt:        payload.Bundle.UpdatedAt.Unix(),
+		NameMarked:       "",
+		Score:            0,
 	}
 
 	err = elasticsearch.IndexBundle(bundle)
@@ -0,0 +1,202 @@
+package consumers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/Jleagle/rate-limit-go"
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"go.uber.org/zap"
+)
+
+const chatBotFeedColour = 2664261 // Matches the chatbot
+
+// Discord allows 50 requests a second globally and 5 messages every 5 seconds per channel.
+// The limiters are per process, which is fine while the chatbot runs as a single instance,
+// more instances would need to share them, or rely on discordgo waiting out the 429s.
+var (
+	chatBotFeedLimiterGlobal  = rate.New(time.Millisecond * 25)
+	chatBotFeedLimiterChannel = rate.New(time.Second, rate.WithBurst(5))
+
+	chatBotFeedSession     *discordgo.Session
+	chatBotFeedSessionLock sync.Mutex
+)
+
+type ChatBotFeedMessage struct {
+	SubscriptionID int                     `json:"subscription_id"`
+	ChannelID      string                  `json:"channel_id"`
+	Embed          *discordgo.MessageEmbed `json:"embed"`
+}
+
+func (m ChatBotFeedMessage) Queue() rabbit.QueueName {
+	return QueueChatBotFeeds
+}
+
+// Queues a post for every channel subscribed to the feed
+func ProduceChatBotFeeds(feed mysql.ChatBotFeed, appID int, cc steamapi.ProductCC, embed *discordgo.MessageEmbed) {
+
+	subscriptions, err := mysql.GetChatBotSubscriptionsForFeed(feed, appID, cc)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	for _, subscription := range subscriptions {
+
+		err = produce(QueueChatBotFeeds, ChatBotFeedMessage{
+			SubscriptionID: subscription.ID,
+			ChannelID:      subscription.ChannelID,
+			Embed:          embed,
+		})
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+// Called from a cron
+func ProduceChatBotTrendingFeed() error {
+
+	apps, err := mongo.TrendingApps()
+	if err != nil {
+		return err
+	}
+
+	if len(apps) == 0 {
+		return nil
+	}
+
+	var lines []string
+	for k, app := range apps {
+
+		line := fmt.Sprintf("%2d", k+1) + ": " + app.GetTrend() + " " + app.GetName()
+		if app.IsReviewBombed() {
+			line += " (review bomb)"
+		}
+
+		lines = append(lines, line)
+	}
+
+	ProduceChatBotFeeds(mysql.ChatBotFeedTrending, 0, "", &discordgo.MessageEmbed{
+		Title:       "Trending Games",
+		Description: "```" + strings.Join(lines, "\n") + "```",
+		URL:         config.C.GlobalSteamDomain + "/games/trending",
+		Thumbnail:   &discordgo.MessageEmbedThumbnail{URL: apps[0].GetHeaderImage(), Width: 460, Height: 215},
+		Color:       chatBotFeedColour,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	})
+
+	return nil
+}
+
+func chatBotAppEmbed(appID int, appName string, title string, description string) *discordgo.MessageEmbed {
+
+	return &discordgo.MessageEmbed{
+		Title:       title,
+		Description: description,
+		URL:         config.C.GlobalSteamDomain + helpers.GetAppPath(appID, appName),
+		Thumbnail:   &discordgo.MessageEmbedThumbnail{URL: helpers.GetAppHeaderImage(appID), Width: 460, Height: 215},
+		Color:       chatBotFeedColour,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+}
+
+// One session is shared so discordgo can track the rate limit buckets
+func getChatBotFeedSession() (*discordgo.Session, error) {
+
+	chatBotFeedSessionLock.Lock()
+	defer chatBotFeedSessionLock.Unlock()
+
+	if chatBotFeedSession == nil {
+
+		if config.C.DiscordChatBotToken == "" {
+			return nil, config.ErrMissingEnvironmentVariable
+		}
+
+		session, err := discordgo.New("Bot " + config.C.DiscordChatBotToken)
+		if err != nil {
+			return nil, err
+		}
+
+		chatBotFeedSession = session
+	}
+
+	return chatBotFeedSession, nil
+}
+
+// Consumed by the chatbot
+func chatBotFeedHandler(message *rabbit.Message) {
+
+	payload := ChatBotFeedMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	// The channel may have unsubscribed since this was queued
+	_, err = mysql.GetChatBotSubscription(payload.SubscriptionID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	session, err := getChatBotFeedSession()
+	if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	// Rate limits, discordgo also waits out any 429s
+	err = chatBotFeedLimiterGlobal.GetLimiter("global").Wait(context.TODO())
+	if err == nil {
+		err = chatBotFeedLimiterChannel.GetLimiter(payload.ChannelID).Wait(context.TODO())
+	}
+	if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	_, err = session.ChannelMessageSendEmbed(payload.ChannelID, payload.Embed)
+	if val, ok := err.(*discordgo.RESTError); ok && val.Message != nil {
+
+		switch val.Message.Code {
+		case discordgo.ErrCodeUnknownChannel, discordgo.ErrCodeMissingAccess, discordgo.ErrCodeMissingPermissions:
+
+			// The channel has been deleted or the bot can no longer post there
+			err = mysql.DeleteChatBotSubscriptionsByChannel(payload.ChannelID)
+			if err != nil {
+				log.ErrS(err)
+			}
+
+			message.Ack()
+			return
+		}
+	}
+
+	if err != nil {
+		log.Err(err.Error(), zap.String("channel", payload.ChannelID))
+		sendToRetryQueueWithDelay(message, time.Minute)
+		return
+	}
+
+	message.Ack()
+}
@@ -22,7 +22,12 @@ import (
 
 const ConsumersPerProcess = 2
 
-var ErrInQueue = errors.New("already in queue")
+var (
+	ErrInQueue = errors.New("already in queue")
+
+	errInvalidPayload = errors.New("invalid payload")
+	errNoChannel      = errors.New("channel not in register")
+)
 
 type QueueMessageInterface interface {
 	Queue() rabbit.QueueName
@@ -35,6 +40,7 @@ const (
 	QueueAppsItems              rabbit.QueueName = "GDB_Apps.Items"
 	QueueAppsArticlesSearch     rabbit.QueueName = "GDB_Apps.Articles.Search"
 	QueueAppsAchievementsSearch rabbit.QueueName = "GDB_Apps.Achievements.Search"
+	QueueAppsAchievementStats   rabbit.QueueName = "GDB_Apps.Achievements.Stats"
 	QueueAppsYoutube            rabbit.QueueName = "GDB_Apps.Youtube"
 	QueueAppsWishlists          rabbit.QueueName = "GDB_Apps.Wishlists"
 	QueueAppsInflux             rabbit.QueueName = "GDB_Apps.Influx"
@@ -66,6 +72,8 @@ const (
 	QueuePlayersAliases      rabbit.QueueName = "GDB_Players.Aliases"
 	QueuePlayersGroups       rabbit.QueueName = "GDB_Players.Groups"
 	QueuePlayersWishlist     rabbit.QueueName = "GDB_Players.Wishlist"
+	QueuePlayersExport       rabbit.QueueName = "GDB_Players.Export"
+	QueuePlayersHistory      rabbit.QueueName = "GDB_Players.History"
 
 	// Group
 	QueueGroups          rabbit.QueueName = "GDB_Groups"
@@ -77,23 +85,30 @@ const (
 	QueueAppPlayersTop rabbit.QueueName = "GDB_App_Players_Top"
 
 	// Other
-	QueueChanges     rabbit.QueueName = "GDB_Changes"
-	QueueDelay       rabbit.QueueName = "GDB_Delay"
-	QueueFailed      rabbit.QueueName = "GDB_Failed"
-	QueuePlayerRanks rabbit.QueueName = "GDB_Player_Ranks"
-	QueueStats       rabbit.QueueName = "GDB_Stats"
-	QueueSteam       rabbit.QueueName = "GDB_Steam"
-	QueueTest        rabbit.QueueName = "GDB_Test"
-	QueueWebsockets  rabbit.QueueName = "GDB_Websockets"
+	QueueBanWatch        rabbit.QueueName = "GDB_Ban_Watch"
+	QueueChanges         rabbit.QueueName = "GDB_Changes"
+	QueueChatBotFeeds    rabbit.QueueName = "GDB_ChatBot_Feeds"
+	QueueDelay           rabbit.QueueName = "GDB_Delay"
+	QueueFailed          rabbit.QueueName = "GDB_Failed"
+	QueuePlayerRanks     rabbit.QueueName = "GDB_Player_Ranks"
+	QueuePriceAlerts     rabbit.QueueName = "GDB_Price_Alerts"
+	QueueStats           rabbit.QueueName = "GDB_Stats"
+	QueueSteam           rabbit.QueueName = "GDB_Steam"
+	QueueTest            rabbit.QueueName = "GDB_Test"
+	QueueWebhooks        rabbit.QueueName = "GDB_Webhooks"
+	QueueWebsockets      rabbit.QueueName = "GDB_Websockets"
+	QueueWishlistAlerts  rabbit.QueueName = "GDB_Wishlist_Alerts"
+	QueueWishlistDigests rabbit.QueueName = "GDB_Wishlist_Digests"
 )
 
 var (
-	ProducerChannels = map[rabbit.QueueName]*rabbit.Channel{}
+	ProducerChannels = map[rabbit.QueueName]Channel{}
 
 	AllProducerDefinitions = []QueueDefinition{
 		{Name: QueueAppPlayersTop},
 		{Name: QueueAppPlayers},
 		{Name: QueueAppsAchievementsSearch, prefetchSize: 1_000},
+		{Name: QueueAppsAchievementStats},
 		{Name: QueueAppsAchievements},
 		{Name: QueueAppsArticlesSearch, prefetchSize: 1_000},
 		{Name: QueueAppsDLC},
@@ -111,8 +126,10 @@ var (
 		{Name: QueueAppsWishlists, prefetchSize: 1_000},
 		{Name: QueueAppsYoutube},
 		{Name: QueueApps},
+		{Name: QueueBanWatch},
 		{Name: QueueBundles},
 		{Name: QueueChanges},
+		{Name: QueueChatBotFeeds},
 		{Name: QueueDelay, skipHeaders: true},
 		{Name: QueueFailed, skipHeaders: true},
 		{Name: QueueGroupsPrimaries, prefetchSize: 1_000},
@@ -125,15 +142,21 @@ var (
 		{Name: QueuePlayersAchievements},
 		{Name: QueuePlayersAliases},
 		{Name: QueuePlayersBadges},
+		{Name: QueuePlayersExport},
 		{Name: QueuePlayersGames},
 		{Name: QueuePlayersGroups},
+		{Name: QueuePlayersHistory},
 		{Name: QueuePlayersSearch, prefetchSize: 1_000},
 		{Name: QueuePlayersWishlist},
 		{Name: QueuePlayers},
+		{Name: QueuePriceAlerts},
 		{Name: QueueStats},
 		{Name: QueueSteam},
 		{Name: QueueTest},
+		{Name: QueueWebhooks},
 		{Name: QueueWebsockets},
+		{Name: QueueWishlistAlerts},
+		{Name: QueueWishlistDigests},
 	}
 
 	ConsumersDefinitions = []QueueDefinition{
@@ -142,6 +165,7 @@ var (
 		{Name: QueueApps, consumer: appHandler},
 		{Name: QueueAppsAchievements, consumer: appAchievementsHandler},
 		{Name: QueueAppsAchievementsSearch, consumer: appsAchievementsSearchHandler, prefetchSize: 1_000},
+		{Name: QueueAppsAchievementStats, consumer: appAchievementStatsHandler},
 		{Name: QueueAppsArticlesSearch, consumer: appsArticlesSearchHandler, prefetchSize: 1_000},
 		{Name: QueueAppsDLC, consumer: appDLCHandler},
 		{Name: QueueAppsFindGroup, consumer: appsFindGroupHandler},
@@ -156,11 +180,13 @@ var (
 		{Name: QueueAppsTwitch, consumer: appTwitchHandler},
 		{Name: QueueAppsWishlists, consumer: appWishlistsHandler, prefetchSize: 1_000},
 		{Name: QueueAppsYoutube, consumer: appYoutubeHandler},
+		{Name: QueueBanWatch},
 		{Name: QueueBundles, consumer: bundleHandler},
 		{Name: QueueBundlesSearch, consumer: bundleSearchHandler, prefetchSize: 1_000},
 		{Name: QueueChanges, consumer: changesHandler},
+		{Name: QueueChatBotFeeds},
 		{Name: QueueDelay, consumer: delayHandler, skipHeaders: true},
-		{Name: QueueFailed, skipHeaders: true},
+		{Name: QueueFailed, consumer: failedHandler, skipHeaders: true},
 		{Name: QueueGroups, consumer: groupsHandler},
 		{Name: QueueGroupsPrimaries, consumer: groupPrimariesHandler, prefetchSize: 1_000},
 		{Name: QueueGroupsSearch, consumer: groupsSearchHandler, prefetchSize: 1_000},
@@ -172,14 +198,20 @@ var (
 		{Name: QueuePlayersAwards, consumer: playerAwardsHandler},
 		{Name: QueuePlayersAliases, consumer: playerAliasesHandler},
 		{Name: QueuePlayersBadges, consumer: playerBadgesHandler},
+		{Name: QueuePlayersExport, consumer: playerExportHandler},
 		{Name: QueuePlayersGames, consumer: playerGamesHandler},
 		{Name: QueuePlayersGroups, consumer: playersGroupsHandler},
+		{Name: QueuePlayersHistory, consumer: playerHistoryHandler},
 		{Name: QueuePlayersSearch, consumer: appsPlayersHandler, prefetchSize: 1_000},
 		{Name: QueuePlayersWishlist, consumer: playersWishlistHandler},
+		{Name: QueuePriceAlerts},
 		{Name: QueueStats, consumer: statsHandler},
 		{Name: QueueSteam},
 		{Name: QueueTest, consumer: testHandler},
+		{Name: QueueWebhooks, consumer: webhookHandler},
 		{Name: QueueWebsockets},
+		{Name: QueueWishlistAlerts},
+		{Name: QueueWishlistDigests},
 	}
 
 	FrontendDefinitions = []QueueDefinition{
@@ -197,6 +229,7 @@ var (
 		{Name: QueueAppsYoutube},
 		{Name: QueueAppsSameowners},
 		{Name: QueueApps},
+		{Name: QueueBanWatch, consumer: banWatchHandler},
 		{Name: QueueBundles},
 		{Name: QueueBundlesSearch, prefetchSize: 1_000},
 		{Name: QueueChanges},
@@ -208,14 +241,18 @@ var (
 		{Name: QueuePackagesPrices},
 		{Name: QueuePackages},
 		{Name: QueuePlayerRanks},
+		{Name: QueuePlayersExport},
 		{Name: QueuePlayersGroups},
 		{Name: QueuePlayersSearch, prefetchSize: 1_000},
 		{Name: QueuePlayersWishlist},
 		{Name: QueuePlayers},
+		{Name: QueuePriceAlerts, consumer: priceAlertHandler},
 		{Name: QueueStats},
 		{Name: QueueSteam},
 		{Name: QueueTest},
 		{Name: QueueWebsockets, consumer: websocketHandler},
+		{Name: QueueWishlistAlerts, consumer: wishlistAlertHandler},
+		{Name: QueueWishlistDigests, consumer: wishlistDigestHandler},
 	}
 
 	QueueSteamDefinitions = []QueueDefinition{
@@ -242,6 +279,7 @@ var (
 		{Name: QueueAppsSameowners},
 		{Name: QueueApps},
 		{Name: QueueBundlesSearch, prefetchSize: 1_000},
+		{Name: QueueChatBotFeeds},
 		{Name: QueueDelay, skipHeaders: true},
 		{Name: QueueGroupsPrimaries, prefetchSize: 1_000},
 		{Name: QueueGroupsSearch, prefetchSize: 1_000},
@@ -254,9 +292,13 @@ var (
 		{Name: QueueStats},
 		{Name: QueueSteam},
 		{Name: QueueWebsockets},
+		{Name: QueueWishlistDigests},
 	}
 
 	ChatbotDefinitions = []QueueDefinition{
+		{Name: QueueChatBotFeeds, consumer: chatBotFeedHandler},
+		{Name: QueueDelay, skipHeaders: true},
+		{Name: QueueFailed, skipHeaders: true},
 		{Name: QueuePlayers},
 		{Name: QueueWebsockets},
 	}
@@ -269,6 +311,29 @@ type QueueDefinition struct {
 	prefetchSize int
 }
 
+// Adds a producer for every queue not in the definitions, so any message can be sent from the server
+func WithAllProducers(definitions []QueueDefinition) []QueueDefinition {
+
+	var names = map[rabbit.QueueName]bool{}
+	for _, v := range definitions {
+		names[v.Name] = true
+	}
+
+	var all = append([]QueueDefinition{}, definitions...)
+	for _, v := range AllProducerDefinitions {
+		if !names[v.Name] {
+			all = append(all, v)
+		}
+	}
+
+	return all
+}
+
+// Whether this server reads the queue, or only produces to it
+func (qd QueueDefinition) HasConsumer() bool {
+	return qd.consumer != nil
+}
+
 func Init(definitions []QueueDefinition) {
 
 	heartbeat := time.Minute
@@ -331,7 +396,7 @@ func Init(definitions []QueueDefinition) {
 		if err != nil {
 			log.ErrS(string(queue.Name), err)
 		} else {
-			ProducerChannels[queue.Name] = q
+			ProducerChannels[queue.Name] = rabbitChannel{q}
 		}
 	}
 
@@ -397,10 +462,44 @@ func Init(definitions []QueueDefinition) {
 	}
 }
 
+// The calls made on producer channels, so tests can swap in a FakeChannel
+type Channel interface {
+	Produce(body interface{}, mutator rabbit.ProduceOptions) error
+	SendToQueueAndAck(message *rabbit.Message, mutator rabbit.ProduceOptions) error
+	Inspect() (amqp.Queue, error)
+}
+
+type rabbitChannel struct {
+	*rabbit.Channel
+}
+
+func (c rabbitChannel) SendToQueueAndAck(message *rabbit.Message, mutator rabbit.ProduceOptions) error {
+	return message.SendToQueueAndAck(c.Channel, mutator)
+}
+
 // Message helpers
-func sendToFailQueue(message *rabbit.Message) {
+const headerFailureReason = "failure-reason"
+
+func sendToQueueAndAck(message *rabbit.Message, q rabbit.QueueName, mutator rabbit.ProduceOptions) error {
 
-	err := message.SendToQueueAndAck(ProducerChannels[QueueFailed], nil)
+	if val, ok := ProducerChannels[q]; ok {
+		return val.SendToQueueAndAck(message, mutator)
+	}
+
+	return errNoChannel
+}
+
+func sendToFailQueue(message *rabbit.Message, reason error) {
+
+	var po rabbit.ProduceOptions
+	if reason != nil {
+		po = func(p amqp.Publishing) amqp.Publishing {
+			p.Headers[headerFailureReason] = reason.Error()
+			return p
+		}
+	}
+
+	err := sendToQueueAndAck(message, QueueFailed, po)
 	if err != nil {
 		log.ErrS(err)
 	}
@@ -421,7 +520,7 @@ func sendToRetryQueueWithDelay(message *rabbit.Message, delay time.Duration) {
 		}
 	}
 
-	err := message.SendToQueueAndAck(ProducerChannels[QueueDelay], po)
+	err := sendToQueueAndAck(message, QueueDelay, po)
 	if err != nil {
 		log.ErrS(err)
 	}
@@ -435,7 +534,7 @@ func sendToLastQueue(message *rabbit.Message) {
 		queue = QueueFailed
 	}
 
-	err := message.SendToQueueAndAck(ProducerChannels[queue], nil)
+	err := sendToQueueAndAck(message, queue, nil)
 	if err != nil {
 		log.ErrS(err)
 	}
@@ -713,6 +812,16 @@ func ProduceAppAchievement(appID int, appName string, appOwners int64) (err erro
 	return produce(QueueAppsAchievements, AppAchievementsMessage{AppID: appID, AppName: appName, AppOwners: appOwners})
 }
 
+func ProduceAppAchievementStats(appID int) (err error) {
+
+	return produce(QueueAppsAchievementStats, AppAchievementStatsMessage{AppID: appID})
+}
+
+func ProducePlayerExport(exportID string) (err error) {
+
+	return produce(QueuePlayersExport, PlayerExportMessage{ExportID: exportID})
+}
+
 func ProduceSteam(payload SteamMessage) (err error) {
 
 	if len(payload.AppIDs) == 0 && len(payload.PackageIDs) == 0 {
@@ -770,6 +879,12 @@ func ProducePlayerSearch(player *mongo.Player, playerID int64) (err error) {
 	return produce(QueuePlayersSearch, PlayersSearchMessage{Player: player, PlayerID: playerID})
 }
 
+func ProduceWishlistDigest(userID int) (err error) {
+
+	m := WishlistDigestMessage{UserID: userID}
+	return produce(m.Queue(), m)
+}
+
 func ProduceWebsocket(payload interface{}, pages ...websockets.WebsocketPage) (err error) {
 
 	b, err := json.Marshal(payload)
@@ -793,5 +908,5 @@ func produce(q rabbit.QueueName, payload interface{}) error {
 		return val.Produce(payload, nil)
 	}
 
-	return errors.New("channel not in register")
+	return errNoChannel
 }
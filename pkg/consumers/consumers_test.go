@@ -0,0 +1,163 @@
+package consumers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/memcache"
+	"github.com/gamedb/gamedb/pkg/memcache/memcachetest"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mongo/mongotest"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"github.com/gamedb/gamedb/pkg/mysql/mysqltest"
+	"github.com/gamedb/gamedb/pkg/steam/steamtest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// Backends for the current test, replaced by setupTest
+var (
+	testMongo    *mongotest.Fake
+	testMemcache *memcachetest.Fake
+	testChannels map[rabbit.QueueName]*FakeChannel
+	testSteam    *steamtest.Fake
+	testLogs     *observer.ObservedLogs
+	testInflux   = &fakeInflux{}
+)
+
+func TestMain(m *testing.M) {
+
+	config.C.Environment = config.EnvConsumer
+
+	// The Influx client has its own transport, so give it a server
+	server := httptest.NewServer(testInflux)
+	config.C.InfluxURL = server.URL
+
+	// The Steam clients refuse to make calls without a key, fixtures don't need a real one
+	if config.C.SteamAPIKey == "" {
+		config.C.SteamAPIKey = "fake"
+	}
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+// Swaps every backend for an empty fake, call the returned function when the test ends
+func setupTest(t *testing.T) (teardown func()) {
+
+	testMongo = mongotest.NewFake()
+	mongo.SetTestClient(testMongo)
+
+	testMemcache = memcachetest.NewFake()
+	memcache.SetTestClient(testMemcache)
+
+	conn, err := mysqltest.NewFake()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mysql.SetTestClient(conn)
+
+	testChannels = UseFakeChannels()
+
+	testInflux.reset()
+
+	core, logs := observer.New(zap.WarnLevel)
+	testLogs = logs
+	undoLogger := zap.ReplaceGlobals(zap.New(core))
+
+	testSteam = steamtest.NewFake("testdata/steam")
+	testSteam.Record = os.Getenv("STEAM_RECORD") != ""
+	undoSteam := testSteam.Install()
+
+	return func() {
+
+		undoSteam()
+		undoLogger()
+
+		for _, v := range testSteam.Missing() {
+			t.Error("missing steam fixture:", v)
+		}
+	}
+}
+
+// Runs a handler on a message, as if it came from the queue
+func consume(t *testing.T, handler rabbit.Handler, queue rabbit.QueueName, payload interface{}) *FakeAcknowledger {
+
+	message, ack, err := NewFakeMessage(queue, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler(message)
+
+	return ack
+}
+
+func assertNoErrors(t *testing.T) {
+
+	for _, v := range testLogs.All() {
+		t.Error("logged:", v.Message, v.ContextMap())
+	}
+}
+
+func assertAcked(t *testing.T, ack *FakeAcknowledger) {
+
+	if !ack.Acked {
+		t.Error("message not acked")
+	}
+	if ack.Nacked || ack.Rejected {
+		t.Error("message nacked or rejected")
+	}
+
+	// Retries and failures are acked too, after being moved
+	for _, queue := range []rabbit.QueueName{QueueDelay, QueueFailed} {
+		for _, v := range testChannels[queue].Messages() {
+			t.Error("message sent to "+string(queue)+":", v.Headers[headerFailureReason], string(v.Body))
+		}
+	}
+}
+
+// Collects the line protocol the Influx client writes
+type fakeInflux struct {
+	lines []string
+	mutex sync.Mutex
+}
+
+func (f *fakeInflux) reset() {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.lines = nil
+}
+
+func (f *fakeInflux) Lines() []string {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return append([]string(nil), f.lines...)
+}
+
+func (f *fakeInflux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+
+	if r.URL.Path == "/write" {
+
+		b, _ := ioutil.ReadAll(r.Body)
+
+		f.mutex.Lock()
+		f.lines = append(f.lines, strings.Split(strings.TrimSpace(string(b)), "\n")...)
+		f.mutex.Unlock()
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,43 +1,198 @@
 package consumers
 
 import (
+	"errors"
 	"math"
 	"time"
 
 	"github.com/Jleagle/rabbit-go"
+	influxHelper "github.com/gamedb/gamedb/pkg/influx"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	influx "github.com/influxdata/influxdb1-client"
+	"github.com/satori/go.uuid"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
 )
 
 const (
 	MaxDelay = time.Hour * 6
 	minDelay = time.Second * 10
+
+	// How long a scheduler has to deliver a claimed message before another can claim it
+	delayLease = time.Minute
+
+	headerDelayUntil = "delay-until"
 )
 
+var errDelayNoQueue = errors.New("delayed message queue not in register")
+
+// Moves the message into Mongo until it is due, instead of re-publishing it until then
 func delayHandler(message *rabbit.Message) {
 
-	time.Sleep(time.Second / 10)
+	var dueAt = getDelayDueAt(message)
 
-	// log.InfoS(fmt.Sprint(message.LastQueue()), " - ", message.Attempt(), " - ", string(message.Message.Body))
+	if !dueAt.After(time.Now()) {
+		sendToLastQueue(message)
+		return
+	}
 
-	// If time.Now() is before "delay-until", keep delaying
-	if val, ok := message.Message.Headers["delay-until"]; ok {
-		if val2, ok2 := val.(int64); ok2 {
-			if val2 > time.Now().Unix() {
-				sendToRetryQueue(message)
-				return
-			}
-		}
+	var dm = mongo.DelayedMessage{
+		UUID:        message.UUID(),
+		CreatedAt:   time.Now(),
+		DueAt:       dueAt,
+		Queue:       string(message.LastQueue()),
+		FirstQueue:  string(message.FirstQueue()),
+		FirstSeen:   message.FirstSeen(),
+		Attempt:     message.Attempt(),
+		ContentType: message.Message.ContentType,
+		Body:        message.Message.Body,
 	}
 
-	// If first seen time is before incremental backoff
+	if dm.UUID == "" {
+		dm.UUID = uuid.NewV4().String()
+	}
+
+	if dm.Queue == "" {
+		sendToFailQueue(message, errDelayNoQueue)
+		return
+	}
+
+	err := mongo.SaveDelayedMessage(dm)
+	if err != nil {
+		log.ErrS(err)
+		time.Sleep(time.Second * 10)
+		message.Nack(false, true)
+		return
+	}
+
+	message.Ack()
+}
+
+// The later of "delay-until" and the incremental backoff from first seen
+func getDelayDueAt(message *rabbit.Message) time.Time {
+
 	var seconds float64
 	seconds = math.Pow(2, float64(message.Attempt()))
 	seconds = math.Min(seconds, MaxDelay.Seconds())
 	seconds = math.Max(seconds, minDelay.Seconds())
 
-	// Requeue
-	if message.FirstSeen().Add(time.Second * time.Duration(seconds)).Before(time.Now()) {
-		sendToLastQueue(message)
-	} else {
-		sendToRetryQueue(message)
+	dueAt := message.FirstSeen().Add(time.Second * time.Duration(seconds))
+
+	if val, ok := message.Message.Headers[headerDelayUntil]; ok {
+		if val2, ok2 := val.(int64); ok2 {
+			if until := time.Unix(val2, 0); until.After(dueAt) {
+				dueAt = until
+			}
+		}
+	}
+
+	return dueAt
+}
+
+// Delivers delayed messages back to their queue once they are due.
+// Safe to run in more than one process, messages are claimed before delivery.
+func StartDelayScheduler() {
+
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+			saveDelayQueueMetrics()
+		}
+	}()
+
+	for {
+
+		dm, found, err := mongo.ClaimDelayedMessage(delayLease)
+		if err != nil {
+			log.ErrS(err)
+			time.Sleep(time.Second * 10)
+			continue
+		}
+
+		if !found {
+			time.Sleep(time.Second)
+			continue
+		}
+
+		err = deliverDelayedMessage(dm)
+		if err != nil {
+			log.Err(err.Error(), zap.String("uuid", dm.UUID), zap.String("queue", dm.Queue))
+			continue // Gets claimed again when the lease ends
+		}
+
+		err = mongo.DeleteDelayedMessage(dm.UUID)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+func deliverDelayedMessage(dm mongo.DelayedMessage) error {
+
+	queue := rabbit.QueueName(dm.Queue)
+
+	channel, ok := ProducerChannels[queue]
+	if !ok {
+		channel, ok = ProducerChannels[QueueFailed]
+		if !ok {
+			return errDelayNoQueue
+		}
+		queue = QueueFailed
+	}
+
+	// Restore the headers, as if the message had been sent straight back to its queue
+	po := func(p amqp.Publishing) amqp.Publishing {
+
+		p.Headers = amqp.Table{
+			"attempt":     dm.Attempt + 1,
+			"first-seen":  dm.FirstSeen.Unix(),
+			"last-seen":   time.Now().Unix(),
+			"first-queue": dm.FirstQueue,
+			"last-queue":  dm.Queue,
+			"uuid":        dm.UUID,
+		}
+
+		if queue == QueueFailed {
+			p.Headers[headerFailureReason] = errDelayNoQueue.Error()
+		}
+
+		if dm.ContentType != "" {
+			p.ContentType = dm.ContentType
+		}
+
+		p.Body = dm.Body
+		return p
+	}
+
+	return channel.Produce(nil, po)
+}
+
+func saveDelayQueueMetrics() {
+
+	counts, err := mongo.GetDelayedMessageCounts()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	var batch = influx.BatchPoints{}
+	for _, v := range counts {
+		batch.Points = append(batch.Points, influx.Point{
+			Measurement: string(influxHelper.InfluxMeasurementDelayQueue),
+			Tags: map[string]string{
+				"queue": v.ID,
+			},
+			Fields: map[string]interface{}{
+				"pending": v.Count,
+			},
+			Time:      time.Now(),
+			Precision: "m",
+		})
+	}
+
+	_, err = influxHelper.InfluxWriteMany(influxHelper.InfluxRetentionPolicyAllTime, batch)
+	if err != nil {
+		log.ErrS(err)
 	}
 }
@@ -0,0 +1,77 @@
+package consumers
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/satori/go.uuid"
+	"go.uber.org/zap"
+)
+
+// Drains the failed queue into Mongo, so messages can be inspected and replayed
+func failedHandler(message *rabbit.Message) {
+
+	var fm = mongo.FailedMessage{
+		UUID:       message.UUID(),
+		CreatedAt:  time.Now(),
+		FirstSeen:  message.FirstSeen(),
+		LastSeen:   message.LastSeen(),
+		FirstQueue: string(message.FirstQueue()),
+		Queue:      string(message.LastQueue()),
+		Attempt:    message.Attempt(),
+		Body:       string(message.Message.Body),
+	}
+
+	if fm.UUID == "" {
+		fm.UUID = uuid.NewV4().String()
+	}
+
+	if val, ok := message.Message.Headers[headerFailureReason]; ok {
+		if val2, ok2 := val.(string); ok2 {
+			fm.Reason = val2
+		}
+	}
+
+	err := mongo.SaveFailedMessage(fm)
+	if err != nil {
+		log.ErrS(err)
+		time.Sleep(time.Second * 10)
+		message.Nack(false, true)
+		return
+	}
+
+	message.Ack()
+}
+
+var ErrReplayNoQueue = errors.New("queue not in register")
+
+// Replays messages back to the queue they failed in, returns the UUIDs that got replayed
+func ReplayFailedMessages(messages []mongo.FailedMessage) (replayed []string, err error) {
+
+	for _, fm := range messages {
+
+		channel, ok := ProducerChannels[rabbit.QueueName(fm.Queue)]
+		if !ok || fm.Queue == "" || fm.Queue == string(QueueFailed) {
+			log.Warn(ErrReplayNoQueue.Error(), zap.String("uuid", fm.UUID), zap.String("queue", fm.Queue))
+			continue
+		}
+
+		if !json.Valid([]byte(fm.Body)) {
+			log.Warn("invalid json body", zap.String("uuid", fm.UUID))
+			continue
+		}
+
+		err = channel.Produce(json.RawMessage(fm.Body), nil)
+		if err != nil {
+			return replayed, err
+		}
+
+		replayed = append(replayed, fm.UUID)
+	}
+
+	return replayed, mongo.SetFailedMessagesReplayed(replayed)
+}
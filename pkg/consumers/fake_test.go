@@ -0,0 +1,179 @@
+package consumers
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/streadway/amqp"
+)
+
+// An in-memory channel, for running consumers without a server
+type FakeChannel struct {
+	Queue    rabbit.QueueName
+	messages []amqp.Publishing
+	mutex    sync.Mutex
+}
+
+func NewFakeChannel(queue rabbit.QueueName) *FakeChannel {
+	return &FakeChannel{Queue: queue}
+}
+
+// Registers a fake channel for every producer queue
+func UseFakeChannels() map[rabbit.QueueName]*FakeChannel {
+
+	var channels = map[rabbit.QueueName]*FakeChannel{}
+
+	for _, v := range AllProducerDefinitions {
+		channels[v.Name] = NewFakeChannel(v.Name)
+		ProducerChannels[v.Name] = channels[v.Name]
+	}
+
+	return channels
+}
+
+func (c *FakeChannel) Produce(body interface{}, mutator rabbit.ProduceOptions) error {
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	c.publish(amqp.Publishing{
+		Headers:      fakeHeaders(c.Queue),
+		DeliveryMode: amqp.Persistent,
+		ContentType:  "application/json",
+		Body:         b,
+	}, mutator)
+
+	return nil
+}
+
+func (c *FakeChannel) SendToQueueAndAck(message *rabbit.Message, mutator rabbit.ProduceOptions) error {
+
+	var headers = amqp.Table{}
+	for k, v := range message.Message.Headers {
+		headers[k] = v
+	}
+	headers["last-queue"] = string(c.Queue)
+
+	c.publish(amqp.Publishing{
+		Headers:      headers,
+		DeliveryMode: message.Message.DeliveryMode,
+		ContentType:  message.Message.ContentType,
+		Body:         message.Message.Body,
+	}, mutator)
+
+	message.Ack()
+	return nil
+}
+
+func (c *FakeChannel) publish(p amqp.Publishing, mutator rabbit.ProduceOptions) {
+
+	if mutator != nil {
+		p = mutator(p)
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.messages = append(c.messages, p)
+}
+
+func (c *FakeChannel) Inspect() (amqp.Queue, error) {
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return amqp.Queue{Name: string(c.Queue), Messages: len(c.messages)}, nil
+}
+
+// Everything produced so far, oldest first
+func (c *FakeChannel) Messages() []amqp.Publishing {
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return append([]amqp.Publishing(nil), c.messages...)
+}
+
+// Records what a consumer did with a message from NewFakeMessage
+type FakeAcknowledger struct {
+	Acked    bool
+	Nacked   bool
+	Rejected bool
+	Requeued bool
+	mutex    sync.Mutex
+}
+
+func (a *FakeAcknowledger) Ack(uint64, bool) error {
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.Acked = true
+	return nil
+}
+
+func (a *FakeAcknowledger) Nack(_ uint64, _ bool, requeue bool) error {
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.Nacked = true
+	a.Requeued = requeue
+	return nil
+}
+
+func (a *FakeAcknowledger) Reject(_ uint64, requeue bool) error {
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.Rejected = true
+	a.Requeued = requeue
+	return nil
+}
+
+// A message as a consumer of the queue would receive it
+func NewFakeMessage(queue rabbit.QueueName, payload interface{}) (*rabbit.Message, *FakeAcknowledger, error) {
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ack = &FakeAcknowledger{}
+
+	message := &rabbit.Message{
+		Message: &amqp.Delivery{
+			Acknowledger: ack,
+			Headers:      fakeHeaders(queue),
+			DeliveryMode: amqp.Persistent,
+			ContentType:  "application/json",
+			Body:         b,
+		},
+	}
+
+	return message, ack, nil
+}
+
+var fakeMessageCount uint64
+
+// The headers rabbit-go sets on a message's first delivery
+func fakeHeaders(queue rabbit.QueueName) amqp.Table {
+
+	var now = time.Now().Unix()
+
+	return amqp.Table{
+		"attempt":     int32(1),
+		"first-seen":  now,
+		"last-seen":   now,
+		"first-queue": string(queue),
+		"last-queue":  string(queue),
+		"uuid":        "fake-" + strconv.FormatUint(atomic.AddUint64(&fakeMessageCount, 1), 10),
+	}
+}
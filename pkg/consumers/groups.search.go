@@ -25,7 +25,7 @@ func groupsSearchHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -51,7 +51,7 @@ func groupsSearchHandler(message *rabbit.Message) {
 
 	} else {
 
-		sendToFailQueue(message)
+		sendToFailQueue(message, errInvalidPayload)
 		return
 	}
 
@@ -0,0 +1,106 @@
+package consumers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/email"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"github.com/gamedb/gamedb/pkg/oauth"
+	"github.com/gamedb/gamedb/pkg/websockets"
+)
+
+// Leave a channel empty to not send to it
+type userNotification struct {
+	Subject        string                  // Email subject
+	Email          email.Template          // Only sent to verified emails
+	Discord        *discordgo.MessageEmbed // Only sent if the user has linked Discord
+	WebsocketQueue string                  // Only sent if the user shows alerts
+	WebsocketText  string
+	Path           string
+}
+
+// Notification consumers with emails run on the frontend, as that is where the email templates are.
+// Errors are only logged, retrying would send duplicate notifications.
+// Returns true if the user was sent anything.
+func notifyUser(user mysql.User, notification userNotification) (sent bool) {
+
+	// Email
+	if notification.Email != nil && user.EmailVerified {
+
+		err := email.GetProvider().Send(user.Email, "", "", notification.Subject, notification.Email)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			sent = true
+		}
+	}
+
+	// Discord
+	if notification.Discord != nil {
+
+		if notification.Discord.URL == "" && notification.Path != "" {
+			notification.Discord.URL = config.C.GlobalSteamDomain + notification.Path
+		}
+		if notification.Discord.Timestamp == "" {
+			notification.Discord.Timestamp = time.Now().Format(time.RFC3339)
+		}
+
+		ok, err := sendDiscordMessage(user.ID, notification.Discord)
+		if err != nil {
+			log.ErrS(err, user.ID)
+		} else if ok {
+			sent = true
+		}
+	}
+
+	// Websocket
+	if notification.WebsocketQueue != "" && user.ShowAlerts {
+
+		playerID := mysql.GetUserSteamID(user.ID)
+		if playerID > 0 {
+
+			wsPayload := PlayerPayload{
+				ID:      strconv.FormatInt(playerID, 10),
+				Queue:   notification.WebsocketQueue,
+				Link:    notification.Path,
+				Message: notification.WebsocketText,
+			}
+
+			err := ProduceWebsocket(wsPayload, websockets.PagePlayer)
+			if err != nil {
+				log.ErrS(err)
+			} else {
+				sent = true
+			}
+		}
+	}
+
+	return sent
+}
+
+// Direct messages the user, returns false if they have not linked Discord
+func sendDiscordMessage(userID int, embed *discordgo.MessageEmbed) (sent bool, err error) {
+
+	provider, err := mysql.GetUserProviderByUserID(oauth.ProviderDiscord, userID)
+	if err != nil || provider.ID == "" {
+		return false, helpers.IgnoreErrors(err, mysql.ErrRecordNotFound)
+	}
+
+	session, err := getChatBotFeedSession()
+	if err != nil {
+		return false, err
+	}
+
+	channel, err := session.UserChannelCreate(provider.ID)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = session.ChannelMessageSendEmbed(channel.ID, embed)
+	return err == nil, err
+}
@@ -38,7 +38,7 @@ func packageHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -169,7 +169,7 @@ func packageHandler(message *rabbit.Message) {
 		return
 	}
 
-	// Save price changes
+	// Save price and PICS changes
 	wg.Add(1)
 	go func() {
 
@@ -181,6 +181,13 @@ func packageHandler(message *rabbit.Message) {
 			sendToRetryQueue(message)
 			return
 		}
+
+		err = savePackagePICSHistory(packageBeforeUpdate, pack)
+		if err != nil {
+			log.ErrS(err, payload.ID)
+			sendToRetryQueue(message)
+			return
+		}
 	}()
 
 	// Save package
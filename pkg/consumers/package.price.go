@@ -34,7 +34,7 @@ func packagePriceHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -86,6 +86,9 @@ func packagePriceHandler(message *rabbit.Message) {
 		var oldPrice = *payload.BeforePrice
 		var newPrice = response.Data.Price.Final
 
+		// Find alerts before the new price is saved, and send them after
+		alerts := getPriceAlerts(helpers.ProductTypePackage, int(payload.PackageID), payload.PackageName, productCC, oldPrice, newPrice, payload.LowestPrice)
+
 		wg.Add(1)
 		go func() {
 
@@ -113,6 +116,13 @@ func packagePriceHandler(message *rabbit.Message) {
 					return
 				}
 
+				producePriceAlerts(alerts)
+
+				err = mongo.UpdateProductPriceStats(price)
+				if err != nil {
+					log.ErrS(err)
+				}
+
 				// Send websockets to prices page
 				if result != nil {
 					if insertedID, ok := result.InsertedID.(primitive.ObjectID); ok {
@@ -149,6 +159,18 @@ func packagePriceHandler(message *rabbit.Message) {
 
 	wg.Wait()
 
+	if message.ActionTaken {
+		return
+	}
+
+	// Only logged, retrying would save the price change and send alerts again
+	if payload.BeforePrice != nil && *payload.BeforePrice != response.Data.Price.Final {
+		err = updateBundlesIndividualPrices(int(payload.PackageID))
+		if err != nil {
+			log.ErrS(err, payload.PackageID)
+		}
+	}
+
 	//
 	message.Ack()
 }
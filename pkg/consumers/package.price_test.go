@@ -0,0 +1,164 @@
+package consumers
+
+import (
+	"testing"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPackagePriceHandler(t *testing.T) {
+
+	defer setupTest(t)()
+
+	err := testMongo.Insert(mongo.CollectionPackages,
+		mongo.Package{ID: 354231, Name: "Half-Life 2 Complete"},
+		mongo.Package{ID: 2, Prices: helpers.ProductPrices{steamapi.ProductCCUS: {Currency: steamapi.CurrencyUSD, Final: 1000}}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = testMongo.Insert(mongo.CollectionBundles, mongo.Bundle{ID: 232, Packages: []int{354231, 2}, Prices: map[steamapi.ProductCC]int{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var before = 3999
+
+	ack := consume(t, packagePriceHandler, QueuePackagesPrices, PackagePriceMessage{
+		PackageID:   354231,
+		PackageName: "Half-Life 2 Complete",
+		ProductCC:   steamapi.ProductCCUS,
+		BeforePrice: &before,
+	})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	// Package price
+	var packages []mongo.Package
+	err = testMongo.Decode(mongo.CollectionPackages, bson.D{{"_id", 354231}}, &packages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(packages) != 1 {
+		t.Fatal("expected 1 package, got", len(packages))
+	}
+
+	price := packages[0].Prices.Get(steamapi.ProductCCUS)
+	if price.Final != 399 || price.Initial != 3999 || price.DiscountPercent != 90 {
+		t.Error("wrong package price", price)
+	}
+
+	// Price change
+	var changes []mongo.ProductPrice
+	err = testMongo.Decode(mongo.CollectionProductPrices, bson.D{{"package_id", 354231}}, &changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 1 {
+		t.Fatal("expected 1 price change, got", len(changes))
+	}
+	if changes[0].PriceBefore != 3999 || changes[0].PriceAfter != 399 || changes[0].Difference != -3600 {
+		t.Error("wrong price change", changes[0])
+	}
+
+	// Stats
+	var stats []mongo.ProductPriceStats
+	err = testMongo.Decode(mongo.CollectionProductPriceStats, bson.D{{"package_id", 354231}}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats) != 1 {
+		t.Fatal("expected 1 price stats, got", len(stats))
+	}
+	if stats[0].CurrentPrice != 399 || stats[0].AllTimeLow != 399 {
+		t.Error("wrong price stats", stats[0])
+	}
+
+	// Bundles containing the package
+	var bundles []mongo.Bundle
+	err = testMongo.Decode(mongo.CollectionBundles, bson.D{{"_id", 232}}, &bundles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bundles) != 1 || bundles[0].PricesIndividual[steamapi.ProductCCUS] != 1399 {
+		t.Error("bundle individual price not updated", bundles)
+	}
+
+	if len(testChannels[QueueBundlesSearch].Messages()) != 1 {
+		t.Error("expected a bundle search message")
+	}
+
+	// Prices page
+	if len(testChannels[QueueWebsockets].Messages()) != 1 {
+		t.Error("expected a websocket message")
+	}
+}
+
+func TestPackagePriceHandlerNotFound(t *testing.T) {
+
+	defer setupTest(t)()
+
+	ack := consume(t, packagePriceHandler, QueuePackagesPrices, PackagePriceMessage{
+		PackageID: 1,
+		ProductCC: steamapi.ProductCCUS,
+	})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	docs, err := testMongo.Documents(mongo.CollectionPackages, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(docs) != 0 {
+		t.Error("package created for a missing package")
+	}
+}
+
+func TestPackagePriceHandlerRetried(t *testing.T) {
+
+	defer setupTest(t)()
+
+	err := testMongo.Insert(mongo.CollectionPackages, mongo.Package{ID: 354231, Name: "Half-Life 2 Complete"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var before = 3999
+
+	for i := 0; i < 2; i++ {
+
+		ack := consume(t, packagePriceHandler, QueuePackagesPrices, PackagePriceMessage{
+			PackageID:   354231,
+			PackageName: "Half-Life 2 Complete",
+			ProductCC:   steamapi.ProductCCUS,
+			BeforePrice: &before,
+		})
+
+		assertAcked(t, ack)
+		assertNoErrors(t)
+	}
+
+	var stats []mongo.ProductPriceStats
+	err = testMongo.Decode(mongo.CollectionProductPriceStats, bson.D{{"package_id", 354231}}, &stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(stats) != 1 {
+		t.Fatal("expected 1 price stats, got", len(stats))
+	}
+	if stats[0].DiscountCount != 1 || stats[0].GetAverageDiscount() != 90.02 {
+		t.Error("retry counted twice", stats[0])
+	}
+}
@@ -30,7 +30,7 @@ func playerAliasesHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -56,6 +56,14 @@ func playerAliasesHandler(message *rabbit.Message) {
 		return
 	}
 
+	// The newest alias we already know about
+	previousAliases, err := mongo.GetPlayerAliases(payload.PlayerID, 1, 0)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
 	var playerAliases []mongo.PlayerAlias
 	var playerAliasStrings []string
 
@@ -92,6 +100,37 @@ func playerAliasesHandler(message *rabbit.Message) {
 		return
 	}
 
+	// History
+	if len(previousAliases) > 0 {
+
+		var newest mongo.PlayerAlias
+		for _, v := range playerAliases {
+			if v.Time > newest.Time {
+				newest = v
+			}
+		}
+
+		previous := previousAliases[0]
+
+		if newest.Time > previous.Time && newest.PlayerName != previous.PlayerName {
+
+			event := mongo.PlayerHistory{
+				PlayerID:  payload.PlayerID,
+				Type:      mongo.PlayerHistoryName,
+				Before:    previous.PlayerName,
+				After:     newest.PlayerName,
+				CreatedAt: time.Unix(newest.Time, 0),
+			}
+
+			err = producePlayerHistory(payload.PlayerID, []mongo.PlayerHistory{event})
+			if err != nil {
+				log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+				sendToRetryQueue(message)
+				return
+			}
+		}
+	}
+
 	// Update player row
 	update := bson.D{{"aliases", playerAliasStrings}}
 
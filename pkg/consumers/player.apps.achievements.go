@@ -34,7 +34,7 @@ func playerAchievementsHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -316,5 +316,34 @@ func playerAchievementsHandler(message *rabbit.Message) {
 		return
 	}
 
+	// History, only when this scan's new unlocks finished the app
+	var newUnlocks bool
+	for _, v := range rows {
+		if v.AchievementDate > timestamp {
+			newUnlocks = true
+			break
+		}
+	}
+
+	if percent >= 100 && timestamp > 0 && newUnlocks {
+
+		event := mongo.PlayerHistory{
+			PlayerID:  payload.PlayerID,
+			Type:      mongo.PlayerHistoryCompleted,
+			ItemID:    int64(app.ID),
+			ItemName:  app.GetName(),
+			ItemIcon:  app.GetIcon(),
+			ItemPath:  app.GetPath() + "#achievements",
+			CreatedAt: time.Now(),
+		}
+
+		err = producePlayerHistory(payload.PlayerID, []mongo.PlayerHistory{event})
+		if err != nil {
+			log.ErrS(err)
+			sendToRetryQueue(message)
+			return
+		}
+	}
+
 	message.Ack()
 }
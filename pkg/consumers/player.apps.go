@@ -38,7 +38,7 @@ func playerGamesHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -148,6 +148,44 @@ func playerGamesHandler(message *rabbit.Message) {
 
 	updatePlayer = append(updatePlayer, bson.E{Key: "games_by_type", Value: gamesByType})
 
+	// History, skipped on the first scan
+	var history []mongo.PlayerHistory
+
+	oldApps, err := mongo.GetPlayerAppsByPlayer(payload.PlayerID, 0, 0, nil, bson.M{"app_id": 1}, nil)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
+	if len(oldApps) > 0 {
+
+		var oldAppIDs = map[int]bool{}
+		for _, v := range oldApps {
+			oldAppIDs[v.AppID] = true
+		}
+
+		var now = time.Now()
+
+		for _, v := range playerApps {
+			if !oldAppIDs[v.AppID] {
+				history = append(history, mongo.PlayerHistory{
+					PlayerID:  payload.PlayerID,
+					Type:      mongo.PlayerHistoryGame,
+					ItemID:    int64(v.AppID),
+					ItemName:  helpers.GetAppName(v.AppID, v.AppName),
+					ItemIcon:  v.GetIcon(),
+					ItemPath:  helpers.GetAppPath(v.AppID, v.AppName),
+					CreatedAt: now,
+				})
+			}
+		}
+
+		if len(history) > mongo.PlayerHistoryMaxItems {
+			history = nil
+		}
+	}
+
 	// Save playerApps to Mongo
 	err = mongo.UpdatePlayerApps(playerApps)
 	if err != nil {
@@ -238,6 +276,13 @@ func playerGamesHandler(message *rabbit.Message) {
 		return
 	}
 
+	err = producePlayerHistory(payload.PlayerID, history)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
 	//
 	message.Ack()
 }
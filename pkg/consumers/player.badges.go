@@ -34,7 +34,7 @@ func playerBadgesHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -123,6 +123,44 @@ func playerBadgesHandler(message *rabbit.Message) {
 		foilBadgeCount = payload.OldFoilCount
 	}
 
+	// History, skipped on the first scan
+	var history []mongo.PlayerHistory
+
+	if payload.OldCount > 0 {
+
+		oldBadges, err := mongo.GetAllPlayerBadgesByPlayer(payload.PlayerID)
+		if err != nil {
+			log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+			sendToRetryQueue(message)
+			return
+		}
+
+		var oldBadgeKeys = map[string]bool{}
+		for _, v := range oldBadges {
+			oldBadgeKeys[v.GetKey()] = true
+		}
+
+		var now = time.Now()
+
+		for _, v := range playerBadgeSlice {
+			if !oldBadgeKeys[v.GetKey()] {
+				history = append(history, mongo.PlayerHistory{
+					PlayerID:  payload.PlayerID,
+					Type:      mongo.PlayerHistoryBadge,
+					ItemID:    int64(v.ID()),
+					ItemName:  v.GetName(),
+					ItemIcon:  v.GetIcon(),
+					ItemPath:  v.GetPath(),
+					CreatedAt: now,
+				})
+			}
+		}
+
+		if len(history) > mongo.PlayerHistoryMaxItems {
+			history = nil
+		}
+	}
+
 	// Save to Mongo
 	err = mongo.ReplacePlayerBadges(playerBadgeSlice)
 	if err != nil {
@@ -180,6 +218,13 @@ func playerBadgesHandler(message *rabbit.Message) {
 		return
 	}
 
+	err = producePlayerHistory(payload.PlayerID, history)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
 	//
 	message.Ack()
 }
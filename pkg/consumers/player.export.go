@@ -0,0 +1,496 @@
+package consumers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+type PlayerExportMessage struct {
+	ExportID string `json:"export_id"`
+}
+
+func (m PlayerExportMessage) Queue() rabbit.QueueName {
+	return QueuePlayersExport
+}
+
+func playerExportHandler(message *rabbit.Message) {
+
+	payload := PlayerExportMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	export, err := mongo.GetPlayerExport(payload.ExportID)
+	if err == mongo.ErrNoDocuments {
+		message.Ack() // Expired
+		return
+	} else if err != nil {
+		log.ErrS(err, payload.ExportID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	if export.IsFinished() {
+		message.Ack()
+		return
+	}
+
+	if message.Attempt() > 5 {
+
+		err = mongo.SetPlayerExportStatus(export.ID, mongo.PlayerExportFailed, "Something went wrong, please try again later")
+		if err != nil {
+			log.ErrS(err, export.ID)
+		}
+
+		message.Ack()
+		return
+	}
+
+	err = mongo.SetPlayerExportStatus(export.ID, mongo.PlayerExportProcessing, "")
+	if err != nil {
+		log.ErrS(err, export.ID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	archive, err := buildPlayerExport(export.PlayerID, export.ProdCC)
+	if err != nil {
+		log.ErrS(err, export.ID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	if len(archive) > mongo.PlayerExportMaxSize {
+
+		err = mongo.SetPlayerExportStatus(export.ID, mongo.PlayerExportFailed, "This library is too large to export")
+		if err != nil {
+			log.ErrS(err, export.ID)
+		}
+
+		message.Ack()
+		return
+	}
+
+	err = mongo.SetPlayerExportArchive(export.ID, archive)
+	if err != nil {
+		log.ErrS(err, export.ID)
+		sendToRetryQueue(message)
+		return
+	}
+
+	message.Ack()
+}
+
+type playerExportGame struct {
+	AppID               int     `json:"app_id"`
+	Name                string  `json:"name"`
+	PlaytimeMinutes     int     `json:"playtime_minutes"`
+	AchievementsHave    int     `json:"achievements_have"`
+	AchievementsTotal   int     `json:"achievements_total"`
+	AchievementsPercent float64 `json:"achievements_percent"`
+	StorePath           string  `json:"store_path"`
+}
+
+type playerExportAchievement struct {
+	AppID       int     `json:"app_id"`
+	AppName     string  `json:"app_name"`
+	ID          string  `json:"achievement_id"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	UnlockedAt  string  `json:"unlocked_at"` // RFC 3339
+	Completion  float64 `json:"global_completion_percent"`
+}
+
+type playerExportWishlistApp struct {
+	AppID       int    `json:"app_id"`
+	Name        string `json:"name"`
+	Order       int    `json:"order"`
+	ReleaseDate string `json:"release_date"`
+	Currency    string `json:"currency"`
+	Price       int    `json:"price"` // Cents
+	Discount    int    `json:"discount_percent"`
+}
+
+type playerExportBadge struct {
+	BadgeID     int    `json:"badge_id"`
+	AppID       int    `json:"app_id"`
+	Name        string `json:"name"`
+	Level       int    `json:"level"`
+	XP          int    `json:"xp"`
+	Foil        bool   `json:"foil"`
+	Scarcity    int    `json:"scarcity"`
+	CompletedAt string `json:"completed_at"` // RFC 3339
+}
+
+// The Steam Web API formats, for tools that already read them
+type steamExportOwnedGames struct {
+	Response struct {
+		GameCount int                    `json:"game_count"`
+		Games     []steamExportOwnedGame `json:"games"`
+	} `json:"response"`
+}
+
+type steamExportOwnedGame struct {
+	AppID           int    `json:"appid"`
+	Name            string `json:"name"`
+	PlaytimeForever int    `json:"playtime_forever"`
+}
+
+type steamExportPlayerStats struct {
+	PlayerStats struct {
+		SteamID      string                   `json:"steamID"`
+		GameName     string                   `json:"gameName"`
+		AppID        int                      `json:"appid"` // Not in the Steam response, which is per app
+		Achievements []steamExportAchievement `json:"achievements"`
+		Success      bool                     `json:"success"`
+	} `json:"playerstats"`
+}
+
+type steamExportAchievement struct {
+	APIName     string `json:"apiname"`
+	Achieved    int    `json:"achieved"`
+	UnlockTime  int64  `json:"unlocktime"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type steamExportBadges struct {
+	Response struct {
+		Badges []steamExportBadge `json:"badges"`
+	} `json:"response"`
+}
+
+type steamExportBadge struct {
+	BadgeID        int   `json:"badgeid"`
+	AppID          int   `json:"appid,omitempty"`
+	Level          int   `json:"level"`
+	CompletionTime int64 `json:"completion_time"`
+	XP             int   `json:"xp"`
+	Scarcity       int   `json:"scarcity"`
+	BorderColor    int   `json:"border_color,omitempty"` // 1 for foil
+}
+
+type steamExportWishlistApp struct {
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+}
+
+// Zero times are left empty, rather than the year 1
+func formatExportTime(t time.Time) string {
+
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func unixExportTime(t time.Time) int64 {
+
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// Zip of games, achievements, wishlist & badges, each as CSV and JSON, and in the Steam Web API formats
+func buildPlayerExport(playerID int64, cc steamapi.ProductCC) (archive []byte, err error) {
+
+	// Games
+	playerApps, err := mongo.GetPlayerAppsByPlayer(playerID, 0, 0, bson.D{{"app_time", -1}}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var games = []playerExportGame{}
+	var steamGames = steamExportOwnedGames{}
+	steamGames.Response.Games = []steamExportOwnedGame{}
+	var gamesRows = [][]string{{"App ID", "Name", "Playtime (Minutes)", "Achievements", "Achievements Total", "Achievements Percent"}}
+
+	for _, v := range playerApps {
+
+		games = append(games, playerExportGame{
+			AppID:               v.AppID,
+			Name:                v.AppName,
+			PlaytimeMinutes:     v.AppTime,
+			AchievementsHave:    v.AppAchievementsHave,
+			AchievementsTotal:   v.AppAchievementsTotal,
+			AchievementsPercent: v.AppAchievementsPercent,
+			StorePath:           v.GetPath(),
+		})
+
+		gamesRows = append(gamesRows, []string{
+			strconv.Itoa(v.AppID),
+			v.AppName,
+			strconv.Itoa(v.AppTime),
+			strconv.Itoa(v.AppAchievementsHave),
+			strconv.Itoa(v.AppAchievementsTotal),
+			strconv.FormatFloat(v.AppAchievementsPercent, 'f', 2, 64),
+		})
+
+		steamGames.Response.Games = append(steamGames.Response.Games, steamExportOwnedGame{
+			AppID:           v.AppID,
+			Name:            v.AppName,
+			PlaytimeForever: v.AppTime,
+		})
+	}
+
+	steamGames.Response.GameCount = len(steamGames.Response.Games)
+
+	// Achievements
+	playerAchievements, err := mongo.GetAllPlayerAchievementsByPlayer(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var achievements = []playerExportAchievement{}
+	var steamAchievements = []steamExportPlayerStats{}
+	var steamAchievementsIndex = map[int]int{}
+	var achievementsRows = [][]string{{"App ID", "App Name", "Achievement ID", "Name", "Description", "Unlocked At", "Global Completion Percent"}}
+
+	for _, v := range playerAchievements {
+
+		var unlocked string
+		if v.AchievementDate > 0 {
+			unlocked = formatExportTime(time.Unix(v.AchievementDate, 0))
+		}
+
+		achievements = append(achievements, playerExportAchievement{
+			AppID:       v.AppID,
+			AppName:     v.AppName,
+			ID:          v.AchievementID,
+			Name:        v.AchievementName,
+			Description: v.AchievementDescription,
+			UnlockedAt:  unlocked,
+			Completion:  v.AchievementComplete,
+		})
+
+		achievementsRows = append(achievementsRows, []string{
+			strconv.Itoa(v.AppID),
+			v.AppName,
+			v.AchievementID,
+			v.AchievementName,
+			v.AchievementDescription,
+			unlocked,
+			strconv.FormatFloat(v.AchievementComplete, 'f', 2, 64),
+		})
+
+		// One per app, like calling GetPlayerAchievements for each game
+		if _, ok := steamAchievementsIndex[v.AppID]; !ok {
+
+			stats := steamExportPlayerStats{}
+			stats.PlayerStats.SteamID = strconv.FormatInt(playerID, 10)
+			stats.PlayerStats.GameName = v.AppName
+			stats.PlayerStats.AppID = v.AppID
+			stats.PlayerStats.Success = true
+
+			steamAchievementsIndex[v.AppID] = len(steamAchievements)
+			steamAchievements = append(steamAchievements, stats)
+		}
+
+		stats := &steamAchievements[steamAchievementsIndex[v.AppID]].PlayerStats
+		stats.Achievements = append(stats.Achievements, steamExportAchievement{
+			APIName:     v.AchievementID,
+			Achieved:    1,
+			UnlockTime:  v.AchievementDate,
+			Name:        v.AchievementName,
+			Description: v.AchievementDescription,
+		})
+	}
+
+	// Wishlist, with the latest prices from the apps
+	wishlistApps, err := mongo.GetPlayerWishlistAppsByPlayer(playerID, 0, 0, bson.D{{"order", 1}}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var appIDs []int
+	for _, v := range wishlistApps {
+		appIDs = append(appIDs, v.AppID)
+	}
+
+	apps, err := mongo.GetAppsByID(appIDs, bson.M{"_id": 1, "prices": 1})
+	if err != nil {
+		return nil, err
+	}
+
+	var prices = map[int]helpers.ProductPrice{}
+	for _, app := range apps {
+		prices[app.ID] = app.GetPrices().Get(cc)
+	}
+
+	var wishlist = []playerExportWishlistApp{}
+	var steamWishlist = map[string]steamExportWishlistApp{}
+	var wishlistRows = [][]string{{"App ID", "Name", "Order", "Release Date", "Currency", "Price", "Discount Percent"}}
+
+	for _, v := range wishlistApps {
+
+		price := prices[v.AppID]
+
+		wishlist = append(wishlist, playerExportWishlistApp{
+			AppID:       v.AppID,
+			Name:        v.GetName(),
+			Order:       v.Order,
+			ReleaseDate: v.GetReleaseDateNice(),
+			Currency:    string(price.Currency),
+			Price:       price.Final,
+			Discount:    price.DiscountPercent,
+		})
+
+		wishlistRows = append(wishlistRows, []string{
+			strconv.Itoa(v.AppID),
+			v.GetName(),
+			strconv.Itoa(v.Order),
+			v.GetReleaseDateNice(),
+			string(price.Currency),
+			strconv.Itoa(price.Final),
+			strconv.Itoa(price.DiscountPercent),
+		})
+
+		steamWishlist[strconv.Itoa(v.AppID)] = steamExportWishlistApp{
+			Name:     v.GetName(),
+			Priority: v.Order,
+		}
+	}
+
+	// Badges
+	playerBadges, err := mongo.GetAllPlayerBadgesByPlayer(playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var badges = []playerExportBadge{}
+	var steamBadges = steamExportBadges{}
+	steamBadges.Response.Badges = []steamExportBadge{}
+	var badgesRows = [][]string{{"Badge ID", "App ID", "Name", "Level", "XP", "Foil", "Scarcity", "Completed At"}}
+
+	for _, v := range playerBadges {
+
+		completed := formatExportTime(v.BadgeCompletionTime)
+
+		badges = append(badges, playerExportBadge{
+			BadgeID:     v.BadgeID,
+			AppID:       v.AppID,
+			Name:        v.GetName(),
+			Level:       v.BadgeLevel,
+			XP:          v.BadgeXP,
+			Foil:        v.BadgeFoil,
+			Scarcity:    v.BadgeScarcity,
+			CompletedAt: completed,
+		})
+
+		badgesRows = append(badgesRows, []string{
+			strconv.Itoa(v.BadgeID),
+			strconv.Itoa(v.AppID),
+			v.GetName(),
+			strconv.Itoa(v.BadgeLevel),
+			strconv.Itoa(v.BadgeXP),
+			strconv.FormatBool(v.BadgeFoil),
+			strconv.Itoa(v.BadgeScarcity),
+			completed,
+		})
+
+		badge := steamExportBadge{
+			BadgeID:        v.BadgeID,
+			AppID:          v.AppID,
+			Level:          v.BadgeLevel,
+			CompletionTime: unixExportTime(v.BadgeCompletionTime),
+			XP:             v.BadgeXP,
+			Scarcity:       v.BadgeScarcity,
+		}
+		if v.BadgeFoil {
+			badge.BorderColor = 1
+		}
+
+		steamBadges.Response.Badges = append(steamBadges.Response.Badges, badge)
+	}
+
+	// Zip
+	var buf = new(bytes.Buffer)
+	var w = zip.NewWriter(buf)
+
+	var files = []struct {
+		name string
+		rows [][]string
+		data interface{}
+	}{
+		{"games", gamesRows, games},
+		{"achievements", achievementsRows, achievements},
+		{"wishlist", wishlistRows, wishlist},
+		{"badges", badgesRows, badges},
+	}
+
+	for _, file := range files {
+
+		f, err := w.Create(file.name + ".csv")
+		if err != nil {
+			return nil, err
+		}
+
+		err = csv.NewWriter(f).WriteAll(file.rows)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err = w.Create(file.name + ".json")
+		if err != nil {
+			return nil, err
+		}
+
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+
+		err = enc.Encode(file.data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var steamFiles = []struct {
+		name string
+		data interface{}
+	}{
+		{"owned_games", steamGames},
+		{"achievements", steamAchievements},
+		{"wishlist", steamWishlist},
+		{"badges", steamBadges},
+	}
+
+	for _, file := range steamFiles {
+
+		f, err := w.Create("steam/" + file.name + ".json")
+		if err != nil {
+			return nil, err
+		}
+
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+
+		err = enc.Encode(file.data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
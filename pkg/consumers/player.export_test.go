@@ -0,0 +1,91 @@
+package consumers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+func TestBuildPlayerExport(t *testing.T) {
+
+	defer setupTest(t)()
+
+	var completed = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	err := testMongo.Insert(mongo.CollectionPlayerBadges,
+		mongo.PlayerBadge{PlayerID: 76561197960287930, AppID: 440, BadgeID: 1, BadgeLevel: 1, BadgeCompletionTime: completed},
+		mongo.PlayerBadge{PlayerID: 76561197960287930, BadgeID: 2, BadgeLevel: 5, BadgeFoil: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := buildPlayerExport(76561197960287930, steamapi.ProductCCUS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files = map[string]*zip.File{}
+	for _, f := range r.File {
+		files[f.Name] = f
+	}
+
+	for _, name := range []string{"games.csv", "games.json", "badges.csv", "steam/owned_games.json", "steam/achievements.json", "steam/wishlist.json", "steam/badges.json"} {
+		if _, ok := files[name]; !ok {
+			t.Error("missing", name)
+		}
+	}
+
+	// CSV
+	f, err := files["badges.csv"].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var times = map[string]string{}
+	for _, row := range rows[1:] {
+		times[row[0]] = row[7]
+	}
+
+	if times["1"] != "2020-01-02T03:04:05Z" || times["2"] != "" {
+		t.Error("wrong completion times", times)
+	}
+
+	// Steam format
+	f, err = files["steam/badges.json"].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var badges steamExportBadges
+	err = json.NewDecoder(f).Decode(&badges)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(badges.Response.Badges) != 2 {
+		t.Fatal("expected 2 badges, got", len(badges.Response.Badges))
+	}
+
+	for _, badge := range badges.Response.Badges {
+		if (badge.BadgeID == 1 && badge.CompletionTime != completed.Unix()) || (badge.BadgeID == 2 && (badge.CompletionTime != 0 || badge.BorderColor != 1)) {
+			t.Error("wrong badge", badge)
+		}
+	}
+}
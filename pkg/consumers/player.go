@@ -42,7 +42,7 @@ func playerHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -78,7 +78,7 @@ func playerHandler(message *rabbit.Message) {
 
 		log.ErrS(err, payload.ID)
 		if err == steamid.ErrInvalidPlayerID {
-			sendToFailQueue(message)
+			sendToFailQueue(message, err)
 		} else {
 			sendToRetryQueue(message)
 		}
@@ -119,6 +119,9 @@ func playerHandler(message *rabbit.Message) {
 	//
 	var wg sync.WaitGroup
 
+	// Profile activity, diffed against the player before this update
+	var history []mongo.PlayerHistory
+
 	// Calls to api.steampowered.com
 	wg.Add(1)
 	go func() {
@@ -144,13 +147,18 @@ func playerHandler(message *rabbit.Message) {
 			return
 		}
 
-		err = updatePlayerFriends(&player)
+		var friendEvents []mongo.PlayerHistory
+		friendEvents, err = updatePlayerFriends(&player)
 		if err != nil {
 			steam.LogSteamError(err, zap.Int64("player id", payload.ID))
 			sendToRetryQueue(message)
 			return
 		}
 
+		if !newPlayer {
+			history = append(history, friendEvents...)
+		}
+
 		err = updatePlayerLevel(&player)
 		if err != nil {
 			steam.LogSteamError(err, zap.Int64("player id", payload.ID))
@@ -192,7 +200,20 @@ func playerHandler(message *rabbit.Message) {
 
 		defer wg.Done()
 
-		apps, err := mongo.GetPlayerWishlistAppsByPlayer(player.ID, 0, 0, nil, bson.M{"app_prices": 1})
+		wishlistApps, err := mongo.GetPlayerWishlistAppsByPlayer(player.ID, 0, 0, nil, bson.M{"app_id": 1})
+		if err != nil {
+			log.ErrS(err, payload.ID)
+			sendToRetryQueue(message)
+			return
+		}
+
+		var appIDs []int
+		for _, app := range wishlistApps {
+			appIDs = append(appIDs, app.AppID)
+		}
+
+		// Use the current prices, the wishlist rows have the price from when the app was added
+		apps, err := mongo.GetAppsByID(appIDs, bson.M{"prices": 1})
 		if err != nil {
 			log.ErrS(err, payload.ID)
 			sendToRetryQueue(message)
@@ -202,7 +223,7 @@ func playerHandler(message *rabbit.Message) {
 		var total = map[steamapi.ProductCC]int{}
 		for _, app := range apps {
 
-			for code, price := range app.AppPrices {
+			for code, price := range app.Prices.Map() {
 				total[code] += price
 			}
 		}
@@ -356,6 +377,13 @@ func playerHandler(message *rabbit.Message) {
 		},
 	}
 
+	if !newPlayer {
+		history = append(history, mongo.DiffPlayers(playerBeforeUpdate, player)...)
+		if len(history) > 0 {
+			produces = append(produces, PlayerHistoryMessage{PlayerID: player.ID, Events: history})
+		}
+	}
+
 	for _, v := range produces {
 		err = produce(v.Queue(), v)
 		if err != nil {
@@ -369,6 +397,12 @@ func playerHandler(message *rabbit.Message) {
 		return
 	}
 
+	if !newPlayer {
+		checkBanWatches(playerBeforeUpdate, player)
+	}
+
+	triggerWebhooks(mysql.WebhookEventPlayerUpdated, WebhookPlayerPayload{PlayerID: player.ID, Name: player.GetName()})
+
 	//
 	message.Ack()
 }
@@ -481,12 +515,12 @@ func updatePlayerRecentGames(player *mongo.Player, payload PlayerMessage) error
 	return nil
 }
 
-func updatePlayerFriends(player *mongo.Player) error {
+func updatePlayerFriends(player *mongo.Player) (events []mongo.PlayerHistory, err error) {
 
 	newFriendsSlice, err := steam.GetSteam().GetFriendList(player.ID)
 	err = steam.AllowSteamCodes(err, 401, 404)
 	if err != nil {
-		return err
+		return events, err
 	}
 
 	//
@@ -495,7 +529,12 @@ func updatePlayerFriends(player *mongo.Player) error {
 	// Get data
 	oldFriendsSlice, err := mongo.GetFriends(player.ID, 0, 0, nil, nil)
 	if err != nil {
-		return err
+		return events, err
+	}
+
+	oldFriendsMap := map[int64]mongo.PlayerFriend{}
+	for _, friend := range oldFriendsSlice {
+		oldFriendsMap[friend.FriendID] = friend
 	}
 
 	newFriendsMap := map[int64]steamapi.Friend{}
@@ -518,9 +557,11 @@ func updatePlayerFriends(player *mongo.Player) error {
 
 	// Friends to remove
 	var friendsToRem []int64
+	var friendsRemoved []mongo.PlayerFriend
 	for _, v := range oldFriendsSlice {
 		if _, ok := newFriendsMap[v.FriendID]; !ok {
 			friendsToRem = append(friendsToRem, v.FriendID)
+			friendsRemoved = append(friendsRemoved, v)
 		}
 	}
 
@@ -533,7 +574,7 @@ func updatePlayerFriends(player *mongo.Player) error {
 		"level":        1,
 	})
 	if err != nil {
-		return err
+		return events, err
 	}
 
 	for _, friend := range friendRows {
@@ -549,15 +590,52 @@ func updatePlayerFriends(player *mongo.Player) error {
 	// Update DB
 	err = mongo.DeleteFriends(player.ID, friendsToRem)
 	if err != nil {
-		return err
+		return events, err
 	}
 
 	var friendsToAddSlice []*mongo.PlayerFriend
+	var friendsAdded []mongo.PlayerFriend
 	for _, v := range friendsToAdd {
 		friendsToAddSlice = append(friendsToAddSlice, v)
+		if _, ok := oldFriendsMap[v.FriendID]; !ok {
+			friendsAdded = append(friendsAdded, *v)
+		}
+	}
+
+	err = mongo.ReplacePlayerFriends(friendsToAddSlice)
+	if err != nil {
+		return events, err
+	}
+
+	// History
+	var now = time.Now()
+
+	if len(friendsAdded) <= mongo.PlayerHistoryMaxItems {
+		for _, v := range friendsAdded {
+			events = append(events, playerFriendHistory(mongo.PlayerHistoryFriendAdded, v, now))
+		}
 	}
 
-	return mongo.ReplacePlayerFriends(friendsToAddSlice)
+	if len(friendsRemoved) <= mongo.PlayerHistoryMaxItems {
+		for _, v := range friendsRemoved {
+			events = append(events, playerFriendHistory(mongo.PlayerHistoryFriendRemoved, v, now))
+		}
+	}
+
+	return events, nil
+}
+
+func playerFriendHistory(eventType mongo.PlayerHistoryType, friend mongo.PlayerFriend, now time.Time) mongo.PlayerHistory {
+
+	return mongo.PlayerHistory{
+		PlayerID:  friend.PlayerID,
+		Type:      eventType,
+		ItemID:    friend.FriendID,
+		ItemName:  friend.GetName(),
+		ItemIcon:  friend.GetAvatar(),
+		ItemPath:  friend.GetPath(),
+		CreatedAt: now,
+	}
 }
 
 func updatePlayerLevel(player *mongo.Player) error {
@@ -649,6 +727,10 @@ func savePlayerToInflux(player mongo.Player) (err error) {
 		// Others stored in sub queues
 	}
 
+	for code, total := range player.WishlistTotalCost {
+		fields[schemas.InfPlayersWishlistTotal(code)] = total
+	}
+
 	return savePlayerStatsToInflux(player.ID, fields)
 }
 
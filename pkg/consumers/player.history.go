@@ -0,0 +1,87 @@
+package consumers
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"github.com/gamedb/gamedb/pkg/oauth"
+	"go.uber.org/zap"
+)
+
+type PlayerHistoryMessage struct {
+	PlayerID int64                 `json:"player_id"`
+	Events   []mongo.PlayerHistory `json:"events"`
+}
+
+func (m PlayerHistoryMessage) Queue() rabbit.QueueName {
+	return QueuePlayersHistory
+}
+
+// Helper used in the player consumers
+func producePlayerHistory(playerID int64, events []mongo.PlayerHistory) error {
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return produce(QueuePlayersHistory, PlayerHistoryMessage{PlayerID: playerID, Events: events})
+}
+
+func playerHistoryHandler(message *rabbit.Message) {
+
+	payload := PlayerHistoryMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	// Websocket
+	defer sendPlayerWebsocket(payload.PlayerID, "history", message)
+
+	if len(payload.Events) == 0 {
+		message.Ack()
+		return
+	}
+
+	// Events have their own keys, so retries do not make duplicates
+	err = mongo.ReplacePlayerHistory(payload.Events)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToRetryQueue(message)
+		return
+	}
+
+	// Discord
+	user, err := mysql.GetUserByProviderID(oauth.ProviderSteam, strconv.FormatInt(payload.PlayerID, 10))
+	if err == nil && user.DiscordHistory {
+
+		var lines []string
+		for _, event := range payload.Events {
+			lines = append(lines, "• "+event.GetText())
+		}
+
+		notifyUser(user, userNotification{
+			Discord: &discordgo.MessageEmbed{
+				Title:       "Profile Activity",
+				Description: strings.Join(lines, "\n"),
+			},
+			Path: helpers.GetPlayerPath(payload.PlayerID, "") + "#activity",
+		})
+	}
+
+	err = helpers.IgnoreErrors(err, mysql.ErrRecordNotFound)
+	if err != nil {
+		log.ErrS(err, payload.PlayerID)
+	}
+
+	message.Ack()
+}
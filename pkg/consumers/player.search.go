@@ -28,7 +28,7 @@ func appsPlayersHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err("decode failed", zap.Error(err), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -52,7 +52,7 @@ func appsPlayersHandler(message *rabbit.Message) {
 
 	} else {
 
-		sendToFailQueue(message)
+		sendToFailQueue(message, errInvalidPayload)
 		return
 	}
 
@@ -86,7 +86,7 @@ func appsPlayersHandler(message *rabbit.Message) {
 	aliases, err := mongo.GetPlayerAliases(mongoPlayer.ID, 5, sixMonthsAgo)
 	if err != nil {
 		log.Err("retrieve aliases", zap.Error(err), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
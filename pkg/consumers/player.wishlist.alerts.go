@@ -0,0 +1,295 @@
+package consumers
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/email"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
+)
+
+const (
+	websocketQueueWishlistAlert = "wishlist-alert"
+	wishlistDigestMaxSales      = 20
+)
+
+type WishlistAlertMessage struct {
+	UserID          int                   `json:"user_id"`
+	AppID           int                   `json:"app_id"`
+	AppName         string                `json:"app_name"`
+	ProductCC       steamapi.ProductCC    `json:"prod_cc"`
+	Currency        steamapi.CurrencyCode `json:"currency"`
+	PriceBefore     int                   `json:"price_before"`
+	PriceAfter      int                   `json:"price_after"`
+	DiscountPercent int                   `json:"discount_percent"`
+	OnSale          bool                  `json:"on_sale"`
+	NewLow          bool                  `json:"new_low"`
+}
+
+func (m WishlistAlertMessage) Queue() rabbit.QueueName {
+	return QueueWishlistAlerts
+}
+
+func (m WishlistAlertMessage) getPath() string {
+	return helpers.GetAppPath(m.AppID, m.AppName) + "#prices"
+}
+
+func (m WishlistAlertMessage) getText() string {
+
+	var name = helpers.GetAppName(m.AppID, m.AppName)
+	var before = i18n.FormatPrice(m.Currency, m.PriceBefore)
+	var after = i18n.FormatPrice(m.Currency, m.PriceAfter)
+
+	if m.NewLow {
+		return name + " is at a new all-time low of " + after + " (was " + before + ")"
+	}
+	return name + " is on sale, " + strconv.Itoa(m.DiscountPercent) + "% off at " + after + " (was " + before + ")"
+}
+
+type WishlistDigestMessage struct {
+	UserID int `json:"user_id"`
+}
+
+func (m WishlistDigestMessage) Queue() rabbit.QueueName {
+	return QueueWishlistDigests
+}
+
+// Must be called before the new price is saved, so it is not counted as the lowest
+func getWishlistAlerts(appID int, appName string, productCC i18n.ProductCountryCode, oldPrice, newPrice, oldDiscount, newDiscount int) (messages []WishlistAlertMessage) {
+
+	// Free games are usually just removed from the store
+	if newPrice >= oldPrice || newPrice <= 0 {
+		return nil
+	}
+
+	playerIDs, err := mongo.GetPlayerIDsWithWishlistApp(appID)
+	if err != nil {
+		log.ErrS(err)
+		return nil
+	}
+
+	if len(playerIDs) == 0 {
+		return nil
+	}
+
+	// Emails are only sent to verified users in the handler, so other channels still work
+	userIDs, err := mysql.GetWishlistAlertUserIDs(productCC.ProductCode, playerIDs)
+	if err != nil {
+		log.ErrS(err)
+		return nil
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	lowest, lowestExists, err := getLowestPrice(helpers.ProductTypeApp, appID, productCC.ProductCode, oldPrice)
+	if err != nil {
+		log.ErrS(err)
+		return nil
+	}
+
+	var onSale = oldDiscount == 0 && newDiscount > 0
+	var newLow = !lowestExists || newPrice < lowest
+
+	if !onSale && !newLow {
+		return nil
+	}
+
+	for _, userID := range userIDs {
+
+		messages = append(messages, WishlistAlertMessage{
+			UserID:          userID,
+			AppID:           appID,
+			AppName:         appName,
+			ProductCC:       productCC.ProductCode,
+			Currency:        productCC.CurrencyCode,
+			PriceBefore:     oldPrice,
+			PriceAfter:      newPrice,
+			DiscountPercent: newDiscount,
+			OnSale:          onSale,
+			NewLow:          newLow,
+		})
+	}
+
+	return messages
+}
+
+// Call after the new price has been saved
+func produceWishlistAlerts(messages []WishlistAlertMessage) {
+
+	for _, message := range messages {
+
+		err := produce(QueueWishlistAlerts, message)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+func wishlistAlertHandler(message *rabbit.Message) {
+
+	payload := WishlistAlertMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	user, err := mysql.GetUserByID(payload.UserID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	// The user may have opted out since this was queued
+	if !user.WishlistAlerts {
+		message.Ack()
+		return
+	}
+
+	var name = helpers.GetAppName(payload.AppID, payload.AppName)
+	var text = payload.getText()
+
+	notifyUser(user, userNotification{
+		Subject: "Wishlist Alert: " + name,
+		Email: email.WishlistAlertTemplate{
+			Domain:  config.C.GlobalSteamDomain,
+			AppName: name,
+			Text:    text,
+			Path:    payload.getPath(),
+		},
+		WebsocketQueue: websocketQueueWishlistAlert,
+		WebsocketText:  text,
+		Path:           payload.getPath(),
+	})
+
+	message.Ack()
+}
+
+func wishlistDigestHandler(message *rabbit.Message) {
+
+	payload := WishlistDigestMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	user, err := mysql.GetUserByID(payload.UserID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	if !user.WishlistDigest || !user.EmailVerified {
+		message.Ack()
+		return
+	}
+
+	playerID := mysql.GetUserSteamID(user.ID)
+	if playerID == 0 {
+		message.Ack()
+		return
+	}
+
+	wishlistApps, err := mongo.GetPlayerWishlistAppsByPlayer(playerID, 0, 0, nil, bson.M{"app_id": 1})
+	if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	if len(wishlistApps) == 0 {
+		message.Ack()
+		return
+	}
+
+	var appIDs []int
+	for _, v := range wishlistApps {
+		appIDs = append(appIDs, v.AppID)
+	}
+
+	apps, err := mongo.GetAppsByID(appIDs, bson.M{"_id": 1, "name": 1, "prices": 1})
+	if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	var productCC = i18n.GetProdCC(user.ProductCC)
+
+	stats, err := mongo.GetProductPriceStatsForApps(appIDs, productCC.ProductCode)
+	if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	var total int
+	var sales []email.WishlistDigestSale
+	for _, app := range apps {
+
+		price := app.Prices.Get(productCC.ProductCode)
+		if !price.Exists {
+			continue
+		}
+
+		total += price.Final
+
+		if price.DiscountPercent > 0 {
+
+			stat, ok := stats[app.ID]
+
+			sales = append(sales, email.WishlistDigestSale{
+				Name:     app.GetName(),
+				Path:     app.GetPath(),
+				Price:    i18n.FormatPrice(productCC.CurrencyCode, price.Final),
+				Discount: price.DiscountPercent,
+				Lowest:   ok && stat.AllTimeLow > 0 && price.Final <= stat.AllTimeLow,
+			})
+		}
+	}
+
+	sort.Slice(sales, func(i, j int) bool {
+		return sales[i].Discount > sales[j].Discount
+	})
+
+	if len(sales) > wishlistDigestMaxSales {
+		sales = sales[:wishlistDigestMaxSales]
+	}
+
+	notifyUser(user, userNotification{
+		Subject: "Your Weekly Wishlist",
+		Email: email.WishlistDigestTemplate{
+			Domain: config.C.GlobalSteamDomain,
+			Path:   helpers.GetPlayerPath(playerID, "") + "#wishlist",
+			Count:  len(wishlistApps),
+			Total:  i18n.FormatPrice(productCC.CurrencyCode, total),
+			Sales:  sales,
+		},
+	})
+
+	message.Ack()
+}
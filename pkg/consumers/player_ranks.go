@@ -32,12 +32,12 @@ func playerRanksHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
 	if payload.ObjectKey == "" || payload.SortColumn == "" {
-		sendToFailQueue(message)
+		sendToFailQueue(message, errInvalidPayload)
 		return
 	}
 
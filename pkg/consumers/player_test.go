@@ -0,0 +1,101 @@
+package consumers
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestPlayerHandler(t *testing.T) {
+
+	defer setupTest(t)()
+
+	ack := consume(t, playerHandler, QueuePlayers, PlayerMessage{ID: 76561197960287930})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	var players []mongo.Player
+	err := testMongo.Decode(mongo.CollectionPlayers, bson.D{{"_id", int64(76561197960287930)}}, &players)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(players) != 1 {
+		t.Fatal("expected 1 player, got", len(players))
+	}
+
+	player := players[0]
+
+	if player.PersonaName != "Rabscuttle" || player.CountryCode != "US" {
+		t.Error("summary not saved", player.PersonaName, player.CountryCode)
+	}
+	if player.Level != 10 {
+		t.Error("level not saved", player.Level)
+	}
+	if player.FriendsCount != 2 {
+		t.Error("friends not saved", player.FriendsCount)
+	}
+
+	// Influx
+	var found bool
+	for _, v := range testInflux.Lines() {
+		if strings.HasPrefix(v, "players,") {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("player not written to influx", testInflux.Lines())
+	}
+
+	// Sub queues, no history for new players
+	for _, queue := range []rabbit.QueueName{QueuePlayersGames, QueuePlayersBadges, QueuePlayersGroups, QueueWebsockets} {
+		if len(testChannels[queue].Messages()) != 1 {
+			t.Error("expected a message on", queue)
+		}
+	}
+
+	if len(testChannels[QueuePlayersHistory].Messages()) != 0 {
+		t.Error("history produced for a new player")
+	}
+}
+
+func TestPlayerHandlerHistory(t *testing.T) {
+
+	defer setupTest(t)()
+
+	err := testMongo.Insert(mongo.CollectionPlayers, mongo.Player{ID: 76561197960287930, PersonaName: "Rabscuttle", Level: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ack := consume(t, playerHandler, QueuePlayers, PlayerMessage{ID: 76561197960287930})
+
+	assertAcked(t, ack)
+	assertNoErrors(t)
+
+	messages := testChannels[QueuePlayersHistory].Messages()
+	if len(messages) != 1 {
+		t.Fatal("expected a history message, got", len(messages))
+	}
+
+	payload := PlayerHistoryMessage{}
+	err = json.Unmarshal(messages[0].Body, &payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var levelUp bool
+	for _, v := range payload.Events {
+		if v.Type == mongo.PlayerHistoryLevel && v.Before == "9" && v.After == "10" {
+			levelUp = true
+		}
+	}
+	if !levelUp {
+		t.Error("level up missing from history", payload.Events)
+	}
+}
@@ -0,0 +1,230 @@
+package consumers
+
+import (
+	"github.com/Jleagle/rabbit-go"
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/bwmarrin/discordgo"
+	"github.com/gamedb/gamedb/cmd/frontend/helpers/email"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"go.uber.org/zap"
+)
+
+const websocketQueuePriceAlert = "price-alert"
+
+type PriceAlertMessage struct {
+	AlertID       int                   `json:"alert_id"`
+	UserID        int                   `json:"user_id"`
+	Rule          mysql.PriceAlertRule  `json:"rule"`
+	NotifyEmail   bool                  `json:"notify_email"`
+	NotifyDiscord bool                  `json:"notify_discord"`
+	ProductType   helpers.ProductType   `json:"product_type"`
+	ProductID     int                   `json:"product_id"`
+	ProductName   string                `json:"product_name"`
+	ProductCC     steamapi.ProductCC    `json:"prod_cc"`
+	Currency      steamapi.CurrencyCode `json:"currency"`
+	PriceBefore   int                   `json:"price_before"`
+	PriceAfter    int                   `json:"price_after"`
+}
+
+func (m PriceAlertMessage) Queue() rabbit.QueueName {
+	return QueuePriceAlerts
+}
+
+func (m PriceAlertMessage) getName() string {
+
+	if m.ProductType == helpers.ProductTypePackage {
+		return helpers.GetPackageName(m.ProductID, m.ProductName)
+	}
+	return helpers.GetAppName(m.ProductID, m.ProductName)
+}
+
+func (m PriceAlertMessage) getPath() string {
+
+	if m.ProductType == helpers.ProductTypePackage {
+		return helpers.GetPackagePath(m.ProductID, m.ProductName) + "#prices"
+	}
+	return helpers.GetAppPath(m.ProductID, m.ProductName) + "#prices"
+}
+
+// Must be called before the new price is saved, so it is not counted as the lowest
+func getPriceAlerts(productType helpers.ProductType, productID int, productName string, productCC i18n.ProductCountryCode, oldPrice, newPrice int, lowestPrice *int) (messages []PriceAlertMessage) {
+
+	if newPrice >= oldPrice {
+		return nil
+	}
+
+	alerts, err := mysql.GetPriceAlertsForProduct(productType, productID, productCC.ProductCode)
+	if err != nil {
+		log.ErrS(err)
+		return nil
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	// Only look up the lowest price if we need it
+	var lowest int
+	var lowestExists bool
+
+	if lowestPrice != nil {
+
+		lowest = *lowestPrice
+		lowestExists = true
+
+	} else {
+
+		for _, alert := range alerts {
+			if alert.Rule == mysql.PriceAlertRuleLowest {
+
+				lowest, lowestExists, err = getLowestPrice(productType, productID, productCC.ProductCode, oldPrice)
+				if err != nil {
+					log.ErrS(err)
+					return nil
+				}
+
+				break
+			}
+		}
+	}
+
+	for _, alert := range alerts {
+
+		if !alert.Matches(oldPrice, newPrice, lowest, lowestExists) {
+			continue
+		}
+
+		// Already sent for this price, from a retried message
+		if alert.NotifiedAt != nil && alert.NotifiedPrice == newPrice {
+			continue
+		}
+
+		messages = append(messages, PriceAlertMessage{
+			AlertID:       alert.ID,
+			UserID:        alert.UserID,
+			Rule:          alert.Rule,
+			NotifyEmail:   alert.NotifyEmail,
+			NotifyDiscord: alert.NotifyDiscord,
+			ProductType:   productType,
+			ProductID:     productID,
+			ProductName:   productName,
+			ProductCC:     productCC.ProductCode,
+			Currency:      productCC.CurrencyCode,
+			PriceBefore:   oldPrice,
+			PriceAfter:    newPrice,
+		})
+	}
+
+	return messages
+}
+
+// Call after the new price has been saved
+func producePriceAlerts(messages []PriceAlertMessage) {
+
+	for _, message := range messages {
+
+		err := produce(QueuePriceAlerts, message)
+		if err != nil {
+			log.ErrS(err)
+			continue
+		}
+
+		err = mysql.PriceAlert{ID: message.AlertID}.SetNotified(message.PriceAfter)
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+// The lowest price before the current change
+func getLowestPrice(productType helpers.ProductType, productID int, cc steamapi.ProductCC, oldPrice int) (lowest int, exists bool, err error) {
+
+	stats, err := mongo.GetProductPriceStats(productType, productID, cc)
+	if err == nil {
+
+		lowest = stats.AllTimeLow
+		exists = stats.AllTimeLow > 0
+
+	} else if err == mongo.ErrNoDocuments {
+
+		price, found, err := mongo.GetLowestPrice(productID, productType, cc)
+		if err != nil {
+			return lowest, exists, err
+		}
+
+		lowest = price.PriceAfter
+		exists = found
+
+	} else {
+		return lowest, exists, err
+	}
+
+	// The old price may be lower than anything recorded as a change
+	if !exists || (oldPrice > 0 && oldPrice < lowest) {
+		lowest = oldPrice
+		exists = oldPrice > 0
+	}
+
+	return lowest, exists, nil
+}
+
+func priceAlertHandler(message *rabbit.Message) {
+
+	payload := PriceAlertMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	user, err := mysql.GetUserByID(payload.UserID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack()
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	var name = payload.getName()
+	var before = i18n.FormatPrice(payload.Currency, payload.PriceBefore)
+	var after = i18n.FormatPrice(payload.Currency, payload.PriceAfter)
+
+	var notification = userNotification{
+		Subject:        "Price Alert: " + name,
+		WebsocketQueue: websocketQueuePriceAlert,
+		WebsocketText:  name + " is now " + after + " (was " + before + ")",
+		Path:           payload.getPath(),
+	}
+
+	if payload.NotifyEmail {
+		notification.Email = email.PriceAlertTemplate{
+			Domain:      config.C.GlobalSteamDomain,
+			ProductName: name,
+			Path:        payload.getPath(),
+			Rule:        payload.Rule.String(),
+			PriceBefore: before,
+			PriceAfter:  after,
+		}
+	}
+
+	if payload.NotifyDiscord {
+		notification.Discord = &discordgo.MessageEmbed{
+			Title:       "Price Alert: " + name,
+			Description: name + " has dropped from " + before + " to **" + after + "**",
+			Footer:      &discordgo.MessageEmbedFooter{Text: payload.Rule.String() + " - " + string(payload.ProductCC)},
+		}
+	}
+
+	notifyUser(user, notification)
+
+	message.Ack()
+}
@@ -3,6 +3,8 @@ package consumers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +15,7 @@ import (
 	"github.com/gamedb/gamedb/pkg/i18n"
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
 	"github.com/gamedb/gamedb/pkg/mysql/pics"
 	"github.com/gamedb/gamedb/pkg/websockets"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -187,6 +190,280 @@ func getAppDepotBranches(kv steamvdf.KeyValue) (branches []pics.AppDepotBranches
 	return branches
 }
 
+// Records new branch builds and depot manifests
+func saveAppDepotHistory(before mongo.App, after mongo.App) (err error) {
+
+	if after.ChangeNumber <= before.ChangeNumber {
+		return nil
+	}
+
+	var histories []mongo.AppDepotHistory
+	var now = time.Now()
+
+	var history = func(t mongo.DepotHistoryType, branch string, oldValue string, newValue string) mongo.AppDepotHistory {
+		return mongo.AppDepotHistory{
+			AppID:        after.ID,
+			AppName:      after.Name,
+			ChangeNumber: after.ChangeNumber,
+			CreatedAt:    now,
+			Type:         t,
+			Branch:       branch,
+			Before:       oldValue,
+			After:        newValue,
+		}
+	}
+
+	// Branches
+	var oldBuilds = map[string]int{}
+	for _, v := range before.Depots.Branches {
+		oldBuilds[v.Name] = v.BuildID
+	}
+
+	for _, branch := range after.Depots.Branches {
+
+		if branch.BuildID == 0 || oldBuilds[branch.Name] == branch.BuildID {
+			continue
+		}
+
+		var oldBuild string
+		if oldBuilds[branch.Name] > 0 {
+			oldBuild = strconv.Itoa(oldBuilds[branch.Name])
+		}
+
+		h := history(mongo.DepotHistoryTypeBuild, branch.Name, oldBuild, strconv.Itoa(branch.BuildID))
+		h.TimeUpdated = branch.TimeUpdated
+
+		histories = append(histories, h)
+	}
+
+	// Manifests
+	var oldManifests = map[int]map[string]string{}
+	for _, v := range before.Depots.Depots {
+		oldManifests[v.ID] = v.GetManifestIDs()
+	}
+
+	for _, depot := range after.Depots.Depots {
+		for branch, manifest := range depot.GetManifestIDs() {
+
+			var oldManifest = oldManifests[depot.ID][branch]
+			if oldManifest == manifest {
+				continue
+			}
+
+			h := history(mongo.DepotHistoryTypeManifest, branch, oldManifest, manifest)
+			h.DepotID = depot.ID
+			h.DepotName = depot.Name
+
+			histories = append(histories, h)
+		}
+	}
+
+	return mongo.SaveAppDepotHistories(histories)
+}
+
+// Records the PICS keys that changed since the last change number
+func saveAppPICSHistory(before mongo.App, after mongo.App) (err error) {
+
+	// Everything would show as added the first time an app is seen
+	if before.ChangeNumber == 0 || after.ChangeNumber <= before.ChangeNumber {
+		return nil
+	}
+
+	var histories []mongo.ProductPICSHistory
+	var now = time.Now()
+
+	var fields = []struct {
+		field  string
+		before pics.PICSKeyValues
+		after  pics.PICSKeyValues
+	}{
+		{mysql.ProductKeyFieldCommon, before.Common, after.Common},
+		{mysql.ProductKeyFieldExtended, before.Extended, after.Extended},
+		{mysql.ProductKeyFieldConfig, before.Config, after.Config},
+		{mysql.ProductKeyFieldUFS, before.UFS, after.UFS},
+		{mongo.PICSHistoryFieldDepots, getDepotsPICSKeyValues(before.Depots), getDepotsPICSKeyValues(after.Depots)},
+		{mongo.PICSHistoryFieldLaunch, getLaunchPICSKeyValues(before.Launch), getLaunchPICSKeyValues(after.Launch)},
+	}
+
+	for _, field := range fields {
+		for _, diff := range field.before.Diff(field.after) {
+			histories = append(histories, mongo.ProductPICSHistory{
+				AppID:        after.ID,
+				Name:         after.Name,
+				ChangeNumber: after.ChangeNumber,
+				CreatedAt:    now,
+				Field:        field.field,
+				Key:          diff.Key,
+				Action:       diff.Action,
+				Before:       diff.Before,
+				After:        diff.After,
+			})
+		}
+	}
+
+	return mongo.SaveProductPICSHistories(histories)
+}
+
+// Records the PICS keys that changed since the last change number
+func savePackagePICSHistory(before mongo.Package, after mongo.Package) (err error) {
+
+	// Everything would show as added the first time a package is seen
+	if before.ChangeNumber == 0 || after.ChangeNumber <= before.ChangeNumber {
+		return nil
+	}
+
+	var histories []mongo.ProductPICSHistory
+	var now = time.Now()
+
+	var fields = []struct {
+		field  string
+		before pics.PICSKeyValues
+		after  pics.PICSKeyValues
+	}{
+		{mongo.PICSHistoryFieldPackage, getPackagePICSKeyValues(before), getPackagePICSKeyValues(after)},
+		{mysql.ProductKeyFieldExtended, before.Extended, after.Extended},
+	}
+
+	for _, field := range fields {
+		for _, diff := range field.before.Diff(field.after) {
+			histories = append(histories, mongo.ProductPICSHistory{
+				PackageID:    after.ID,
+				Name:         after.Name,
+				ChangeNumber: after.ChangeNumber,
+				CreatedAt:    now,
+				Field:        field.field,
+				Key:          diff.Key,
+				Action:       diff.Action,
+				Before:       diff.Before,
+				After:        diff.After,
+			})
+		}
+	}
+
+	return mongo.SaveProductPICSHistories(histories)
+}
+
+// The top level package keys, named as they are in PICS
+func getPackagePICSKeyValues(pack mongo.Package) pics.PICSKeyValues {
+
+	var kv = pics.PICSKeyValues{}
+
+	if pack.BillingType > 0 {
+		kv["billingtype"] = strconv.Itoa(int(pack.BillingType))
+	}
+	if pack.LicenseType > 0 {
+		kv["licensetype"] = strconv.Itoa(int(pack.LicenseType))
+	}
+	if pack.Status > 0 {
+		kv["status"] = strconv.Itoa(int(pack.Status))
+	}
+	if len(pack.Apps) > 0 {
+		kv["appids"] = helpers.JoinInts(pack.Apps, ",")
+	}
+	if len(pack.Depots) > 0 {
+		kv["depotids"] = helpers.JoinInts(pack.Depots, ",")
+	}
+	if len(pack.AppItems) > 0 {
+
+		var items []string
+		for k, v := range pack.AppItems {
+			items = append(items, strconv.Itoa(k)+":"+strconv.Itoa(v))
+		}
+
+		sort.Strings(items)
+
+		kv["appitems"] = strings.Join(items, ",")
+	}
+
+	return kv
+}
+
+// Depots flattened to keys, manifests use the ID so changes to their metadata don't show
+func getDepotsPICSKeyValues(depots pics.Depots) pics.PICSKeyValues {
+
+	var kv = pics.PICSKeyValues{}
+
+	for k, v := range depots.Extra {
+		kv[k] = v
+	}
+
+	for _, depot := range depots.Depots {
+
+		var prefix = strconv.Itoa(depot.ID) + "."
+
+		if depot.Name != "" {
+			kv[prefix+"name"] = depot.Name
+		}
+		if depot.MaxSize > 0 {
+			kv[prefix+"maxsize"] = strconv.FormatUint(depot.MaxSize, 10)
+		}
+		if depot.App > 0 {
+			kv[prefix+"depotfromapp"] = strconv.Itoa(depot.App)
+		}
+		if depot.DLCApp > 0 {
+			kv[prefix+"dlcappid"] = strconv.Itoa(depot.DLCApp)
+		}
+		if depot.EncryptedManifests != "" {
+			kv[prefix+"encryptedmanifests"] = depot.EncryptedManifests
+		}
+		for k, v := range depot.Configs {
+			kv[prefix+"config."+k] = v
+		}
+		for branch, manifest := range depot.GetManifestIDs() {
+			kv[prefix+"manifests."+branch] = manifest
+		}
+	}
+
+	for _, branch := range depots.Branches {
+
+		var prefix = "branches." + branch.Name + "."
+
+		if branch.BuildID > 0 {
+			kv[prefix+"buildid"] = strconv.Itoa(branch.BuildID)
+		}
+		if branch.Description != "" {
+			kv[prefix+"description"] = branch.Description
+		}
+		if branch.PasswordRequired {
+			kv[prefix+"pwdrequired"] = "1"
+		}
+	}
+
+	return kv
+}
+
+// Launch options flattened to keys, named as they are in PICS
+func getLaunchPICSKeyValues(items []pics.PICSAppConfigLaunchItem) pics.PICSKeyValues {
+
+	var kv = pics.PICSKeyValues{}
+
+	for _, item := range items {
+
+		var prefix = fmt.Sprint(item.Order) + "."
+
+		var values = map[string]string{
+			"executable":  item.Executable,
+			"arguments":   item.Arguments,
+			"description": item.Description,
+			"type":        item.Typex,
+			"oslist":      item.OSList,
+			"osarch":      item.OSArch,
+			"betakey":     item.BetaKey,
+			"workingdir":  item.WorkingDir,
+			"vrmode":      item.VRMode,
+			"ownsdlc":     strings.Join(item.OwnsDLCs, ","),
+		}
+
+		for k, v := range values {
+			if v != "" {
+				kv[prefix+k] = v
+			}
+		}
+	}
+
+	return kv
+}
+
 func getAppLaunch(kv steamvdf.KeyValue) (items []pics.PICSAppConfigLaunchItem) {
 
 	for _, v := range kv.Children {
@@ -252,10 +529,13 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 	var prices helpers.ProductPrices
 	var price helpers.ProductPrice
 	var documents []mongo.Document
+	var alerts []PriceAlertMessage
+	var wishlistAlerts []WishlistAlertMessage
 
 	for _, productCC := range i18n.GetProdCCs(true) {
 
 		var oldPrice, newPrice int
+		var oldDiscount, newDiscount int
 
 		// Before price
 		prices = before.GetPrices()
@@ -265,6 +545,7 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 		}
 
 		oldPrice = price.Final
+		oldDiscount = price.DiscountPercent
 
 		// After price
 		prices = after.GetPrices()
@@ -274,6 +555,7 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 		}
 
 		newPrice = price.Final
+		newDiscount = price.DiscountPercent
 
 		//
 		if oldPrice != newPrice {
@@ -303,6 +585,34 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 			}
 
 			documents = append(documents, price)
+
+			// Find alerts before the new price is saved, and send them after
+			alerts = append(alerts, getPriceAlerts(after.GetProductType(), after.GetID(), after.GetName(), productCC, oldPrice, newPrice, nil)...)
+
+			if after.GetProductType() == helpers.ProductTypeApp {
+
+				wishlistAlerts = append(wishlistAlerts, getWishlistAlerts(after.GetID(), after.GetName(), productCC, oldPrice, newPrice, oldDiscount, newDiscount)...)
+
+				triggerWebhooks(mysql.WebhookEventAppPrice, WebhookAppPricePayload{
+					AppID:             after.GetID(),
+					Name:              after.GetName(),
+					ProductCC:         string(productCC.ProductCode),
+					Currency:          string(productCC.CurrencyCode),
+					PriceBefore:       oldPrice,
+					PriceAfter:        newPrice,
+					DifferencePercent: price.DifferencePercent,
+				})
+
+				// Free games are usually just removed from the store
+				if newPrice < oldPrice && newPrice > 0 {
+
+					description := i18n.FormatPrice(productCC.CurrencyCode, oldPrice) + " → " + i18n.FormatPrice(productCC.CurrencyCode, newPrice) +
+						" (" + helpers.FloatToString(price.DifferencePercent, 0) + "%)"
+
+					embed := chatBotAppEmbed(after.GetID(), after.GetName(), "Price Drop: "+after.GetName(), description)
+					ProduceChatBotFeeds(mysql.ChatBotFeedPrices, after.GetID(), productCC.ProductCode, embed)
+				}
+			}
 		}
 
 		// Tweet / Post to Reddit
@@ -344,6 +654,10 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 	}
 
 	result, err := mongo.InsertMany(mongo.CollectionProductPrices, documents)
+	if err == nil {
+		producePriceAlerts(alerts)
+		produceWishlistAlerts(wishlistAlerts)
+	}
 
 	// Send websockets to prices page
 	if err == nil && result != nil {
@@ -365,5 +679,18 @@ func saveProductPricesToMongo(before helpers.ProductInterface, after helpers.Pro
 			}
 		}
 	}
+
+	// Update historical lows etc
+	if err == nil {
+		for _, v := range documents {
+			if price, ok := v.(mongo.ProductPrice); ok {
+				err2 := mongo.UpdateProductPriceStats(price)
+				if err2 != nil {
+					log.ErrS(err2)
+				}
+			}
+		}
+	}
+
 	return err
 }
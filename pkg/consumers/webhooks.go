@@ -0,0 +1,263 @@
+package consumers
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Jleagle/rabbit-go"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"github.com/satori/go.uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookMaxAttempts = 6
+	webhookTimeout     = time.Second * 10
+
+	HeaderWebhookEvent     = "X-GlobalSteam-Event"
+	HeaderWebhookDelivery  = "X-GlobalSteam-Delivery"
+	HeaderWebhookSignature = "X-GlobalSteam-Signature" // sha256=<hex hmac of the body, keyed with the webhook secret>
+)
+
+type WebhookMessage struct {
+	WebhookID  int                `json:"webhook_id"`
+	DeliveryID string             `json:"delivery_id"`
+	Event      mysql.WebhookEvent `json:"event"`
+	Body       json.RawMessage    `json:"body"` // Built when produced, so retries send the same body
+}
+
+func (m WebhookMessage) Queue() rabbit.QueueName {
+	return QueueWebhooks
+}
+
+// The body sent to users
+type webhookBody struct {
+	ID        string             `json:"id"`
+	Event     mysql.WebhookEvent `json:"event"`
+	CreatedAt int64              `json:"created_at"`
+	Data      interface{}        `json:"data"`
+}
+
+// Payloads say which app or player they are about, to match webhook filters
+type webhookPayload interface {
+	webhookEntity() (appID int, playerID int64)
+}
+
+type WebhookAppPricePayload struct {
+	AppID             int     `json:"app_id"`
+	Name              string  `json:"name"`
+	ProductCC         string  `json:"prod_cc"`
+	Currency          string  `json:"currency"`
+	PriceBefore       int     `json:"price_before"`
+	PriceAfter        int     `json:"price_after"`
+	DifferencePercent float64 `json:"difference_percent"`
+}
+
+func (p WebhookAppPricePayload) webhookEntity() (int, int64) {
+	return p.AppID, 0
+}
+
+type WebhookAppPayload struct {
+	AppID        int    `json:"app_id"`
+	Name         string `json:"name"`
+	ChangeNumber int    `json:"change_number,omitempty"`
+}
+
+func (p WebhookAppPayload) webhookEntity() (int, int64) {
+	return p.AppID, 0
+}
+
+type WebhookPlayerPayload struct {
+	PlayerID int64  `json:"player_id"`
+	Name     string `json:"name"`
+}
+
+func (p WebhookPlayerPayload) webhookEntity() (int, int64) {
+	return 0, p.PlayerID
+}
+
+type WebhookArticlePayload struct {
+	ArticleID int64  `json:"article_id"`
+	AppID     int    `json:"app_id"`
+	AppName   string `json:"app_name"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	Date      int64  `json:"date"`
+}
+
+func (p WebhookArticlePayload) webhookEntity() (int, int64) {
+	return p.AppID, 0
+}
+
+// Queues a delivery for every webhook subscribed to the event, and filtered to its app or player
+func triggerWebhooks(event mysql.WebhookEvent, data webhookPayload) {
+
+	webhooks, err := mysql.GetUserWebhooksForEvent(event)
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	appID, playerID := data.webhookEntity()
+
+	for _, webhook := range webhooks {
+
+		if !webhook.Matches(appID, playerID) {
+			continue
+		}
+
+		var deliveryID = uuid.NewV4().String()
+
+		b, err := json.Marshal(webhookBody{
+			ID:        deliveryID,
+			Event:     event,
+			CreatedAt: time.Now().Unix(),
+			Data:      data,
+		})
+		if err != nil {
+			log.ErrS(err)
+			return
+		}
+
+		err = produce(QueueWebhooks, WebhookMessage{
+			WebhookID:  webhook.ID,
+			DeliveryID: deliveryID,
+			Event:      event,
+			Body:       b,
+		})
+		if err != nil {
+			log.ErrS(err)
+		}
+	}
+}
+
+func webhookHandler(message *rabbit.Message) {
+
+	payload := WebhookMessage{}
+
+	err := helpers.Unmarshal(message.Message.Body, &payload)
+	if err != nil {
+		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
+		sendToFailQueue(message, err)
+		return
+	}
+
+	webhook, err := mysql.GetUserWebhook(payload.WebhookID)
+	if err == mysql.ErrRecordNotFound {
+		message.Ack() // Webhook has been removed
+		return
+	} else if err != nil {
+		log.ErrS(err)
+		sendToRetryQueue(message)
+		return
+	}
+
+	var start = time.Now()
+
+	code, err := sendWebhook(webhook, payload)
+
+	var delivery = mongo.WebhookDelivery{
+		DeliveryID: payload.DeliveryID,
+		CreatedAt:  time.Now(),
+		UserID:     webhook.UserID,
+		WebhookID:  webhook.ID,
+		Event:      string(payload.Event),
+		URL:        webhook.URL,
+		Attempt:    message.Attempt(),
+		StatusCode: code,
+		Duration:   time.Since(start).Milliseconds(),
+		Retrying:   err != nil && message.Attempt() < webhookMaxAttempts,
+	}
+
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+
+	err2 := mongo.NewWebhookDelivery(delivery)
+	if err2 != nil {
+		log.ErrS(err2)
+	}
+
+	// Back off through the delay queue, 1, 2, 4.. minutes
+	if delivery.Retrying {
+		sendToRetryQueueWithDelay(message, time.Minute*time.Duration(math.Pow(2, float64(message.Attempt()-1))))
+		return
+	}
+
+	message.Ack()
+}
+
+func sendWebhook(webhook mysql.UserWebhook, payload WebhookMessage) (code int, err error) {
+
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(payload.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "GlobalSteam-Webhooks")
+	req.Header.Set(HeaderWebhookEvent, string(payload.Event))
+	req.Header.Set(HeaderWebhookDelivery, payload.DeliveryID)
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(webhook.Secret, payload.Body))
+
+	resp, err := webhookClient().Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer helpers.Close(resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.New("responded with " + strconv.Itoa(resp.StatusCode))
+	}
+
+	return resp.StatusCode, nil
+}
+
+var (
+	webhookHTTPClient     *http.Client
+	webhookHTTPClientLock sync.Mutex
+)
+
+// User URLs can't reach internal services, except locally when testing against a local server
+func webhookClient() *http.Client {
+
+	webhookHTTPClientLock.Lock()
+	defer webhookHTTPClientLock.Unlock()
+
+	if webhookHTTPClient == nil {
+		if config.IsLocal() {
+			webhookHTTPClient = &http.Client{
+				Timeout: webhookTimeout,
+				CheckRedirect: func(req *http.Request, via []*http.Request) error {
+					return http.ErrUseLastResponse
+				},
+			}
+		} else {
+			webhookHTTPClient = helpers.NewPublicHTTPClient(webhookTimeout)
+		}
+	}
+
+	return webhookHTTPClient
+}
+
+func SignWebhook(secret string, body []byte) string {
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
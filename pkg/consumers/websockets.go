@@ -22,7 +22,7 @@ func websocketHandler(message *rabbit.Message) {
 	err := helpers.Unmarshal(message.Message.Body, &payload)
 	if err != nil {
 		log.Err(err.Error(), zap.String("body", string(message.Message.Body)))
-		sendToFailQueue(message)
+		sendToFailQueue(message, err)
 		return
 	}
 
@@ -235,6 +235,7 @@ type PlayerPayload struct {
 	Queue         string `json:"queue"`
 	CommunityLink string `json:"community_link"`
 	New           bool   `json:"new"`
+	Message       string `json:"message,omitempty"`
 }
 
 type NewsPayload struct {
@@ -0,0 +1,51 @@
+package crons
+
+import (
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type AppsItemsQueueElastic struct {
+	BaseTask
+}
+
+func (c AppsItemsQueueElastic) ID() string {
+	return "items-queue-elastic"
+}
+
+func (c AppsItemsQueueElastic) Name() string {
+	return "Index all items in Elastic"
+}
+
+func (c AppsItemsQueueElastic) Group() TaskGroup {
+	return TaskGroupElastic
+}
+
+func (c AppsItemsQueueElastic) Cron() TaskTime {
+	return ""
+}
+
+func (c AppsItemsQueueElastic) work() (err error) {
+
+	var filter = bson.D{{"items", bson.M{"$gt": 0}}}
+	var projection = bson.M{"_id": 1, "name": 1}
+
+	return mongo.BatchApps(filter, projection, func(apps []mongo.App) {
+
+		for _, app := range apps {
+
+			items, err := mongo.GetAppItems(0, 0, bson.D{{"app_id", app.ID}}, nil)
+			if err != nil {
+				log.ErrS(err, app.ID)
+				continue
+			}
+
+			err = consumers.IndexAppItems(app, items)
+			if err != nil {
+				log.ErrS(err, app.ID)
+			}
+		}
+	})
+}
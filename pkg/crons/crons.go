@@ -23,6 +23,7 @@ const ( //                                       min  hour
 	CronTimeAppPlayers               TaskTime = "*/10 *"
 	CronTimeAppPlayersTop            TaskTime = "*/10 *"
 	CronTimeAppsSameowners           TaskTime = "*/10 *"
+	CronTimeBanWatchPlayers          TaskTime = "0    *"
 	CronTimeAutoPlayerRefreshes      TaskTime = "0    */6"
 	CronTimeGameDBStats              TaskTime = "0    */6"
 	CronTimeAppsReviews              TaskTime = "0    0"
@@ -35,6 +36,10 @@ const ( //                                       min  hour
 	CronTimeStats                    TaskTime = "35   0"
 	CronTimeAppsWishlists            TaskTime = "40   0"
 	CronTimeAddAppTagsToInflux       TaskTime = "45   0"
+	CronTimeExchangeRates            TaskTime = "50   0"
+	CronTimeFranchises               TaskTime = "55   0"
+	CronTimeWishlistDigests          TaskTime = "0    9"
+	CronTimeDiscordTrendingFeed      TaskTime = "0    12"
 	CronTimeAppsInflux               TaskTime = ""
 	CronTimeSteamSpy                 TaskTime = ""
 	CronTimeInstagram                TaskTime = ""
@@ -61,6 +66,7 @@ var (
 		&AppsAchievementsQueueElastic{},
 		&AppsAddTagCountsToInflux{},
 		&AppsArticlesQueueElastic{},
+		&AppsItemsQueueElastic{},
 		&AppsPlayerCheckTop{},
 		&AppsPlayerCheck{},
 		&AppsQueueAll{},
@@ -78,7 +84,10 @@ var (
 		&BadgesUpdateRandom{},
 		&BundlesQueueAll{},
 		&BundlesQueueElastic{},
+		&DiscordTrendingFeed{},
 		&DiscordUpdateGuild{},
+		&ExchangeRatesUpdate{},
+		&FranchisesUpdate{},
 		&GlobalSteamStats{},
 		&GroupsQueueElastic{},
 		&GroupsQueuePrimaries{},
@@ -86,10 +95,12 @@ var (
 		&InstagramPost{},
 		&MemcacheClearAll{},
 		&PlayersQueueAll{},
+		&PlayersQueueBanWatch{},
 		&PlayersQueueElastic{},
 		&PlayersQueueGroups{},
 		&PlayersQueueLastUpdated{},
 		&PlayersUpdateRanks{},
+		&PlayersWishlistDigests{},
 		&ProductsUpdateKeys{},
 		&StatsTask{},
 		&SteamOnline{},
@@ -0,0 +1,30 @@
+package crons
+
+import (
+	"github.com/gamedb/gamedb/pkg/consumers"
+)
+
+type DiscordTrendingFeed struct {
+	BaseTask
+}
+
+func (c DiscordTrendingFeed) ID() string {
+	return "discord-trending-feed"
+}
+
+func (c DiscordTrendingFeed) Name() string {
+	return "Post trending games to subscribed discord channels"
+}
+
+func (c DiscordTrendingFeed) Group() TaskGroup {
+	return ""
+}
+
+func (c DiscordTrendingFeed) Cron() TaskTime {
+	return CronTimeDiscordTrendingFeed
+}
+
+func (c DiscordTrendingFeed) work() (err error) {
+
+	return consumers.ProduceChatBotTrendingFeed()
+}
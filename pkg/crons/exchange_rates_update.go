@@ -0,0 +1,38 @@
+package crons
+
+import (
+	"github.com/gamedb/gamedb/pkg/exchange"
+	"github.com/gamedb/gamedb/pkg/mongo"
+)
+
+type ExchangeRatesUpdate struct {
+	BaseTask
+}
+
+func (c ExchangeRatesUpdate) ID() string {
+	return "update-exchange-rates"
+}
+
+func (c ExchangeRatesUpdate) Name() string {
+	return "Update currency exchange rates"
+}
+
+func (c ExchangeRatesUpdate) Group() TaskGroup {
+	return ""
+}
+
+func (c ExchangeRatesUpdate) Cron() TaskTime {
+	return CronTimeExchangeRates
+}
+
+func (c ExchangeRatesUpdate) work() (err error) {
+
+	provider := exchange.GetProvider()
+
+	rates, err := provider.Rates()
+	if err != nil {
+		return err
+	}
+
+	return mongo.SaveExchangeRates(rates, provider.Name())
+}
@@ -0,0 +1,55 @@
+package crons
+
+import (
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type FranchisesUpdate struct {
+	BaseTask
+}
+
+func (c FranchisesUpdate) ID() string {
+	return "update-franchises"
+}
+
+func (c FranchisesUpdate) Name() string {
+	return "Rebuild franchises from app names, publishers and developers"
+}
+
+func (c FranchisesUpdate) Group() TaskGroup {
+	return TaskGroupApps
+}
+
+func (c FranchisesUpdate) Cron() TaskTime {
+	return CronTimeFranchises
+}
+
+func (c FranchisesUpdate) work() (err error) {
+
+	var filter = bson.D{{"type", "game"}}
+	var projection = bson.M{
+		"_id":                 1,
+		"name":                1,
+		"icon":                1,
+		"publishers":          1,
+		"developers":          1,
+		"player_peak_week":    1,
+		"player_peak_alltime": 1,
+		"owners":              1,
+		"reviews.positive":    1,
+		"reviews.negative":    1,
+		"reviews_score":       1,
+		"prices":              1,
+	}
+
+	var apps []mongo.App
+	err = mongo.BatchApps(filter, projection, func(batch []mongo.App) {
+		apps = append(apps, batch...)
+	})
+	if err != nil {
+		return err
+	}
+
+	return mongo.ReplaceFranchises(mongo.BuildFranchises(apps))
+}
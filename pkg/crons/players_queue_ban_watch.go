@@ -0,0 +1,77 @@
+package crons
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/mongo"
+	"github.com/gamedb/gamedb/pkg/mysql"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+type PlayersQueueBanWatch struct {
+	BaseTask
+}
+
+func (c PlayersQueueBanWatch) ID() string {
+	return "queue-ban-watch-players"
+}
+
+func (c PlayersQueueBanWatch) Name() string {
+	return "Queue watched players"
+}
+
+func (c PlayersQueueBanWatch) Group() TaskGroup {
+	return TaskGroupPlayers
+}
+
+func (c PlayersQueueBanWatch) Cron() TaskTime {
+	return CronTimeBanWatchPlayers
+}
+
+// Watched players are scanned more often than the last updated cron gets to them
+const banWatchMaxAge = time.Hour * 12
+
+func (c PlayersQueueBanWatch) work() (err error) {
+
+	playerIDs, err := mysql.GetBanWatchPlayerIDs()
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range helpers.ChunkInt64s(playerIDs, 500) {
+
+		players, err := mongo.GetPlayersByID(chunk, bson.M{"_id": 1, "updated_at": 1})
+		if err != nil {
+			return err
+		}
+
+		var updatedAt = map[int64]time.Time{}
+		for _, player := range players {
+			updatedAt[player.ID] = player.UpdatedAt
+		}
+
+		for _, playerID := range chunk {
+
+			// Players not in Mongo yet are queued too
+			if t, ok := updatedAt[playerID]; ok && t.After(time.Now().Add(-banWatchMaxAge)) {
+				continue
+			}
+
+			m := consumers.PlayerMessage{
+				ID:               playerID,
+				SkipGroupUpdate:  true,
+				SkipAchievements: true,
+			}
+
+			err = consumers.ProducePlayer(m, "crons-ban-watch")
+			err = helpers.IgnoreErrors(err, consumers.ErrInQueue)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package crons
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/consumers"
+	"github.com/gamedb/gamedb/pkg/mysql"
+)
+
+type PlayersWishlistDigests struct {
+	BaseTask
+}
+
+func (c PlayersWishlistDigests) ID() string {
+	return "send-wishlist-digests"
+}
+
+func (c PlayersWishlistDigests) Name() string {
+	return "Send weekly wishlist digests"
+}
+
+func (c PlayersWishlistDigests) Group() TaskGroup {
+	return TaskGroupPlayers
+}
+
+func (c PlayersWishlistDigests) Cron() TaskTime {
+	return CronTimeWishlistDigests
+}
+
+func (c PlayersWishlistDigests) work() (err error) {
+
+	// The cron parser has no weekday field
+	if time.Now().Weekday() != time.Monday {
+		return nil
+	}
+
+	users, err := mysql.GetUsersWithWishlistDigest()
+	if err != nil {
+		return err
+	}
+
+	for _, user := range users {
+
+		err = consumers.ProduceWishlistDigest(user.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/olivere/elastic/v7"
+)
+
+type Item struct {
+	AppID       int                           `json:"app_id"`
+	AppName     string                        `json:"app_name"`
+	ItemDefID   int                           `json:"item_def_id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Type        string                        `json:"type"`
+	Quality     string                        `json:"quality"`
+	Tags        []string                      `json:"tags"` // category:value
+	Tradable    bool                          `json:"tradable"`
+	Marketable  bool                          `json:"marketable"`
+	Commodity   bool                          `json:"commodity"`
+	Recipes     int                           `json:"recipes"`
+	Prices      map[steamapi.CurrencyCode]int `json:"prices"`
+	Icon        string                        `json:"icon"`
+	NameMarked  string                        `json:"-"`
+	Score       float64                       `json:"-"`
+}
+
+func (item Item) GetKey() string {
+	return strconv.Itoa(item.AppID) + "-" + strconv.Itoa(item.ItemDefID)
+}
+
+func (item Item) GetName() string {
+
+	if item.Name == "" {
+		return "Item " + strconv.Itoa(item.ItemDefID)
+	}
+	return item.Name
+}
+
+func (item Item) GetPath() string {
+	return helpers.GetAppItemPath(item.AppID, item.ItemDefID)
+}
+
+func (item Item) GetAppName() string {
+	return helpers.GetAppName(item.AppID, item.AppName)
+}
+
+func (item Item) GetAppPath() string {
+	return helpers.GetAppPath(item.AppID, item.AppName) + "#items"
+}
+
+func (item Item) GetIcon() string {
+
+	if item.Icon == "" {
+		return helpers.DefaultAppIcon
+	}
+	return item.Icon
+}
+
+func (item Item) GetPriceFormatted(currency steamapi.CurrencyCode) string {
+
+	if price, ok := item.Prices[currency]; ok {
+		return i18n.FormatPrice(currency, price)
+	}
+	return ""
+}
+
+func (item Item) OutputForJSON(currency steamapi.CurrencyCode) (output []interface{}) {
+
+	return []interface{}{
+		item.AppID,                       // 0
+		item.ItemDefID,                   // 1
+		item.GetName(),                   // 2
+		item.NameMarked,                  // 3
+		item.GetPath(),                   // 4
+		item.GetIcon(),                   // 5
+		item.GetAppName(),                // 6
+		item.GetAppPath(),                // 7
+		strings.Title(item.Type),         // 8
+		item.Quality,                     // 9
+		item.Tradable,                    // 10
+		item.Marketable,                  // 11
+		item.GetPriceFormatted(currency), // 12
+		item.Recipes,                     // 13
+		item.Score,                       // 14
+	}
+}
+
+// Some apps have thousands of items, so send them in chunks
+func IndexItemsBulk(items map[string]Item) error {
+
+	i := map[string]interface{}{}
+	for k, v := range items {
+
+		i[k] = v
+
+		if len(i) == 500 {
+			err := indexDocuments(IndexItems, i)
+			if err != nil {
+				return err
+			}
+			i = map[string]interface{}{}
+		}
+	}
+
+	if len(i) == 0 {
+		return nil
+	}
+
+	return indexDocuments(IndexItems, i)
+}
+
+func DeleteItems(keys []string) error {
+	return deleteDocuments(IndexItems, keys)
+}
+
+// Aggregations are keyed by tags, quality, type and tradable
+func SearchItems(offset int, limit int, search string, sorters []elastic.Sorter, filters []elastic.Query) (items []Item, aggregations map[string]map[string]int64, total int64, err error) {
+
+	client, ctx, err := client()
+	if err != nil {
+		return items, aggregations, 0, err
+	}
+
+	searchService := client.Search().
+		Index(IndexItems).
+		From(offset).
+		Size(limit).
+		TrackTotalHits(true).
+		Aggregation("tags", elastic.NewTermsAggregation().Field("tags").Size(100).OrderByCountDesc()).
+		Aggregation("quality", elastic.NewTermsAggregation().Field("quality").Size(50).OrderByCountDesc()).
+		Aggregation("type", elastic.NewTermsAggregation().Field("type").Size(50).OrderByCountDesc()).
+		Aggregation("tradable", elastic.NewTermsAggregation().Field("tradable").Size(2))
+
+	boolQuery := elastic.NewBoolQuery()
+
+	if len(filters) > 0 {
+		boolQuery.Filter(filters...)
+	}
+
+	search = strings.TrimSpace(search)
+	if search != "" {
+
+		i, _ := strconv.ParseInt(search, 10, 64)
+
+		boolQuery.Must(
+			elastic.NewBoolQuery().MinimumNumberShouldMatch(1).Should(
+				elastic.NewTermQuery("item_def_id", i).Boost(5),
+				elastic.NewMatchQuery("name", search).Boost(3),
+				elastic.NewMatchQuery("app_name", search).Boost(1),
+				elastic.NewMatchQuery("description", search).Boost(0.5),
+				elastic.NewPrefixQuery("name", search).Boost(0.3),
+			),
+		)
+
+		searchService.Highlight(elastic.NewHighlight().Field("name").PreTags("<mark>").PostTags("</mark>"))
+	} else {
+		searchService.SortBy(sorters...)
+	}
+
+	searchService.Query(boolQuery)
+
+	searchResult, err := searchService.Do(ctx)
+	if err != nil {
+		return items, aggregations, 0, err
+	}
+
+	aggregations = make(map[string]map[string]int64, len(searchResult.Aggregations))
+	for k := range searchResult.Aggregations {
+		a, ok := searchResult.Aggregations.Terms(k)
+		if ok {
+			aggregations[k] = make(map[string]int64, len(a.Buckets))
+			for _, v := range a.Buckets {
+				if v.KeyAsString != nil {
+					aggregations[k][*v.KeyAsString] = v.DocCount
+				} else if key, ok := v.Key.(string); ok {
+					aggregations[k][key] = v.DocCount
+				}
+			}
+		}
+	}
+
+	for _, hit := range searchResult.Hits.Hits {
+
+		var item Item
+		err := json.Unmarshal(hit.Source, &item)
+		if err != nil {
+			log.ErrS(err)
+			continue
+		}
+
+		if hit.Score != nil {
+			item.Score = *hit.Score
+		}
+
+		item.NameMarked = item.GetName()
+		if val, ok := hit.Highlight["name"]; ok {
+			if len(val) > 0 {
+				item.NameMarked = val[0]
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, aggregations, searchResult.TotalHits(), nil
+}
+
+//noinspection GoUnusedExportedFunction
+func DeleteAndRebuildItemsIndex() {
+
+	var priceProperties = map[steamapi.CurrencyCode]interface{}{}
+	for _, prodCC := range i18n.GetProdCCs(false) {
+		priceProperties[prodCC.CurrencyCode] = fieldTypeInt32
+	}
+
+	var mapping = map[string]interface{}{
+		"settings": settings,
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"app_id":      fieldTypeInt32,
+				"app_name":    fieldTypeText,
+				"item_def_id": fieldTypeInt32,
+				"name":        fieldTypeText,
+				"description": fieldTypeText,
+				"type":        fieldTypeKeyword,
+				"quality":     fieldTypeKeyword,
+				"tags":        fieldTypeKeyword,
+				"tradable":    fieldTypeBool,
+				"marketable":  fieldTypeBool,
+				"commodity":   fieldTypeBool,
+				"recipes":     fieldTypeInt32,
+				"prices":      map[string]interface{}{"type": "object", "properties": priceProperties},
+				"icon":        fieldTypeDisabled,
+			},
+		},
+	}
+
+	rebuildIndex(IndexItems, mapping)
+}
@@ -14,25 +14,27 @@ import (
 )
 
 type Bundle struct {
-	Apps            int                        `json:"apps"`
-	CreatedAt       int64                      `json:"created_at"`
-	Discount        int                        `json:"discount"`
-	DiscountHighest int                        `json:"discount_highest"`
-	DiscountLowest  int                        `json:"discount_lowest"`
-	DiscountSale    int                        `json:"discount_sale"`
-	Giftable        bool                       `json:"giftable"`
-	Icon            string                     `json:"icon"`
-	ID              int                        `json:"id"`
-	Image           string                     `json:"image"`
-	Name            string                     `json:"name"`
-	OnSale          bool                       `json:"on_sale"`
-	Packages        int                        `json:"packages"`
-	Prices          map[steamapi.ProductCC]int `json:"prices"`
-	PricesSale      map[steamapi.ProductCC]int `json:"prices_sale"`
-	Type            string                     `json:"type"`
-	UpdatedAt       int64                      `json:"updated_at"`
-	NameMarked      string                     `json:"-"`
-	Score           float64                    `json:"-"`
+	Apps             int                        `json:"apps"`
+	CreatedAt        int64                      `json:"created_at"`
+	Discount         int                        `json:"discount"`
+	DiscountHighest  int                        `json:"discount_highest"`
+	DiscountLowest   int                        `json:"discount_lowest"`
+	DiscountSale     int                        `json:"discount_sale"`
+	Giftable         bool                       `json:"giftable"`
+	Icon             string                     `json:"icon"`
+	ID               int                        `json:"id"`
+	Image            string                     `json:"image"`
+	Name             string                     `json:"name"`
+	OnSale           bool                       `json:"on_sale"`
+	Packages         int                        `json:"packages"`
+	Prices           map[steamapi.ProductCC]int `json:"prices"`
+	PricesSale       map[steamapi.ProductCC]int `json:"prices_sale"`
+	PricesIndividual map[steamapi.ProductCC]int `json:"prices_individual"`
+	Savings          map[steamapi.ProductCC]int `json:"savings"`
+	Type             string                     `json:"type"`
+	UpdatedAt        int64                      `json:"updated_at"`
+	NameMarked       string                     `json:"-"`
+	Score            float64                    `json:"-"`
 }
 
 func (bundle Bundle) GetID() int {
@@ -75,6 +77,14 @@ func (bundle Bundle) GetPricesSaleFormatted() map[steamapi.ProductCC]string {
 	return helpers.GetBundlePricesFormatted(bundle.PricesSale)
 }
 
+func (bundle Bundle) GetPricesIndividualFormatted() map[steamapi.ProductCC]string {
+	return helpers.GetBundlePricesFormatted(bundle.PricesIndividual)
+}
+
+func (bundle Bundle) GetSavings() map[steamapi.ProductCC]int {
+	return bundle.Savings
+}
+
 func (bundle Bundle) GetScore() float64 {
 	return bundle.Score
 }
@@ -193,23 +203,25 @@ func DeleteAndRebuildBundlesIndex() {
 		"settings": settings,
 		"mappings": map[string]interface{}{
 			"properties": map[string]interface{}{
-				"apps":             fieldTypeInt32,
-				"created_at":       fieldTypeInt64,
-				"discount":         fieldTypeInt32,
-				"discount_highest": fieldTypeInt32,
-				"discount_lowest":  fieldTypeInt32,
-				"discount_sale":    fieldTypeInt32,
-				"giftable":         fieldTypeBool,
-				"icon":             fieldTypeDisabled,
-				"id":               fieldTypeInt32,
-				"image":            fieldTypeDisabled,
-				"name":             fieldTypeText,
-				"on_sale":          fieldTypeBool,
-				"packages":         fieldTypeInt32,
-				"prices":           map[string]interface{}{"type": "object", "properties": priceProperties},
-				"prices_sale":      map[string]interface{}{"type": "object", "properties": priceProperties},
-				"type":             fieldTypeKeyword,
-				"updated_at":       fieldTypeInt64,
+				"apps":              fieldTypeInt32,
+				"created_at":        fieldTypeInt64,
+				"discount":          fieldTypeInt32,
+				"discount_highest":  fieldTypeInt32,
+				"discount_lowest":   fieldTypeInt32,
+				"discount_sale":     fieldTypeInt32,
+				"giftable":          fieldTypeBool,
+				"icon":              fieldTypeDisabled,
+				"id":                fieldTypeInt32,
+				"image":             fieldTypeDisabled,
+				"name":              fieldTypeText,
+				"on_sale":           fieldTypeBool,
+				"packages":          fieldTypeInt32,
+				"prices":            map[string]interface{}{"type": "object", "properties": priceProperties},
+				"prices_sale":       map[string]interface{}{"type": "object", "properties": priceProperties},
+				"prices_individual": map[string]interface{}{"type": "object", "properties": priceProperties},
+				"savings":           map[string]interface{}{"type": "object", "properties": priceProperties},
+				"type":              fieldTypeKeyword,
+				"updated_at":        fieldTypeInt64,
 			},
 		},
 	}
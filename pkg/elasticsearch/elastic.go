@@ -21,6 +21,7 @@ const (
 	IndexApps         = "apps"
 	IndexGlobal       = "global"
 	IndexPlayers      = "players"
+	IndexItems        = "items"
 )
 
 var (
@@ -137,6 +138,37 @@ func DeleteDocument(index string, key string) error {
 	return err
 }
 
+func deleteDocuments(index string, keys []string) error {
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	client, ctx, err := client()
+	if err != nil {
+		return err
+	}
+
+	bulk := client.Bulk()
+	for _, key := range keys {
+		bulk.Add(elastic.NewBulkDeleteRequest().Index(index).Id(key))
+	}
+
+	resp, err := bulk.Do(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Already missing is fine
+	for _, v := range resp.Failed() {
+		if v.Status != 404 {
+			return errors.New(v.Error.Reason)
+		}
+	}
+
+	return nil
+}
+
 func rebuildIndex(index string, mapping map[string]interface{}) {
 
 	client, ctx, err := client()
@@ -0,0 +1,102 @@
+package exchange
+
+import (
+	"errors"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+)
+
+const defaultURL = "https://open.er-api.com/v6/latest/USD"
+
+// Somewhere to load exchange rates from
+type Provider interface {
+	Name() string
+	Rates() (rates helpers.ExchangeRates, err error)
+}
+
+// Uses a local file if one is set, so rates are predictable locally and in tests
+func GetProvider() Provider {
+
+	if config.C.ExchangeRatesFile != "" {
+		return FileProvider{Path: config.C.ExchangeRatesFile}
+	}
+
+	return HTTPProvider{URL: defaultURL}
+}
+
+// Loads rates from an API returning USD based rates
+type HTTPProvider struct {
+	URL string
+}
+
+func (p HTTPProvider) Name() string {
+	return "http"
+}
+
+func (p HTTPProvider) Rates() (rates helpers.ExchangeRates, err error) {
+
+	body, code, err := helpers.Get(p.URL, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if code != 200 {
+		return nil, errors.New("exchange rates returned " + strconv.Itoa(code))
+	}
+
+	return parseRates(body)
+}
+
+// Loads rates from a saved copy of the API response
+type FileProvider struct {
+	Path string
+}
+
+func (p FileProvider) Name() string {
+	return "file"
+}
+
+func (p FileProvider) Rates() (rates helpers.ExchangeRates, err error) {
+
+	body, err := ioutil.ReadFile(p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseRates(body)
+}
+
+type ratesResponse struct {
+	Base  steamapi.CurrencyCode             `json:"base_code"`
+	Rates map[steamapi.CurrencyCode]float64 `json:"rates"`
+}
+
+func parseRates(body []byte) (rates helpers.ExchangeRates, err error) {
+
+	resp := ratesResponse{}
+	err = helpers.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Base != steamapi.CurrencyUSD {
+		return nil, errors.New("exchange rates must be based on USD, got " + string(resp.Base))
+	}
+
+	if len(resp.Rates) == 0 {
+		return nil, errors.New("no exchange rates returned")
+	}
+
+	rates = helpers.ExchangeRates{}
+	for k, v := range resp.Rates {
+		if v > 0 {
+			rates[k] = v
+		}
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,45 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/Jleagle/steam-go/steamapi"
+)
+
+func TestFileProvider(t *testing.T) {
+
+	rates, err := FileProvider{Path: "testdata/rates.json"}.Rates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rates[steamapi.CurrencyUSD] != 1 || rates[steamapi.CurrencyGBP] != 0.8 || rates[steamapi.CurrencyRUB] != 75 {
+		t.Error("wrong rates", rates)
+	}
+
+	// Zero rates would divide by zero
+	if _, ok := rates["XXX"]; ok {
+		t.Error("zero rate kept")
+	}
+
+	_, err = FileProvider{Path: "testdata/missing.json"}.Rates()
+	if err == nil {
+		t.Error("missing file did not error")
+	}
+}
+
+func TestParseRates(t *testing.T) {
+
+	tests := map[string]string{
+		"not json":      `{`,
+		"wrong base":    `{"base_code": "EUR", "rates": {"USD": 1.1}}`,
+		"no rates":      `{"base_code": "USD", "rates": {}}`,
+		"missing rates": `{"base_code": "USD"}`,
+	}
+
+	for name, body := range tests {
+		if _, err := parseRates([]byte(body)); err == nil {
+			t.Error(name, "did not error")
+		}
+	}
+}
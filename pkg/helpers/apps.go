@@ -63,6 +63,10 @@ func GetAppPathAbsolute(id int, name string) string {
 	return pathx
 }
 
+func GetAppItemPath(appID int, itemDefID int) string {
+	return "/items/" + strconv.Itoa(appID) + "/" + strconv.Itoa(itemDefID)
+}
+
 func GetAppCommunityLink(appID int) string {
 	name := config.C.GameDBShortName
 	return "https://steamcommunity.com/app/" + strconv.Itoa(appID) + "?utm_source=" + name + "&utm_medium=link&curator_clanid=" // todo curator_clanid
@@ -207,9 +211,10 @@ func (ss AppSteamSpy) GetSSMedianPlaytimeForever() float64 {
 }
 
 type AppReviewSummary struct {
-	Positive int
-	Negative int
-	Reviews  []AppReview
+	Positive  int
+	Negative  int
+	Reviews   []AppReview
+	Languages []AppReviewLanguage // From a sample of reviews in all languages
 }
 
 func (r AppReviewSummary) GetTotal() int {
@@ -224,6 +229,20 @@ func (r AppReviewSummary) GetNegativePercent() float64 {
 	return float64(r.Negative) / float64(r.GetTotal()) * 100
 }
 
+type AppReviewLanguage struct {
+	Language string `json:"language"`
+	Positive int    `json:"positive"`
+	Negative int    `json:"negative"`
+}
+
+func (l AppReviewLanguage) GetTotal() int {
+	return l.Positive + l.Negative
+}
+
+func (l AppReviewLanguage) GetPositivePercent() float64 {
+	return float64(l.Positive) / float64(l.GetTotal()) * 100
+}
+
 type AppReview struct {
 	Review     string `json:"r"`
 	Vote       bool   `json:"v"`
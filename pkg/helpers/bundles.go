@@ -25,6 +25,8 @@ type Bundle interface {
 	GetPrices() map[steamapi.ProductCC]int
 	GetPricesFormatted() map[steamapi.ProductCC]string
 	GetPricesSaleFormatted() map[steamapi.ProductCC]string
+	GetPricesIndividualFormatted() map[steamapi.ProductCC]string
+	GetSavings() map[steamapi.ProductCC]int
 	GetScore() float64
 	GetType() string
 	GetApps() int
@@ -38,20 +40,22 @@ func OutputBundleForJSON(bundle Bundle) []interface{} {
 	highest := bundle.GetDiscountHighest() == bundle.GetDiscount() && bundle.GetDiscount() != 0
 
 	return []interface{}{
-		bundle.GetID(),                  // 0
-		bundle.GetName(),                // 1
-		bundle.GetPath(),                // 2
-		updated,                         // 3
-		bundle.GetDiscount(),            // 4
-		bundle.GetApps(),                // 5
-		bundle.IsGiftable(),             // 6
-		highest,                         // 7
-		bundle.GetStoreLink(),           // 8
-		bundle.GetPricesFormatted(),     // 9
-		bundle.GetScore(),               // 10
-		bundle.GetPricesSaleFormatted(), // 11
-		bundle.GetType(),                // 12
-		bundle.GetDiscountSale(),        // 13
+		bundle.GetID(),                        // 0
+		bundle.GetName(),                      // 1
+		bundle.GetPath(),                      // 2
+		updated,                               // 3
+		bundle.GetDiscount(),                  // 4
+		bundle.GetApps(),                      // 5
+		bundle.IsGiftable(),                   // 6
+		highest,                               // 7
+		bundle.GetStoreLink(),                 // 8
+		bundle.GetPricesFormatted(),           // 9
+		bundle.GetScore(),                     // 10
+		bundle.GetPricesSaleFormatted(),       // 11
+		bundle.GetType(),                      // 12
+		bundle.GetDiscountSale(),              // 13
+		bundle.GetSavings(),                   // 14
+		bundle.GetPricesIndividualFormatted(), // 15
 	}
 }
 
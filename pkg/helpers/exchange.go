@@ -0,0 +1,130 @@
+package helpers
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/i18n"
+)
+
+// Units of each currency per US dollar
+type ExchangeRates map[steamapi.CurrencyCode]float64
+
+func (rates ExchangeRates) Convert(value int, from steamapi.CurrencyCode, to steamapi.CurrencyCode) (converted int, ok bool) {
+
+	if from == to {
+		return value, true
+	}
+
+	fromRate, ok := rates[from]
+	if !ok || fromRate <= 0 {
+		return 0, false
+	}
+
+	toRate, ok := rates[to]
+	if !ok || toRate <= 0 {
+		return 0, false
+	}
+
+	return int(math.Round(float64(value) / fromRate * toRate)), true
+}
+
+// A region's price converted into another currency
+type RegionPrice struct {
+	ProductCC         steamapi.ProductCC    `json:"prod_cc"`
+	Price             ProductPrice          `json:"price"`
+	Currency          steamapi.CurrencyCode `json:"currency"` // The currency Normalised is in
+	Normalised        int                   `json:"normalised"`
+	DifferencePercent float64               `json:"difference_percent"` // Compared to the region it was normalised for
+	Rank              int                   `json:"rank"`               // 1 is the cheapest
+}
+
+func (rp RegionPrice) GetNormalised() string {
+	return i18n.FormatPrice(rp.Currency, rp.Normalised)
+}
+
+func (rp RegionPrice) GetDifferencePercent() string {
+
+	if rp.DifferencePercent > 0 {
+		return "+" + strconv.FormatFloat(rp.DifferencePercent, 'f', 0, 64) + "%"
+	}
+	return strconv.FormatFloat(rp.DifferencePercent, 'f', 0, 64) + "%"
+}
+
+func (rp RegionPrice) GetCountryName() string {
+	return i18n.GetProdCC(rp.ProductCC).Name
+}
+
+func (rp RegionPrice) GetFlag() string {
+	return rp.Price.GetFlag(rp.ProductCC)
+}
+
+// Cheapest first
+type RegionPrices []RegionPrice
+
+func (rp RegionPrices) Get(code steamapi.ProductCC) (price RegionPrice, ok bool) {
+
+	for _, v := range rp {
+		if v.ProductCC == code {
+			return v, true
+		}
+	}
+	return price, false
+}
+
+// Has a zero Rank if there are no prices
+func (rp RegionPrices) Cheapest() (price RegionPrice) {
+
+	if len(rp) == 0 {
+		return price
+	}
+	return rp[0]
+}
+
+// Converts every region's final price into the currency of the given region and ranks them
+func (p ProductPrices) RegionPrices(rates ExchangeRates, code steamapi.ProductCC) (regions RegionPrices) {
+
+	var to = i18n.GetProdCC(code).CurrencyCode
+	var local = p.Get(code)
+
+	for k := range p {
+
+		price := p.Get(k)
+		if !price.Exists || price.Free || price.Final == 0 || price.Currency == "" {
+			continue
+		}
+
+		normalised, ok := rates.Convert(price.Final, price.Currency, to)
+		if !ok {
+			continue
+		}
+
+		region := RegionPrice{
+			ProductCC:  k,
+			Price:      price,
+			Currency:   to,
+			Normalised: normalised,
+		}
+
+		if local.Exists && local.Final > 0 {
+			region.DifferencePercent = RoundFloatTo2DP(float64(normalised-local.Final) / float64(local.Final) * 100)
+		}
+
+		regions = append(regions, region)
+	}
+
+	sort.Slice(regions, func(i, j int) bool {
+		if regions[i].Normalised == regions[j].Normalised {
+			return regions[i].ProductCC < regions[j].ProductCC
+		}
+		return regions[i].Normalised < regions[j].Normalised
+	})
+
+	for k := range regions {
+		regions[k].Rank = k + 1
+	}
+
+	return regions
+}
@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/Jleagle/steam-go/steamapi"
+)
+
+var testRates = ExchangeRates{
+	steamapi.CurrencyUSD: 1,
+	steamapi.CurrencyGBP: 0.8,
+	steamapi.CurrencyEUR: 0.9,
+	steamapi.CurrencyRUB: 75,
+}
+
+func TestConvert(t *testing.T) {
+
+	tests := []struct {
+		value    int
+		from     steamapi.CurrencyCode
+		to       steamapi.CurrencyCode
+		expected int
+		ok       bool
+	}{
+		{1000, steamapi.CurrencyUSD, steamapi.CurrencyGBP, 800, true},
+		{1299, steamapi.CurrencyGBP, steamapi.CurrencyUSD, 1624, true}, // 1623.75 rounds up
+		{1001, steamapi.CurrencyGBP, steamapi.CurrencyUSD, 1251, true}, // 1251.25 rounds down
+		{45000, steamapi.CurrencyRUB, steamapi.CurrencyEUR, 540, true},
+		{1000, steamapi.CurrencyUSD, steamapi.CurrencyCNY, 0, false},
+		{1000, steamapi.CurrencyCNY, steamapi.CurrencyUSD, 0, false},
+		{1000, "XXX", steamapi.CurrencyUSD, 0, false},
+		{1000, steamapi.CurrencyCNY, steamapi.CurrencyCNY, 1000, true}, // No rate needed
+	}
+
+	for _, test := range tests {
+
+		converted, ok := testRates.Convert(test.value, test.from, test.to)
+		if converted != test.expected || ok != test.ok {
+			t.Error(test.value, test.from, "to", test.to, "got", converted, ok, "expected", test.expected, test.ok)
+		}
+	}
+}
+
+func TestRegionPrices(t *testing.T) {
+
+	prices := ProductPrices{
+		steamapi.ProductCCUS: {Currency: steamapi.CurrencyUSD, Final: 1999},
+		steamapi.ProductCCUK: {Currency: steamapi.CurrencyGBP, Final: 1299},
+		steamapi.ProductCCRU: {Currency: steamapi.CurrencyRUB, Final: 45000},
+		steamapi.ProductCCCN: {Currency: steamapi.CurrencyCNY, Final: 9000}, // No rate
+		steamapi.ProductCCAU: {Currency: steamapi.CurrencyAUD, Free: true},
+	}
+
+	regions := prices.RegionPrices(testRates, steamapi.ProductCCUS)
+
+	expected := []struct {
+		code       steamapi.ProductCC
+		normalised int
+		difference float64
+	}{
+		{steamapi.ProductCCRU, 600, -69.98},
+		{steamapi.ProductCCUK, 1624, -18.76},
+		{steamapi.ProductCCUS, 1999, 0},
+	}
+
+	if len(regions) != len(expected) {
+		t.Fatal("expected", len(expected), "regions, got", len(regions), regions)
+	}
+
+	for k, v := range expected {
+
+		region := regions[k]
+
+		if region.ProductCC != v.code || region.Normalised != v.normalised || region.DifferencePercent != v.difference {
+			t.Error("wrong region", k, region.ProductCC, region.Normalised, region.DifferencePercent)
+		}
+		if region.Currency != steamapi.CurrencyUSD {
+			t.Error("wrong currency", region.ProductCC, region.Currency)
+		}
+		if region.Rank != k+1 {
+			t.Error("wrong rank", region.ProductCC, region.Rank)
+		}
+	}
+
+	if regions.Cheapest().ProductCC != steamapi.ProductCCRU {
+		t.Error("wrong cheapest", regions.Cheapest())
+	}
+}
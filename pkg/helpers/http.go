@@ -2,8 +2,10 @@ package helpers
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -136,3 +138,109 @@ func GetIP() string {
 
 	return ""
 }
+
+var ErrInternalAddress = errors.New("address is not public")
+
+// Loopback, private, link-local (including cloud metadata), CGNAT, multicast and reserved ranges
+var internalNetworks = func() (networks []*net.IPNet) {
+
+	for _, v := range []string{
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+		"192.0.0.0/24", "192.168.0.0/16", "198.18.0.0/15", "224.0.0.0/4", "240.0.0.0/4",
+		"::/128", "::1/128", "64:ff9b::/96", "fc00::/7", "fe80::/10", "ff00::/8",
+	} {
+		_, network, err := net.ParseCIDR(v)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}()
+
+func IsPublicIP(ip net.IP) bool {
+
+	if ip == nil {
+		return false
+	}
+
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolves a host and returns its addresses, or an error if any of them are internal
+func LookupPublicIPs(ctx context.Context, host string) (ips []net.IP, err error) {
+
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+
+		addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range addrs {
+			ips = append(ips, v.IP)
+		}
+	}
+
+	for _, ip := range ips {
+		if !IsPublicIP(ip) {
+			return nil, ErrInternalAddress
+		}
+	}
+
+	if len(ips) == 0 {
+		return nil, ErrInternalAddress
+	}
+
+	return ips, nil
+}
+
+// A client for user supplied URLs, it only dials public addresses and does not follow redirects.
+// The address is checked when dialing, so a DNS change after validation can't point it inside.
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+
+	dialer := &net.Dialer{Timeout: timeout}
+
+	transport := &http.Transport{
+		Proxy: nil,
+		DialContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil {
+				return nil, err
+			}
+
+			ips, err := LookupPublicIPs(ctx, host)
+			if err != nil {
+				return nil, err
+			}
+
+			var conn net.Conn
+			for _, ip := range ips {
+				conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+				if err == nil {
+					return conn, nil
+				}
+			}
+			return nil, err
+		},
+		TLSHandshakeTimeout: timeout,
+		MaxIdleConns:        10,
+		IdleConnTimeout:     time.Minute,
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -127,6 +127,38 @@ func IsValidPlayerID(id int64) (int64, error) {
 	return int64(steamID), nil
 }
 
+// Splits pasted text into player IDs, accepts any Steam ID format and profile links
+func ParsePlayerIDs(text string) (playerIDs []int64, invalid []string) {
+
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+
+	for _, field := range fields {
+
+		field = strings.TrimSuffix(field, "/")
+		if strings.Contains(field, "/profiles/") {
+			field = field[strings.LastIndex(field, "/")+1:]
+		}
+
+		id, err := steamid.ParsePlayerID(field)
+		if err != nil {
+			invalid = append(invalid, field)
+			continue
+		}
+
+		playerID, err := IsValidPlayerID(int64(id))
+		if err != nil {
+			invalid = append(invalid, field)
+			continue
+		}
+
+		playerIDs = append(playerIDs, playerID)
+	}
+
+	return UniqueInt64(playerIDs), invalid
+}
+
 func GetPlayerMaxFriends(level int) (ret int) {
 	ret = 250 + (level * 5)
 	if ret > 2000 {
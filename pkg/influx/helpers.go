@@ -28,6 +28,7 @@ const (
 	InfluxMeasurementApps          InfluxMeasurement = "apps"
 	InfluxMeasurementChanges       InfluxMeasurement = "changes"
 	InfluxMeasurementChatBot       InfluxMeasurement = "chat_bot"
+	InfluxMeasurementDelayQueue    InfluxMeasurement = "delay_queue"
 	InfluxMeasurementGameDBStats   InfluxMeasurement = "gamedb-stats"
 	InfluxMeasurementGroups        InfluxMeasurement = "groups"
 	InfluxMeasurementPlayers       InfluxMeasurement = "players"
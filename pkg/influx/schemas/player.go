@@ -1,5 +1,7 @@
 package schemas
 
+import "github.com/Jleagle/steam-go/steamapi"
+
 type PlayerField string
 
 var (
@@ -29,3 +31,8 @@ var (
 	InfPlayersAwardsGivenPointsRank    PlayerField = "awards_given_points_rank"
 	InfPlayersAwardsReceivedPointsRank PlayerField = "awards_received_points_rank"
 )
+
+// Wishlist totals are stored per region, in cents
+func InfPlayersWishlistTotal(cc steamapi.ProductCC) PlayerField {
+	return PlayerField("wishlist_total_" + string(cc))
+}
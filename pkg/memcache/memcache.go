@@ -53,9 +53,11 @@ var (
 	ItemBundle = func(bundleID int) Item { return Item{Key: "bundle-" + strconv.Itoa(bundleID), Expiration: 0} }
 
 	// Chat
-	ItemChatBotSettings     = func(discordID string) Item { return Item{Key: "chat-bot-settings-" + discordID, Expiration: 0} }
-	ItemChatBotRequest      = func(request string, code steamapi.ProductCC) Item { return Item{Key: "interaction-" + string(code) + "-" + helpers.MD5([]byte(request)), Expiration: 60 * 10} }
-	ItemChatBotRequestSlash = func(commandID string, inputs map[string]string, code steamapi.ProductCC) Item { return Item{Key: "interaction-slash-" + commandID + "-" + string(code) + "-" + helpers.MD5Interface(inputs) + "-2", Expiration: 60 * 10} }
+	ItemChatBotSettings      = func(discordID string) Item { return Item{Key: "chat-bot-settings-" + discordID, Expiration: 0} }
+	ItemChatBotGuildSettings = func(guildID string) Item { return Item{Key: "chat-bot-guild-settings-" + guildID, Expiration: 0} }
+	ItemChatBotSubscriptions = func(feed string) Item { return Item{Key: "chat-bot-subscriptions-" + feed, Expiration: 10 * 60} }
+	ItemChatBotRequest       = func(request string, code steamapi.ProductCC, lang string) Item { return Item{Key: "interaction-" + string(code) + "-" + lang + "-" + helpers.MD5([]byte(request)), Expiration: 60 * 10} }
+	ItemChatBotRequestSlash  = func(commandID string, inputs map[string]string, code steamapi.ProductCC, lang string) Item { return Item{Key: "interaction-slash-" + commandID + "-" + string(code) + "-" + lang + "-" + helpers.MD5Interface(inputs) + "-2", Expiration: 60 * 10} }
 
 	// Group
 	ItemGroup               = func(changeID string) Item { return Item{Key: "group-" + changeID, Expiration: 0} }
@@ -90,6 +92,7 @@ var (
 	ItemUserEvents    = func(userID int) Item { return Item{Key: "user-event-counts" + strconv.Itoa(userID), Expiration: 0} }
 	ItemUserByAPIKey  = func(key string) Item { return Item{Key: "user-level-by-key-" + key, Expiration: 10 * 60} }
 	ItemUserInDiscord = func(discordID string) Item { return Item{Key: "discord-id-" + discordID, Expiration: 60 * 60 * 24} }
+	ItemUserWebhooks  = func(event string) Item { return Item{Key: "user-webhooks-" + event, Expiration: 10 * 60} }
 
 	// Player
 	ItemPlayer                   = func(playerID int64) Item { return Item{Key: "player-" + strconv.FormatInt(playerID, 10), Expiration: 0} }
@@ -108,16 +111,29 @@ var (
 	ItemChange               = func(changeID int64) Item { return Item{Key: "change-" + strconv.FormatInt(changeID, 10), Expiration: 0} }
 	ItemCommitsPage          = func(page int) Item { return Item{Key: "commits-page-" + strconv.Itoa(page), Expiration: 60 * 60} }
 	ItemConfigItem           = func(configID string) Item { return Item{Key: "config-item-" + configID, Expiration: 0} }
+	ItemCoopGames            = func(playerIDs []int64, code steamapi.ProductCC) Item { return Item{Key: "coop-games-" + string(code) + "-" + helpers.MD5([]byte(helpers.JoinInt64s(playerIDs, ","))), Expiration: 10 * 60} }
+	ItemExchangeRates        = Item{Key: "exchange-rates", Expiration: 60 * 60}
 	ItemFirstAppBadge        = func(appID int) Item { return Item{Key: "first-app-badge-" + strconv.Itoa(appID), Expiration: 0} }
 	ItemMongoCount           = func(collection string, filter bson.D) Item { return Item{Key: "mongo-count-" + collection + "-" + FilterToString(filter), Expiration: 60 * 60} }
 	ItemUniqueSaleTypes      = Item{Key: "unique-sale-types", Expiration: 60 * 60 * 1}
 	ItemChatbotCalls         = Item{Key: "chatbot-calls", Expiration: 60 * 10}
 )
 
+// The calls this repo makes, so tests can swap in a Fake
+type Cache interface {
+	Exists(key string) (exists bool, err error)
+	Get(key string, out interface{}) (err error)
+	Set(key string, value interface{}, seconds uint32) (err error)
+	GetSet(key string, seconds uint32, out interface{}, callback func() (interface{}, error)) (err error)
+	Delete(keys ...string) (err error)
+	DeleteAll() error
+	Close()
+}
+
 var lock sync.Mutex
-var client *memcache.Client
+var client Cache
 
-func Client() *memcache.Client {
+func Client() Cache {
 
 	lock.Lock()
 	defer lock.Unlock()
@@ -143,6 +159,20 @@ func Client() *memcache.Client {
 	return client
 }
 
+// Replaces the client in tests, nil will connect to the real server again.
+// Panics outside of tests, so the live client can't be swapped.
+func SetTestClient(c Cache) {
+
+	if !config.IsTest() {
+		panic("memcache.SetTestClient called outside of a test")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	client = c
+}
+
 func Close() {
 	Client().Close()
 }
@@ -0,0 +1,159 @@
+package memcachetest
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Jleagle/memcache-go"
+	"github.com/memcachier/mc/v3"
+)
+
+// An in-memory cache, for running code that uses this package without a server.
+// Values are stored as JSON, like the real client, so decoding behaves the same.
+type Fake struct {
+	Now   func() time.Time
+	items map[string]fakeItem
+	mutex sync.Mutex
+}
+
+type fakeItem struct {
+	value   string
+	expires time.Time
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		Now:   time.Now,
+		items: map[string]fakeItem{},
+	}
+}
+
+// Cached keys that have not expired
+func (f *Fake) Keys() (keys []string) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for k := range f.items {
+		if _, ok := f.get(k); ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Call with the lock held
+func (f *Fake) get(key string) (string, bool) {
+
+	item, ok := f.items[key]
+	if !ok {
+		return "", false
+	}
+
+	if !item.expires.IsZero() && !f.Now().Before(item.expires) {
+		delete(f.items, key)
+		return "", false
+	}
+
+	return item.value, true
+}
+
+func (f *Fake) Exists(key string) (exists bool, err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	_, exists = f.get(key)
+	return exists, nil
+}
+
+func (f *Fake) Get(key string, out interface{}) (err error) {
+
+	f.mutex.Lock()
+	val, ok := f.get(key)
+	f.mutex.Unlock()
+
+	if !ok {
+		return mc.ErrNotFound
+	}
+
+	return json.Unmarshal([]byte(val), out)
+}
+
+func (f *Fake) Set(key string, value interface{}, seconds uint32) (err error) {
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	var item = fakeItem{value: string(b)}
+
+	// Like memcache, over 30 days is a unix timestamp
+	if seconds > 60*60*24*30 {
+		item.expires = time.Unix(int64(seconds), 0)
+	} else if seconds > 0 {
+		item.expires = f.Now().Add(time.Second * time.Duration(seconds))
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.items[key] = item
+	return nil
+}
+
+func (f *Fake) GetSet(key string, seconds uint32, out interface{}, callback func() (interface{}, error)) (err error) {
+
+	err = f.Get(key, out)
+	if err != mc.ErrNotFound {
+		return err
+	}
+
+	var set = true
+
+	s, err := callback()
+	if err == memcache.ErrNoSet {
+		set = false
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Same as the real client, a miss fills out directly
+	if s != nil {
+		reflect.ValueOf(out).Elem().Set(reflect.ValueOf(s))
+	}
+
+	if !set {
+		return nil
+	}
+
+	return f.Set(key, s, seconds)
+}
+
+func (f *Fake) Delete(keys ...string) (err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(f.items, key)
+	}
+	return nil
+}
+
+func (f *Fake) DeleteAll() error {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.items = map[string]fakeItem{}
+	return nil
+}
+
+func (f *Fake) Close() {
+}
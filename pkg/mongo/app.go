@@ -95,6 +95,7 @@ type App struct {
 	Reviews                       helpers.AppReviewSummary       `bson:"reviews"`
 	ReviewsScore                  float64                        `bson:"reviews_score"`
 	ReviewsCount                  int                            `bson:"reviews_count"`
+	ReviewBombAt                  time.Time                      `bson:"review_bomb_at"` // When a review bomb was last detected
 	Screenshots                   []helpers.AppImage             `bson:"screenshots"`
 	ShortDescription              string                         `bson:"description_short"`
 	Stats                         []helpers.AppStat              `bson:"stats"`
@@ -187,6 +188,7 @@ func (app App) BSON() bson.D {
 		{"reviews", app.Reviews},
 		{"reviews_score", app.ReviewsScore},
 		{"reviews_count", app.ReviewsCount},
+		{"review_bomb_at", app.ReviewBombAt},
 		{"screenshots", app.Screenshots},
 		{"description_short", app.ShortDescription},
 		{"stats", app.Stats},
@@ -288,28 +290,47 @@ func (app App) GetHeaderImage() string {
 	return "https://steamcdn-a.akamaihd.net/steam/apps/" + strconv.Itoa(app.ID) + "/header.jpg"
 }
 
-func (app App) GetCoopTags() (string, error) {
-
-	var tagMap = map[int]string{
+// Tags for playing with friends
+var (
+	CoopTags = map[int]string{
 		1685: "Co-op",
 		3843: "Online co-op",
 		3841: "Local co-op",
 		4508: "Co-op campaign",
-
+	}
+	MultiplayerTags = map[int]string{
 		3859:  "Multiplayer",
 		128:   "Massively multiplayer",
 		7368:  "Local multiplayer",
 		17770: "Asynchronous multiplayer",
 	}
+)
+
+func (app App) GetCoopTags() (string, error) {
+	return strings.Join(app.GetCoopTagNames(), ", "), nil
+}
+
+func (app App) GetCoopTagNames() (coopTags []string) {
 
-	var coopTags []string
 	for _, tagID := range app.Tags {
-		if val, ok := tagMap[tagID]; ok {
+		if val, ok := CoopTags[tagID]; ok {
+			coopTags = append(coopTags, val)
+		} else if val, ok := MultiplayerTags[tagID]; ok {
 			coopTags = append(coopTags, val)
 		}
 	}
 
-	return strings.Join(coopTags, ", "), nil
+	return coopTags
+}
+
+func (app App) IsCoop() bool {
+
+	for _, tagID := range app.Tags {
+		if _, ok := CoopTags[tagID]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 // func (app App) GetHeaderImage2() string {
@@ -338,6 +359,10 @@ func (app App) GetReviewScore() string {
 	return helpers.GetAppReviewScore(app.ReviewsScore)
 }
 
+func (app App) IsReviewBombed() bool {
+	return !app.ReviewBombAt.IsZero() && time.Since(app.ReviewBombAt) < time.Hour*24*ReviewBombDays
+}
+
 func (app App) GetFollowers() string {
 	return helpers.GetAppFollowers(app.GroupID, app.GroupFollowers)
 }
@@ -824,7 +849,7 @@ func TrendingApps() (apps []App, err error) {
 			10,
 			bson.D{{"player_trend", -1}},
 			nil,
-			bson.M{"_id": 1, "name": 1, "player_trend": 1},
+			bson.M{"_id": 1, "name": 1, "player_trend": 1, "review_bomb_at": 1},
 		)
 	})
 
@@ -0,0 +1,347 @@
+package mongo
+
+import (
+	"sort"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Players to read unlock dates from
+const AppAchievementStatsSample = 1000
+
+// Global completion percent ranges
+var achievementRarityBuckets = []struct {
+	Name string
+	Min  float64
+	Max  float64
+}{
+	{"Ultra Rare", 0, 1},
+	{"Very Rare", 1, 5},
+	{"Rare", 5, 10},
+	{"Uncommon", 10, 25},
+	{"Common", 25, 50},
+	{"Very Common", 50, 101},
+}
+
+type AppAchievementStats struct {
+	AppID                                        int                         `bson:"_id"`
+	AppName                                      string                      `bson:"app_name"`
+	AppIcon                                      string                      `bson:"app_icon"`
+	Achievements                                 []AppAchievementStat        `bson:"achievements"`
+	Rarity                                       []AppAchievementRarityCount `bson:"rarity"`
+	Players                                      int                         `bson:"players"` // With at least one achievement
+	Completionists                               int                         `bson:"completionists"`
+	CompletionistPercent                         float64                     `bson:"completionist_percent"`
+	SamplePlayers                                int                         `bson:"sample_players"`
+	MedianCompletionSecondsSinceFirstAchievement int64                       `bson:"median_completion_seconds_since_first_achievement"` // From the sample, Steam has no first play times
+	UpdatedAt                                    time.Time                   `bson:"updated_at"`
+}
+
+type AppAchievementStat struct {
+	Key                                string  `bson:"key"`
+	Name                               string  `bson:"name"`
+	Icon                               string  `bson:"icon"`
+	Completed                          float64 `bson:"completed"`                              // Global percent
+	Players                            int     `bson:"players"`                                // In the sample
+	OrderAverage                       float64 `bson:"order_average"`                          // 1 is always unlocked first
+	MedianSecondsSinceFirstAchievement int64   `bson:"median_seconds_since_first_achievement"` // Steam has no first play times
+}
+
+type AppAchievementRarityCount struct {
+	Name  string `bson:"name"`
+	Count int    `bson:"count"`
+}
+
+func (stats AppAchievementStats) BSON() bson.D {
+
+	return bson.D{
+		{"_id", stats.AppID},
+		{"app_name", stats.AppName},
+		{"app_icon", stats.AppIcon},
+		{"achievements", stats.Achievements},
+		{"rarity", stats.Rarity},
+		{"players", stats.Players},
+		{"completionists", stats.Completionists},
+		{"completionist_percent", stats.CompletionistPercent},
+		{"sample_players", stats.SamplePlayers},
+		{"median_completion_seconds_since_first_achievement", stats.MedianCompletionSecondsSinceFirstAchievement},
+		{"updated_at", stats.UpdatedAt},
+	}
+}
+
+func (stats AppAchievementStats) GetAppPath() string {
+	return helpers.GetAppPath(stats.AppID, stats.AppName) + "#achievements"
+}
+
+func (stats AppAchievementStats) GetAppName() string {
+	return helpers.GetAppName(stats.AppID, stats.AppName)
+}
+
+func (stats AppAchievementStats) GetAppIcon() string {
+	return helpers.GetAppIcon(stats.AppID, stats.AppIcon)
+}
+
+func (stats AppAchievementStats) GetMedianCompletionSinceFirstAchievement() string {
+	return helpers.GetTimeShort(int(stats.MedianCompletionSecondsSinceFirstAchievement/60), 2)
+}
+
+// Lowest global completion first
+func (stats AppAchievementStats) GetHardest(limit int) (achievements []AppAchievementStat) {
+
+	achievements = append(achievements, stats.Achievements...)
+
+	sort.Slice(achievements, func(i, j int) bool {
+		return achievements[i].Completed < achievements[j].Completed
+	})
+
+	if limit > 0 && len(achievements) > limit {
+		achievements = achievements[0:limit]
+	}
+
+	return achievements
+}
+
+// The order players usually unlock achievements in
+func (stats AppAchievementStats) GetUnlockOrder() (achievements []AppAchievementStat) {
+
+	for _, v := range stats.Achievements {
+		if v.Players > 0 {
+			achievements = append(achievements, v)
+		}
+	}
+
+	sort.Slice(achievements, func(i, j int) bool {
+		return achievements[i].OrderAverage < achievements[j].OrderAverage
+	})
+
+	return achievements
+}
+
+func (stat AppAchievementStat) GetIcon(appID int) string {
+	return helpers.GetAchievementIcon(appID, stat.Icon)
+}
+
+func (stat AppAchievementStat) GetCompleted() string {
+	return helpers.GetAchievementCompleted(stat.Completed)
+}
+
+func (stat AppAchievementStat) GetMedianTimeSinceFirstAchievement() string {
+	return helpers.GetTimeShort(int(stat.MedianSecondsSinceFirstAchievement/60), 2)
+}
+
+func ensureAppAchievementStatsIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"completionist_percent", -1}}},
+		{Keys: bson.D{{"players", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionAppAchievementStats.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func GetAppAchievementStats(appID int) (stats AppAchievementStats, err error) {
+
+	err = FindOne(CollectionAppAchievementStats, bson.D{{"_id", appID}}, nil, nil, &stats)
+	return stats, err
+}
+
+// Without the achievements
+func GetAppAchievementStatsList(offset int64, limit int64, filter bson.D, sort bson.D) (list []AppAchievementStats, err error) {
+
+	cur, ctx, err := find(CollectionAppAchievementStats, offset, limit, filter, sort, bson.M{"achievements": 0}, nil)
+	if err != nil {
+		return list, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var stats AppAchievementStats
+		err := cur.Decode(&stats)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			list = append(list, stats)
+		}
+	}
+
+	return list, cur.Err()
+}
+
+func ReplaceAppAchievementStats(stats AppAchievementStats) (err error) {
+
+	_, err = ReplaceOne(CollectionAppAchievementStats, bson.D{{"_id", stats.AppID}}, stats)
+	return err
+}
+
+// Random players that have unlocked at least one achievement in the app
+func SamplePlayerIDsWithAchievements(appID int, size int) (playerIDs []int64, err error) {
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return playerIDs, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{{"app_id", appID}, {"app_achievements_have", bson.M{"$gt": 0}}}}},
+		{{Key: "$sample", Value: bson.M{"size": size}}},
+		{{Key: "$project", Value: bson.M{"_id": 0, "player_id": 1}}},
+	}
+
+	cur, err := client.Database(config.C.MongoDatabase, options.Database()).Collection(CollectionPlayerApps.String()).Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return playerIDs, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var playerApp PlayerApp
+		err := cur.Decode(&playerApp)
+		if err != nil {
+			log.ErrS(err, appID)
+		} else {
+			playerIDs = append(playerIDs, playerApp.PlayerID)
+		}
+	}
+
+	return playerIDs, cur.Err()
+}
+
+// Achievements should be the active ones, counts are players by achievements unlocked
+func BuildAppAchievementStats(app App, achievements []AppAchievement, unlocks []PlayerAchievement, counts []Count) (stats AppAchievementStats) {
+
+	stats.AppID = app.ID
+	stats.AppName = app.Name
+	stats.AppIcon = app.Icon
+	stats.UpdatedAt = time.Now()
+
+	// Rarity
+	for _, bucket := range achievementRarityBuckets {
+
+		var count = 0
+		for _, achievement := range achievements {
+			if achievement.Completed >= bucket.Min && achievement.Completed < bucket.Max {
+				count++
+			}
+		}
+
+		stats.Rarity = append(stats.Rarity, AppAchievementRarityCount{Name: bucket.Name, Count: count})
+	}
+
+	// Completionists
+	for _, count := range counts {
+		stats.Players += count.Count
+		if len(achievements) > 0 && count.ID >= len(achievements) {
+			stats.Completionists += count.Count
+		}
+	}
+
+	if stats.Players > 0 {
+		stats.CompletionistPercent = helpers.RoundFloatTo2DP(float64(stats.Completionists) / float64(stats.Players) * 100)
+	}
+
+	// Unlock order & times, per player
+	var active = map[string]bool{}
+	for _, achievement := range achievements {
+		active[achievement.Key] = true
+	}
+
+	var byPlayer = map[int64][]PlayerAchievement{}
+	for _, unlock := range unlocks {
+		if active[unlock.AchievementID] && unlock.AchievementDate > 0 {
+			byPlayer[unlock.PlayerID] = append(byPlayer[unlock.PlayerID], unlock)
+		}
+	}
+
+	stats.SamplePlayers = len(byPlayer)
+
+	var positions = map[string][]int{}
+	var durations = map[string][]int64{}
+	var completions []int64
+
+	for _, playerUnlocks := range byPlayer {
+
+		sort.Slice(playerUnlocks, func(i, j int) bool {
+			return playerUnlocks[i].AchievementDate < playerUnlocks[j].AchievementDate
+		})
+
+		var first = playerUnlocks[0].AchievementDate
+
+		for k, unlock := range playerUnlocks {
+			positions[unlock.AchievementID] = append(positions[unlock.AchievementID], k+1)
+			durations[unlock.AchievementID] = append(durations[unlock.AchievementID], unlock.AchievementDate-first)
+		}
+
+		if len(playerUnlocks) == len(achievements) {
+			completions = append(completions, playerUnlocks[len(playerUnlocks)-1].AchievementDate-first)
+		}
+	}
+
+	stats.MedianCompletionSecondsSinceFirstAchievement = medianInt64(completions)
+
+	for _, achievement := range achievements {
+
+		stat := AppAchievementStat{
+			Key:       achievement.Key,
+			Name:      achievement.Name,
+			Icon:      achievement.Icon,
+			Completed: achievement.Completed,
+			Players:   len(positions[achievement.Key]),
+		}
+
+		if stat.Players > 0 {
+
+			var total = 0
+			for _, v := range positions[achievement.Key] {
+				total += v
+			}
+
+			stat.OrderAverage = helpers.RoundFloatTo2DP(float64(total) / float64(stat.Players))
+			stat.MedianSecondsSinceFirstAchievement = medianInt64(durations[achievement.Key])
+		}
+
+		stats.Achievements = append(stats.Achievements, stat)
+	}
+
+	sort.Slice(stats.Achievements, func(i, j int) bool {
+		return stats.Achievements[i].Key < stats.Achievements[j].Key
+	})
+
+	return stats
+}
+
+func medianInt64(values []int64) int64 {
+
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := append([]int64{}, values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	var middle = len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
@@ -0,0 +1,167 @@
+package mongo
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type DepotHistoryType string
+
+const (
+	DepotHistoryTypeBuild    DepotHistoryType = "build"    // A branch got a new build ID
+	DepotHistoryTypeManifest DepotHistoryType = "manifest" // A depot got a new manifest on a branch
+)
+
+// A change to an app's branches or depot manifests, taken from PICS
+type AppDepotHistory struct {
+	AppID        int              `bson:"app_id"`
+	AppName      string           `bson:"app_name"`
+	ChangeNumber int              `bson:"change_number"`
+	CreatedAt    time.Time        `bson:"created_at"`
+	Type         DepotHistoryType `bson:"type"`
+	Branch       string           `bson:"branch"`
+	DepotID      int              `bson:"depot_id"` // Only on manifest changes
+	DepotName    string           `bson:"depot_name"`
+	Before       string           `bson:"before"` // Build ID or manifest ID, empty if new
+	After        string           `bson:"after"`
+	TimeUpdated  int64            `bson:"time_updated"` // When Steam says the branch was updated
+}
+
+func (history AppDepotHistory) BSON() bson.D {
+
+	return bson.D{
+		{"_id", history.getKey()},
+		{"app_id", history.AppID},
+		{"app_name", history.AppName},
+		{"change_number", history.ChangeNumber},
+		{"created_at", history.CreatedAt},
+		{"type", history.Type},
+		{"branch", history.Branch},
+		{"depot_id", history.DepotID},
+		{"depot_name", history.DepotName},
+		{"before", history.Before},
+		{"after", history.After},
+		{"time_updated", history.TimeUpdated},
+	}
+}
+
+// Includes the change number, so rolling a branch back to an old build is saved again,
+// but the same change seen twice is only saved once
+func (history AppDepotHistory) getKey() string {
+	return strconv.Itoa(history.AppID) + "-" + strconv.Itoa(history.ChangeNumber) + "-" + string(history.Type) + "-" + history.Branch + "-" + strconv.Itoa(history.DepotID) + "-" + history.Before + "-" + history.After
+}
+
+func (history AppDepotHistory) GetAppName() string {
+	return helpers.GetAppName(history.AppID, history.AppName)
+}
+
+func (history AppDepotHistory) GetAppPath() string {
+	return helpers.GetAppPath(history.AppID, history.AppName)
+}
+
+func (history AppDepotHistory) GetDepotName() string {
+
+	if history.DepotName != "" {
+		return history.DepotName
+	}
+	if history.DepotID > 0 {
+		return "Depot " + strconv.Itoa(history.DepotID)
+	}
+	return ""
+}
+
+func (history AppDepotHistory) GetDepotPath() string {
+
+	if history.DepotID > 0 {
+		return "/depots/" + strconv.Itoa(history.DepotID)
+	}
+	return ""
+}
+
+func (history AppDepotHistory) GetChangePath() string {
+	return "/changes/" + strconv.Itoa(history.ChangeNumber)
+}
+
+func (history AppDepotHistory) OutputForJSON() (output []interface{}) {
+
+	return []interface{}{
+		history.AppID,
+		history.GetAppName(),
+		history.GetAppPath(),
+		history.Type,
+		history.Branch,
+		history.DepotID,
+		history.GetDepotName(),
+		history.GetDepotPath(),
+		history.Before,
+		history.After,
+		history.CreatedAt.Unix(),
+		history.CreatedAt.Format(helpers.DateYearTime),
+		history.ChangeNumber,
+		history.GetChangePath(),
+	}
+}
+
+func ensureAppDepotHistoryIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"app_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"depot_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"created_at", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionAppDepotHistory.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func SaveAppDepotHistories(histories []AppDepotHistory) (err error) {
+
+	if len(histories) == 0 {
+		return nil
+	}
+
+	var documents []Document
+	for _, v := range histories {
+		documents = append(documents, v)
+	}
+
+	_, err = InsertMany(CollectionAppDepotHistory, documents)
+	return err
+}
+
+func GetAppDepotHistories(offset int64, limit int64, filter bson.D) (histories []AppDepotHistory, err error) {
+
+	cur, ctx, err := find(CollectionAppDepotHistory, offset, limit, filter, bson.D{{"created_at", -1}}, nil, nil)
+	if err != nil {
+		return histories, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var history AppDepotHistory
+		err := cur.Decode(&history)
+		if err != nil {
+			log.ErrS(err, history.getKey())
+		} else {
+			histories = append(histories, history)
+		}
+	}
+
+	return histories, cur.Err()
+}
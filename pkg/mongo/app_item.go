@@ -6,8 +6,10 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Jleagle/steam-go/steamapi"
 	"github.com/gamedb/gamedb/pkg/config"
 	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
 	"github.com/gamedb/gamedb/pkg/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -15,6 +17,17 @@ import (
 	"go.uber.org/zap"
 )
 
+// One way of crafting an item, from its exchange field
+type AppItemRecipe struct {
+	Inputs []AppItemRecipeInput `json:"inputs"`
+}
+
+type AppItemRecipeInput struct {
+	ItemDefID int    `json:"item_def_id"` // Zero if a tag input
+	Tag       string `json:"tag"`         // category:value
+	Quantity  int    `json:"quantity"`
+}
+
 type AppItem struct {
 	AppID            int        `bson:"app_id"`
 	Bundle           string     `bson:"bundle"`
@@ -83,6 +96,126 @@ func (item AppItem) GetType() string {
 	return strings.Title(item.Type)
 }
 
+func (item AppItem) GetPath() string {
+	return helpers.GetAppItemPath(item.AppID, item.ItemDefID)
+}
+
+// Steam formats prices as "1;USD199;GBP149", the first part is a version
+// Price categories like "1;VLV100" are tiers, not currencies, so are skipped
+func (item AppItem) GetPrices() (prices map[steamapi.CurrencyCode]int) {
+
+	prices = map[steamapi.CurrencyCode]int{}
+
+	for _, part := range strings.Split(item.Price, ";") {
+
+		matches := itemPriceRegex.FindStringSubmatch(strings.TrimSpace(part))
+		if len(matches) != 3 || matches[1] == "VLV" {
+			continue
+		}
+
+		i, err := strconv.Atoi(matches[2])
+		if err != nil {
+			continue
+		}
+
+		prices[steamapi.CurrencyCode(matches[1])] = i
+	}
+
+	return prices
+}
+
+func (item AppItem) GetPricesFormatted() (ret map[steamapi.CurrencyCode]string) {
+
+	ret = map[steamapi.CurrencyCode]string{}
+
+	for k, v := range item.GetPrices() {
+		ret[k] = i18n.FormatPrice(k, v)
+	}
+
+	return ret
+}
+
+// Each exchange is a recipe, like "201x2,202" or "type:gem*3"
+func (item AppItem) GetRecipes() (recipes []AppItemRecipe) {
+
+	for _, exchange := range item.Exchange {
+
+		var recipe AppItemRecipe
+
+		for _, material := range strings.Split(exchange, ",") {
+
+			material = strings.TrimSpace(material)
+			if material == "" {
+				continue
+			}
+
+			input := AppItemRecipeInput{Quantity: 1}
+
+			if strings.Contains(material, ":") {
+
+				parts := strings.SplitN(material, "*", 2)
+				input.Tag = parts[0]
+				if len(parts) == 2 {
+					input.Quantity, _ = strconv.Atoi(parts[1])
+				}
+
+			} else {
+
+				parts := strings.SplitN(strings.ToLower(material), "x", 2)
+				input.ItemDefID, _ = strconv.Atoi(parts[0])
+				if len(parts) == 2 {
+					input.Quantity, _ = strconv.Atoi(parts[1])
+				}
+
+				if input.ItemDefID == 0 {
+					continue
+				}
+			}
+
+			if input.Quantity < 1 {
+				input.Quantity = 1
+			}
+
+			recipe.Inputs = append(recipe.Inputs, input)
+		}
+
+		if len(recipe.Inputs) > 0 {
+			recipes = append(recipes, recipe)
+		}
+	}
+
+	return recipes
+}
+
+// Item def IDs used in any recipe
+func (item AppItem) GetRecipeItemDefIDs() (ids []int) {
+
+	var seen = map[int]bool{}
+
+	for _, recipe := range item.GetRecipes() {
+		for _, input := range recipe.Inputs {
+			if input.ItemDefID > 0 && !seen[input.ItemDefID] {
+				seen[input.ItemDefID] = true
+				ids = append(ids, input.ItemDefID)
+			}
+		}
+	}
+
+	return ids
+}
+
+// category:value strings, for faceting
+func (item AppItem) GetTagStrings() (tags []string) {
+
+	for _, tag := range item.Tags {
+		if len(tag) == 2 {
+			tags = append(tags, tag[0]+":"+tag[1])
+		}
+	}
+
+	return tags
+}
+
 func (item *AppItem) SetTags(tagsString string) {
 	tagsString = strings.TrimSpace(tagsString)
 	if tagsString != "" {
@@ -121,6 +254,8 @@ func (item *AppItem) Link() string {
 //goland:noinspection RegExpRedundantEscape
 var bbcodeRegex = regexp.MustCompile(`\[.+?\]`)
 
+var itemPriceRegex = regexp.MustCompile(`^([A-Z]{3})([0-9]+)$`)
+
 func (item AppItem) ShortDescription() string {
 	return bbcodeRegex.ReplaceAllString(item.Description, "")
 }
@@ -168,6 +303,28 @@ func GetAppItems(offset int64, limit int64, filter bson.D, projection bson.M) (i
 	return items, cur.Err()
 }
 
+func GetAppItem(appID int, itemDefID int) (item AppItem, err error) {
+
+	item.AppID = appID
+	item.ItemDefID = itemDefID
+
+	err = FindOne(CollectionAppItems, bson.D{{"_id", item.getKey()}}, nil, nil, &item)
+	return item, err
+}
+
+// Items in the same app that use this item in one of their recipes
+func GetAppItemsUsingItem(appID int, itemDefID int) (items []AppItem, err error) {
+
+	id := strconv.Itoa(itemDefID)
+
+	var filter = bson.D{
+		{"app_id", appID},
+		{"exchange", bson.M{"$regex": "(^|,)" + id + "([xX][0-9]+)?(,|$)"}},
+	}
+
+	return GetAppItems(0, 100, filter, nil)
+}
+
 func ReplaceAppItems(items []AppItem) (err error) {
 
 	if len(items) < 1 {
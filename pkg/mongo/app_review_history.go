@@ -0,0 +1,116 @@
+package mongo
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// How long an app is flagged for after a review bomb
+const ReviewBombDays = 14
+
+// An app's review totals, one row per app per day
+type AppReviewHistory struct {
+	AppID       int                         `bson:"app_id"`
+	Date        time.Time                   `bson:"date"` // Midnight UTC
+	UpdatedAt   time.Time                   `bson:"updated_at"`
+	Positive    int                         `bson:"positive"`
+	Negative    int                         `bson:"negative"`
+	Score       float64                     `bson:"score"`
+	NewPositive int                         `bson:"new_positive"` // Since the previous row
+	NewNegative int                         `bson:"new_negative"`
+	Days        int                         `bson:"days"`      // Since the previous row, apps aren't scanned every day
+	Languages   []helpers.AppReviewLanguage `bson:"languages"` // From a sample of reviews, not totals
+	ReviewBomb  bool                        `bson:"review_bomb"`
+}
+
+func (history AppReviewHistory) BSON() bson.D {
+
+	return bson.D{
+		{"_id", history.getKey()},
+		{"app_id", history.AppID},
+		{"date", history.Date},
+		{"updated_at", history.UpdatedAt},
+		{"positive", history.Positive},
+		{"negative", history.Negative},
+		{"score", history.Score},
+		{"new_positive", history.NewPositive},
+		{"new_negative", history.NewNegative},
+		{"days", history.Days},
+		{"languages", history.Languages},
+		{"review_bomb", history.ReviewBomb},
+	}
+}
+
+// Rows from before days were saved are treated as a single day
+func (history AppReviewHistory) GetDays() int {
+
+	if history.Days < 1 {
+		return 1
+	}
+	return history.Days
+}
+
+func (history AppReviewHistory) GetNewNegativePerDay() float64 {
+	return float64(history.NewNegative) / float64(history.GetDays())
+}
+
+func (history AppReviewHistory) getKey() string {
+	return strconv.Itoa(history.AppID) + "-" + history.Date.Format(helpers.DateSQLDay)
+}
+
+func ensureAppReviewHistoryIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"app_id", 1}, {"date", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionAppReviewHistory.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+// Reviews get fetched more than once a day, the last fetch wins
+func ReplaceAppReviewHistory(history AppReviewHistory) (err error) {
+
+	_, err = ReplaceOne(CollectionAppReviewHistory, bson.D{{"_id", history.getKey()}}, history)
+	return err
+}
+
+// Newest first
+func GetAppReviewHistories(appID int, offset int64, limit int64, filter bson.D) (histories []AppReviewHistory, err error) {
+
+	filter = append(bson.D{{"app_id", appID}}, filter...)
+
+	cur, ctx, err := find(CollectionAppReviewHistory, offset, limit, filter, bson.D{{"date", -1}}, nil, nil)
+	if err != nil {
+		return histories, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var history AppReviewHistory
+		err := cur.Decode(&history)
+		if err != nil {
+			log.ErrS(err, history.getKey())
+		} else {
+			histories = append(histories, history)
+		}
+	}
+
+	return histories, cur.Err()
+}
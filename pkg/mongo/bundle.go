@@ -1,37 +1,41 @@
 package mongo
 
 import (
+	"math"
 	"time"
 
 	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
 	"github.com/gamedb/gamedb/pkg/helpers"
 	"github.com/gamedb/gamedb/pkg/i18n"
 	"github.com/gamedb/gamedb/pkg/log"
 	"github.com/gamedb/gamedb/pkg/memcache"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 const BundleTypeCompleteTheSet = "cts"
 const BundleTypePurchaseTogether = "pt"
 
 type Bundle struct {
-	Apps            []int                      `bson:"apps"`
-	CreatedAt       time.Time                  `bson:"created_at"`
-	Discount        int                        `bson:"discount"`
-	DiscountHighest int                        `bson:"discount_highest"`
-	DiscountLowest  int                        `bson:"discount_lowest"`
-	DiscountSale    int                        `bson:"discount_sale"`
-	Giftable        bool                       `bson:"giftable"`
-	Icon            string                     `bson:"icon"`
-	ID              int                        `bson:"_id"`
-	Image           string                     `bson:"image"`
-	Name            string                     `bson:"name"`
-	OnSale          bool                       `bson:"on_sale"`
-	Packages        []int                      `bson:"packages"`
-	Prices          map[steamapi.ProductCC]int `bson:"prices"`
-	PricesSale      map[steamapi.ProductCC]int `bson:"prices_sale"`
-	Type            string                     `bson:"type"`
-	UpdatedAt       time.Time                  `bson:"updated_at"`
+	Apps             []int                      `bson:"apps"`
+	CreatedAt        time.Time                  `bson:"created_at"`
+	Discount         int                        `bson:"discount"`
+	DiscountHighest  int                        `bson:"discount_highest"`
+	DiscountLowest   int                        `bson:"discount_lowest"`
+	DiscountSale     int                        `bson:"discount_sale"`
+	Giftable         bool                       `bson:"giftable"`
+	Icon             string                     `bson:"icon"`
+	ID               int                        `bson:"_id"`
+	Image            string                     `bson:"image"`
+	Name             string                     `bson:"name"`
+	OnSale           bool                       `bson:"on_sale"`
+	Packages         []int                      `bson:"packages"`
+	Prices           map[steamapi.ProductCC]int `bson:"prices"`
+	PricesSale       map[steamapi.ProductCC]int `bson:"prices_sale"`
+	PricesIndividual map[steamapi.ProductCC]int `bson:"prices_individual"` // Sum of the packages' current prices
+	Type             string                     `bson:"type"`
+	UpdatedAt        time.Time                  `bson:"updated_at"`
 }
 
 func (bundle Bundle) BSON() bson.D {
@@ -84,6 +88,8 @@ func (bundle Bundle) BSON() bson.D {
 		{"packages", bundle.Packages},
 		{"prices", bundle.Prices},
 		{"prices_sale", bundle.PricesSale},
+		{"prices_individual", bundle.PricesIndividual},
+		{"savings", bundle.GetSavings()},
 		{"type", bundle.Type},
 		{"updated_at", bundle.UpdatedAt},
 	}
@@ -141,6 +147,76 @@ func (bundle Bundle) GetPricesSaleFormatted() map[steamapi.ProductCC]string {
 	return helpers.GetBundlePricesFormatted(bundle.PricesSale)
 }
 
+// Percent saved against buying the packages individually, negative if the bundle costs more
+func (bundle Bundle) GetSavings() (savings map[steamapi.ProductCC]int) {
+
+	savings = map[steamapi.ProductCC]int{}
+
+	for code, individual := range bundle.PricesIndividual {
+
+		price, ok := bundle.PricesSale[code]
+		if !ok || price == 0 || individual == 0 {
+			continue
+		}
+
+		savings[code] = int(math.Round(float64(individual-price) / float64(individual) * 100))
+	}
+
+	return savings
+}
+
+func (bundle Bundle) GetValue(code steamapi.ProductCC, packages []Package, ownedApps map[int]bool) (value BundleValue) {
+
+	value.Currency = i18n.GetProdCC(code).CurrencyCode
+	value.Price = bundle.PricesSale[code]
+	value.Individual = bundle.PricesIndividual[code]
+	value.Packages = len(bundle.Packages)
+
+	if value.Individual > 0 && value.Price > 0 {
+		value.Saving = value.Individual - value.Price
+		value.SavingPercent = bundle.GetSavings()[code]
+	}
+
+	// Complete the set bundles only charge for the packages you don't own, with the bundle discount
+	var unowned int
+	for _, pack := range packages {
+
+		if isPackageOwned(pack, ownedApps) {
+			value.PackagesOwned++
+			continue
+		}
+
+		unowned += pack.Prices.Get(code).Final
+	}
+
+	if bundle.Type == BundleTypeCompleteTheSet && len(ownedApps) > 0 && len(packages) > 0 {
+		value.CompleteTheSet = int(math.Round(float64(unowned) * float64(100-bundle.Discount) / 100))
+	} else {
+		value.CompleteTheSet = value.Price
+	}
+
+	return value
+}
+
+func isPackageOwned(pack Package, ownedApps map[int]bool) bool {
+
+	if len(pack.Apps) == 0 {
+		return false
+	}
+
+	for _, appID := range pack.Apps {
+		if !ownedApps[appID] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (bundle Bundle) GetPricesIndividualFormatted() map[steamapi.ProductCC]string {
+	return helpers.GetBundlePricesFormatted(bundle.PricesIndividual)
+}
+
 func (bundle Bundle) GetScore() float64 {
 	return 0
 }
@@ -169,6 +245,86 @@ func (bundle Bundle) GetCreatedNice() string {
 	return bundle.CreatedAt.Format(helpers.DateYearTime)
 }
 
+// A bundle price against its packages for one region
+type BundleValue struct {
+	Currency       steamapi.CurrencyCode
+	Price          int // Bundle price
+	Individual     int // Packages bought separately
+	Saving         int
+	SavingPercent  int
+	CompleteTheSet int // Price for a player after owned packages are removed
+	Packages       int
+	PackagesOwned  int
+}
+
+func (value BundleValue) GetPriceFormatted() string {
+	return i18n.FormatPrice(value.Currency, value.Price)
+}
+
+func (value BundleValue) GetIndividualFormatted() string {
+	return i18n.FormatPrice(value.Currency, value.Individual)
+}
+
+func (value BundleValue) GetSavingFormatted() string {
+	return i18n.FormatPrice(value.Currency, value.Saving, true)
+}
+
+func (value BundleValue) GetCompleteTheSetFormatted() string {
+	return i18n.FormatPrice(value.Currency, value.CompleteTheSet)
+}
+
+// Bundles are made of packages, so the individual price is their sum
+// Regions where any package is missing a price are left out
+func GetBundleIndividualPrices(packages []Package) (prices map[steamapi.ProductCC]int) {
+
+	prices = map[steamapi.ProductCC]int{}
+
+	if len(packages) == 0 {
+		return prices
+	}
+
+	for _, prodCC := range i18n.GetProdCCs(true) {
+
+		var total int
+		var complete = true
+
+		for _, pack := range packages {
+
+			price := pack.Prices.Get(prodCC.ProductCode)
+			if !price.Exists {
+				complete = false
+				break
+			}
+
+			total += price.Final
+		}
+
+		if complete && total > 0 {
+			prices[prodCC.ProductCode] = total
+		}
+	}
+
+	return prices
+}
+
+func ensureBundleIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"packages", 1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionBundles.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
 func BatchBundles(filter bson.D, projection bson.M, callback func(bundles []Bundle)) (err error) {
 
 	var offset int64 = 0
@@ -138,3 +138,44 @@ func GetChanges(offset int64) (changes []Change, err error) {
 
 	return changes, cur.Err()
 }
+
+// Changes after a change number, oldest first
+func GetChangesAfter(id int, limit int64, appIDs []int) (changes []Change, err error) {
+
+	var filter = bson.D{{"_id", bson.M{"$gt": id}}}
+	if len(appIDs) > 0 {
+		filter = append(filter, bson.E{Key: "apps", Value: bson.M{"$in": appIDs}})
+	}
+
+	cur, ctx, err := find(CollectionChanges, 0, limit, filter, bson.D{{"_id", 1}}, nil, nil)
+	if err != nil {
+		return changes, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var change Change
+		err := cur.Decode(&change)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, cur.Err()
+}
+
+func GetLatestChangeID() (id int, err error) {
+
+	var change Change
+
+	err = FindOne(CollectionChanges, bson.D{}, bson.D{{"_id", -1}}, bson.M{"_id": 1}, &change)
+	if err == ErrNoDocuments {
+		return 0, nil
+	}
+
+	return change.ID, err
+}
@@ -0,0 +1,135 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// The parts of the driver this package uses, so tests can swap in a Fake
+type Client interface {
+	Connect(ctx context.Context) error
+	Ping(ctx context.Context, rp *readpref.ReadPref) error
+	Disconnect(ctx context.Context) error
+	NumberSessionsInProgress() int
+	Database(name string, opts ...*options.DatabaseOptions) Database
+}
+
+type Database interface {
+	Collection(name string, opts ...*options.CollectionOptions) Collection
+}
+
+type Collection interface {
+	Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (Cursor, error)
+	BulkWrite(ctx context.Context, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error)
+	CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error)
+	DeleteMany(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	DeleteOne(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	Distinct(ctx context.Context, fieldName string, filter interface{}, opts ...*options.DistinctOptions) ([]interface{}, error)
+	EstimatedDocumentCount(ctx context.Context, opts ...*options.EstimatedDocumentCountOptions) (int64, error)
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (Cursor, error)
+	FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) SingleResult
+	FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) SingleResult
+	Indexes() IndexView
+	InsertMany(ctx context.Context, documents []interface{}, opts ...*options.InsertManyOptions) (*mongo.InsertManyResult, error)
+	InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error)
+	ReplaceOne(ctx context.Context, filter interface{}, replacement interface{}, opts ...*options.ReplaceOptions) (*mongo.UpdateResult, error)
+	UpdateMany(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+	UpdateOne(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+}
+
+type IndexView interface {
+	CreateMany(ctx context.Context, models []mongo.IndexModel, opts ...*options.CreateIndexesOptions) ([]string, error)
+}
+
+// Need to close cursor after using it
+type Cursor interface {
+	Next(ctx context.Context) bool
+	Current() bson.Raw
+	Decode(val interface{}) error
+	Err() error
+	Close(ctx context.Context) error
+}
+
+type SingleResult interface {
+	Decode(v interface{}) error
+	Err() error
+}
+
+// Replaces the client in tests, nil will connect to the real server again.
+// Panics outside of tests, so the live client can't be swapped.
+func SetTestClient(c Client) {
+
+	if !config.IsTest() {
+		panic("mongo.SetTestClient called outside of a test")
+	}
+
+	mongoClientLock.Lock()
+	defer mongoClientLock.Unlock()
+
+	mongoClient = c
+	mongoCtx = context.Background()
+}
+
+// Wrappers around the driver
+type driverClient struct {
+	*mongo.Client
+}
+
+func (c driverClient) Database(name string, opts ...*options.DatabaseOptions) Database {
+	return driverDatabase{c.Client.Database(name, opts...)}
+}
+
+type driverDatabase struct {
+	*mongo.Database
+}
+
+func (d driverDatabase) Collection(name string, opts ...*options.CollectionOptions) Collection {
+	return driverCollection{d.Database.Collection(name, opts...)}
+}
+
+type driverCollection struct {
+	*mongo.Collection
+}
+
+func (c driverCollection) Aggregate(ctx context.Context, pipeline interface{}, opts ...*options.AggregateOptions) (Cursor, error) {
+
+	cur, err := c.Collection.Aggregate(ctx, pipeline, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return driverCursor{cur}, nil
+}
+
+func (c driverCollection) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (Cursor, error) {
+
+	cur, err := c.Collection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return driverCursor{cur}, nil
+}
+
+func (c driverCollection) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) SingleResult {
+	return c.Collection.FindOne(ctx, filter, opts...)
+}
+
+func (c driverCollection) FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) SingleResult {
+	return c.Collection.FindOneAndUpdate(ctx, filter, update, opts...)
+}
+
+func (c driverCollection) Indexes() IndexView {
+	return c.Collection.Indexes()
+}
+
+type driverCursor struct {
+	*mongo.Cursor
+}
+
+func (c driverCursor) Current() bson.Raw {
+	return c.Cursor.Current
+}
@@ -0,0 +1,219 @@
+package mongo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/memcache"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Out of 100
+const (
+	coopWeightOwners   = 40
+	coopWeightTags     = 20
+	coopWeightReviews  = 20
+	coopWeightRecent   = 15
+	coopWeightPrice    = 5
+	coopExpensivePrice = 6000 // USD cents, the price a missing owner gets no price points at
+)
+
+// A game a group of players could play together
+type CoopGame struct {
+	App           App
+	Owners        []int64 // Players in the group that own the game
+	Missing       []int64 // Players in the group that need to buy it
+	Tags          []string
+	RecentMinutes int // Playtime in the last two weeks, across the group
+	Price         helpers.ProductPrice
+	MissingPrice  int // Cost for every missing player to buy it
+	Score         float64
+}
+
+func (game CoopGame) OwnedByAll() bool {
+	return len(game.Missing) == 0
+}
+
+func (game CoopGame) GetMissingPrice() string {
+	return i18n.FormatPrice(game.Price.Currency, game.MissingPrice)
+}
+
+// How many of the group need to own a game for it to be suggested
+func CoopMinOwners(players int) int {
+	if players < 2 {
+		return players
+	}
+	return players/2 + 1
+}
+
+// Games owned by all or most of the players, best first.
+// The full list is cached, so paging through it only scores the games once
+func GetCoopGames(playerIDs []int64, code steamapi.ProductCC, limit int) (games []CoopGame, err error) {
+
+	playerIDs = helpers.UniqueInt64(playerIDs)
+	if len(playerIDs) == 0 {
+		return games, nil
+	}
+
+	var item = memcache.ItemCoopGames(playerIDs, code)
+
+	err = memcache.Client().GetSet(item.Key, item.Expiration, &games, func() (interface{}, error) {
+		return getCoopGames(playerIDs, code)
+	})
+	if err != nil {
+		return games, err
+	}
+
+	if limit > 0 && len(games) > limit {
+		games = games[0:limit]
+	}
+
+	return games, nil
+}
+
+func getCoopGames(playerIDs []int64, code steamapi.ProductCC) (games []CoopGame, err error) {
+
+	// Owners
+	playerApps, err := GetPlayerAppsByPlayers(playerIDs, bson.M{"_id": 0, "player_id": 1, "app_id": 1})
+	if err != nil {
+		return games, err
+	}
+
+	var owners = map[int][]int64{}
+	for _, v := range playerApps {
+		owners[v.AppID] = append(owners[v.AppID], v.PlayerID)
+	}
+
+	var minOwners = CoopMinOwners(len(playerIDs))
+	var appIDs = bson.A{}
+	for appID, appOwners := range owners {
+		if len(appOwners) >= minOwners {
+			appIDs = append(appIDs, appID)
+		}
+	}
+
+	if len(appIDs) == 0 {
+		return games, nil
+	}
+
+	// Multiplayer apps
+	var tags = bson.A{}
+	for k := range CoopTags {
+		tags = append(tags, k)
+	}
+	for k := range MultiplayerTags {
+		tags = append(tags, k)
+	}
+
+	filter := bson.D{
+		{"_id", bson.M{"$in": appIDs}},
+		{"tags", bson.M{"$in": tags}},
+	}
+
+	projection := bson.M{"_id": 1, "name": 1, "icon": 1, "platforms": 1, "achievements_count": 1, "tags": 1, "reviews_score": 1, "prices": 1}
+
+	apps, err := GetApps(0, 0, nil, filter, projection)
+	if err != nil {
+		return games, err
+	}
+
+	// Recent playtime
+	recentApps, err := GetRecentAppsByPlayers(playerIDs, bson.M{"_id": 0, "player_id": 1, "app_id": 1, "playtime_2_weeks": 1})
+	if err != nil {
+		return games, err
+	}
+
+	var recent = map[int]map[int64]int{}
+	for _, v := range recentApps {
+		if _, ok := recent[v.AppID]; !ok {
+			recent[v.AppID] = map[int64]int{}
+		}
+		recent[v.AppID][v.PlayerID] = v.PlayTime2Weeks
+	}
+
+	rates, err := GetExchangeRates()
+	if err != nil {
+		return games, err
+	}
+
+	return ScoreCoopGames(playerIDs, apps, owners, recent, code, rates), nil
+}
+
+// Ranks apps for the group, apps need tags, reviews score & prices
+func ScoreCoopGames(playerIDs []int64, apps []App, owners map[int][]int64, recent map[int]map[int64]int, code steamapi.ProductCC, rates helpers.ExchangeRates) (games []CoopGame) {
+
+	var minOwners = CoopMinOwners(len(playerIDs))
+
+	for _, app := range apps {
+
+		game := CoopGame{
+			App:   app,
+			Tags:  app.GetCoopTagNames(),
+			Price: app.Prices.Get(code),
+		}
+
+		if len(game.Tags) == 0 {
+			continue
+		}
+
+		for _, playerID := range playerIDs {
+			if helpers.SliceHasInt64(owners[app.ID], playerID) {
+				game.Owners = append(game.Owners, playerID)
+			} else {
+				game.Missing = append(game.Missing, playerID)
+			}
+		}
+
+		if len(game.Owners) < minOwners {
+			continue
+		}
+
+		var recentPlayers = 0
+		for _, playerID := range game.Owners {
+			if minutes := recent[app.ID][playerID]; minutes > 0 {
+				game.RecentMinutes += minutes
+				recentPlayers++
+			}
+		}
+
+		if game.Price.Exists && !game.Price.Free {
+			game.MissingPrice = game.Price.Final * len(game.Missing)
+		}
+
+		// Score
+		var score = coopWeightOwners * float64(len(game.Owners)) / float64(len(playerIDs))
+
+		if app.IsCoop() {
+			score += coopWeightTags
+		} else {
+			score += coopWeightTags / 2
+		}
+
+		score += coopWeightReviews * app.ReviewsScore / 100
+		score += coopWeightRecent * float64(recentPlayers) / float64(len(playerIDs))
+
+		if len(game.Missing) == 0 || game.Price.Free || !game.Price.Exists {
+			score += coopWeightPrice
+		} else if price, ok := rates.Convert(game.Price.Final, game.Price.Currency, steamapi.CurrencyUSD); ok {
+			score += coopWeightPrice * math.Max(0, 1-float64(price)/coopExpensivePrice)
+		} else {
+			score += float64(coopWeightPrice) / 2 // No rate for the currency
+		}
+
+		game.Score = helpers.RoundFloatTo2DP(score)
+
+		games = append(games, game)
+	}
+
+	sort.Slice(games, func(i, j int) bool {
+		if games[i].Score == games[j].Score {
+			return games[i].App.ID < games[j].App.ID
+		}
+		return games[i].Score > games[j].Score
+	})
+
+	return games
+}
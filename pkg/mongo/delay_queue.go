@@ -0,0 +1,130 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Messages waiting to be retried, moved out of Rabbit so they are not re-published until due
+type DelayedMessage struct {
+	UUID        string    `bson:"_id"`
+	CreatedAt   time.Time `bson:"created_at"`
+	DueAt       time.Time `bson:"due_at"`
+	Queue       string    `bson:"queue"` // The queue it gets delivered back to
+	FirstQueue  string    `bson:"first_queue"`
+	FirstSeen   time.Time `bson:"first_seen"`
+	Attempt     int       `bson:"attempt"`
+	ContentType string    `bson:"content_type"`
+	Body        []byte    `bson:"body"`
+}
+
+func (dm DelayedMessage) BSON() bson.D {
+
+	return bson.D{
+		{"_id", dm.UUID},
+		{"created_at", dm.CreatedAt},
+		{"due_at", dm.DueAt},
+		{"queue", dm.Queue},
+		{"first_queue", dm.FirstQueue},
+		{"first_seen", dm.FirstSeen},
+		{"attempt", dm.Attempt},
+		{"content_type", dm.ContentType},
+		{"body", dm.Body},
+	}
+}
+
+func ensureDelayQueueIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"due_at", 1}}},
+		{Keys: bson.D{{"queue", 1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionDelayQueue.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func SaveDelayedMessage(dm DelayedMessage) (err error) {
+
+	_, err = ReplaceOne(CollectionDelayQueue, bson.D{{"_id", dm.UUID}}, dm)
+	return err
+}
+
+// Claims the next due message by pushing its due time back by the lease.
+// If the message is not deleted before the lease ends, it will be claimed again.
+func ClaimDelayedMessage(lease time.Duration) (dm DelayedMessage, found bool, err error) {
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return dm, false, err
+	}
+
+	now := time.Now()
+
+	ops := options.FindOneAndUpdate().
+		SetSort(bson.D{{"due_at", 1}}).
+		SetReturnDocument(options.Before)
+
+	err = client.Database(config.C.MongoDatabase).
+		Collection(CollectionDelayQueue.String()).
+		FindOneAndUpdate(ctx, bson.D{{"due_at", bson.M{"$lte": now}}}, bson.M{"$set": bson.M{"due_at": now.Add(lease)}}, ops).
+		Decode(&dm)
+
+	if err == ErrNoDocuments {
+		return dm, false, nil
+	}
+
+	return dm, err == nil, err
+}
+
+func DeleteDelayedMessage(uuid string) (err error) {
+
+	_, err = DeleteOne(CollectionDelayQueue, bson.D{{"_id", uuid}})
+	return err
+}
+
+// Pending messages per queue
+func GetDelayedMessageCounts() (counts []StringCount, err error) {
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return counts, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$group", Value: bson.M{"_id": "$queue", "count": bson.M{"$sum": 1}}}},
+	}
+
+	cur, err := client.Database(config.C.MongoDatabase, options.Database()).Collection(CollectionDelayQueue.String()).Aggregate(ctx, pipeline, options.Aggregate())
+	if err != nil {
+		return counts, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var count StringCount
+		err := cur.Decode(&count)
+		if err != nil {
+			log.ErrS(err, count.ID)
+			continue
+		}
+		counts = append(counts, count)
+	}
+
+	return counts, cur.Err()
+}
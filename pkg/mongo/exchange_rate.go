@@ -0,0 +1,174 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/memcache"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// One per currency per day
+type ExchangeRate struct {
+	Currency  steamapi.CurrencyCode `bson:"currency"`
+	Rate      float64               `bson:"rate"` // Per US dollar
+	Provider  string                `bson:"provider"`
+	CreatedAt time.Time             `bson:"created_at"`
+}
+
+func (rate ExchangeRate) BSON() bson.D {
+
+	return bson.D{
+		{"_id", rate.getKey()},
+		{"currency", rate.Currency},
+		{"rate", rate.Rate},
+		{"provider", rate.Provider},
+		{"created_at", rate.CreatedAt},
+	}
+}
+
+func (rate ExchangeRate) getKey() string {
+	return string(rate.Currency) + "-" + rate.CreatedAt.Format(helpers.DateSQLDay)
+}
+
+func ensureExchangeRateIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"currency", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"created_at", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionExchangeRates.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+// Replaces today's rates
+func SaveExchangeRates(rates helpers.ExchangeRates, provider string) (err error) {
+
+	if len(rates) == 0 {
+		return nil
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return err
+	}
+
+	var now = time.Now()
+	var writes []mongo.WriteModel
+
+	for currency, value := range rates {
+
+		rate := ExchangeRate{
+			Currency:  currency,
+			Rate:      value,
+			Provider:  provider,
+			CreatedAt: now,
+		}
+
+		write := mongo.NewReplaceOneModel()
+		write.SetFilter(bson.M{"_id": rate.getKey()})
+		write.SetReplacement(rate.BSON())
+		write.SetUpsert(true)
+
+		writes = append(writes, write)
+	}
+
+	collection := client.Database(config.C.MongoDatabase).Collection(CollectionExchangeRates.String())
+	_, err = collection.BulkWrite(ctx, writes, options.BulkWrite())
+	if err != nil {
+		return err
+	}
+
+	return memcache.Client().Delete(memcache.ItemExchangeRates.Key)
+}
+
+// The latest rate for each currency
+func GetLatestExchangeRates() (rates []ExchangeRate, err error) {
+
+	err = memcache.Client().GetSet(memcache.ItemExchangeRates.Key, memcache.ItemExchangeRates.Expiration, &rates, func() (interface{}, error) {
+
+		var rates []ExchangeRate
+		var seen = map[steamapi.CurrencyCode]bool{}
+
+		// Only look at the last week, in case a currency stops being returned
+		filter := bson.D{{"created_at", bson.M{"$gte": time.Now().AddDate(0, 0, -7)}}}
+
+		cur, ctx, err := find(CollectionExchangeRates, 0, 0, filter, bson.D{{"created_at", -1}}, nil, nil)
+		if err != nil {
+			return rates, err
+		}
+
+		defer closeCursor(cur, ctx)
+
+		for cur.Next(ctx) {
+
+			var rate ExchangeRate
+			err := cur.Decode(&rate)
+			if err != nil {
+				log.ErrS(err)
+				continue
+			}
+
+			if !seen[rate.Currency] {
+				seen[rate.Currency] = true
+				rates = append(rates, rate)
+			}
+		}
+
+		return rates, cur.Err()
+	})
+
+	return rates, err
+}
+
+func GetExchangeRates() (rates helpers.ExchangeRates, err error) {
+
+	latest, err := GetLatestExchangeRates()
+	if err != nil {
+		return rates, err
+	}
+
+	rates = helpers.ExchangeRates{}
+	for _, v := range latest {
+		rates[v.Currency] = v.Rate
+	}
+
+	return rates, nil
+}
+
+func GetExchangeRateHistory(currency steamapi.CurrencyCode, limit int64) (rates []ExchangeRate, err error) {
+
+	cur, ctx, err := find(CollectionExchangeRates, 0, limit, bson.D{{"currency", currency}}, bson.D{{"created_at", -1}}, nil, nil)
+	if err != nil {
+		return rates, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var rate ExchangeRate
+		err := cur.Decode(&rate)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			rates = append(rates, rate)
+		}
+	}
+
+	return rates, cur.Err()
+}
@@ -0,0 +1,168 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Messages drained from the failed queue
+type FailedMessage struct {
+	UUID       string     `bson:"_id"`
+	CreatedAt  time.Time  `bson:"created_at"` // When it was drained
+	FirstSeen  time.Time  `bson:"first_seen"`
+	LastSeen   time.Time  `bson:"last_seen"`
+	FirstQueue string     `bson:"first_queue"`
+	Queue      string     `bson:"queue"` // The queue it failed in, and gets replayed to
+	Attempt    int        `bson:"attempt"`
+	Reason     string     `bson:"reason"`
+	Body       string     `bson:"body"`
+	Edited     bool       `bson:"edited"`
+	ReplayedAt *time.Time `bson:"replayed_at"`
+}
+
+func (fm FailedMessage) BSON() bson.D {
+
+	return bson.D{
+		{"_id", fm.UUID},
+		{"created_at", fm.CreatedAt},
+		{"first_seen", fm.FirstSeen},
+		{"last_seen", fm.LastSeen},
+		{"first_queue", fm.FirstQueue},
+		{"queue", fm.Queue},
+		{"attempt", fm.Attempt},
+		{"reason", fm.Reason},
+		{"body", fm.Body},
+		{"edited", fm.Edited},
+		{"replayed_at", fm.ReplayedAt},
+	}
+}
+
+func (fm FailedMessage) GetPath() string {
+	return "/admin/failed/" + fm.UUID
+}
+
+func (fm FailedMessage) IsReplayed() bool {
+	return fm.ReplayedAt != nil
+}
+
+func (fm FailedMessage) GetBodyShort() string {
+	return helpers.TruncateString(fm.Body, 100, "…")
+}
+
+func ensureFailedMessageIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"created_at", -1}}},
+		{Keys: bson.D{{"queue", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"replayed_at", 1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionFailedMessages.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+// Filters used by the admin pages and the CLI
+func FailedMessagesFilter(queues []string, reason string, replayed *bool) bson.D {
+
+	filter := bson.D{}
+
+	if len(queues) > 0 {
+		filter = append(filter, bson.E{Key: "queue", Value: bson.M{"$in": queues}})
+	}
+
+	if reason != "" {
+		filter = append(filter, bson.E{Key: "reason", Value: bson.M{"$regex": reason, "$options": "i"}})
+	}
+
+	if replayed != nil {
+		if *replayed {
+			filter = append(filter, bson.E{Key: "replayed_at", Value: bson.M{"$ne": nil}})
+		} else {
+			filter = append(filter, bson.E{Key: "replayed_at", Value: nil})
+		}
+	}
+
+	return filter
+}
+
+func SaveFailedMessage(fm FailedMessage) (err error) {
+
+	_, err = ReplaceOne(CollectionFailedMessages, bson.D{{"_id", fm.UUID}}, fm)
+	return err
+}
+
+func GetFailedMessage(uuid string) (fm FailedMessage, err error) {
+
+	err = FindOne(CollectionFailedMessages, bson.D{{"_id", uuid}}, nil, nil, &fm)
+	return fm, err
+}
+
+func GetFailedMessages(offset int64, limit int64, filter bson.D) (messages []FailedMessage, err error) {
+
+	cur, ctx, err := find(CollectionFailedMessages, offset, limit, filter, bson.D{{"created_at", -1}}, nil, nil)
+	if err != nil {
+		return messages, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var fm FailedMessage
+		err := cur.Decode(&fm)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			messages = append(messages, fm)
+		}
+	}
+
+	return messages, cur.Err()
+}
+
+func GetFailedMessagesByUUID(uuids []string) (messages []FailedMessage, err error) {
+
+	if len(uuids) == 0 {
+		return messages, nil
+	}
+
+	return GetFailedMessages(0, 0, bson.D{{"_id", bson.M{"$in": uuids}}})
+}
+
+func UpdateFailedMessageBody(uuid string, body string) (err error) {
+
+	_, err = UpdateOne(CollectionFailedMessages, bson.D{{"_id", uuid}}, bson.D{{"body", body}, {"edited", true}})
+	return err
+}
+
+func SetFailedMessagesReplayed(uuids []string) (err error) {
+
+	if len(uuids) == 0 {
+		return nil
+	}
+
+	_, err = UpdateManySet(CollectionFailedMessages, bson.D{{"_id", bson.M{"$in": uuids}}}, bson.D{{"replayed_at", time.Now()}})
+	return err
+}
+
+func DeleteFailedMessages(filter bson.D) (count int64, err error) {
+
+	resp, err := DeleteMany(CollectionFailedMessages, filter)
+	if resp != nil {
+		count = resp.DeletedCount
+	}
+	return count, err
+}
@@ -0,0 +1,354 @@
+package mongo
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gosimple/slug"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Apps in the same name series that share a publisher or developer
+type Franchise struct {
+	ID              string                     `bson:"_id"` // Slug of the series name
+	Name            string                     `bson:"name"`
+	IconAppID       int                        `bson:"icon_app_id"` // The most played app
+	Icon            string                     `bson:"icon"`
+	AppIDs          []int                      `bson:"app_ids"`
+	Apps            int                        `bson:"apps"`
+	Publishers      []int                      `bson:"publishers"`
+	Developers      []int                      `bson:"developers"`
+	PlayersWeek     int                        `bson:"players_week"`    // Sum of each app's weekly peak
+	PlayersAllTime  int                        `bson:"players_alltime"` // Sum of each app's all time peak
+	Owners          int64                      `bson:"owners"`
+	ReviewsPositive int                        `bson:"reviews_positive"`
+	ReviewsNegative int                        `bson:"reviews_negative"`
+	ReviewsScore    float64                    `bson:"reviews_score"` // Weighted by review count
+	Prices          map[steamapi.ProductCC]int `bson:"prices"`        // Cost of every app
+	UpdatedAt       time.Time                  `bson:"updated_at"`
+}
+
+func (franchise Franchise) BSON() bson.D {
+
+	return bson.D{
+		{"_id", franchise.ID},
+		{"name", franchise.Name},
+		{"icon_app_id", franchise.IconAppID},
+		{"icon", franchise.Icon},
+		{"app_ids", franchise.AppIDs},
+		{"apps", franchise.Apps},
+		{"publishers", franchise.Publishers},
+		{"developers", franchise.Developers},
+		{"players_week", franchise.PlayersWeek},
+		{"players_alltime", franchise.PlayersAllTime},
+		{"owners", franchise.Owners},
+		{"reviews_positive", franchise.ReviewsPositive},
+		{"reviews_negative", franchise.ReviewsNegative},
+		{"reviews_score", franchise.ReviewsScore},
+		{"prices", franchise.Prices},
+		{"updated_at", franchise.UpdatedAt},
+	}
+}
+
+func (franchise Franchise) GetPath() string {
+	return "/franchise/" + franchise.ID
+}
+
+func (franchise Franchise) GetIcon() string {
+	return helpers.GetAppIcon(franchise.IconAppID, franchise.Icon)
+}
+
+func (franchise Franchise) GetReviewScore() string {
+	return helpers.GetAppReviewScore(franchise.ReviewsScore)
+}
+
+func (franchise Franchise) GetPrice(code steamapi.ProductCC) string {
+
+	price, ok := franchise.Prices[code]
+	if !ok {
+		return "-"
+	}
+	return i18n.FormatPrice(i18n.GetProdCC(code).CurrencyCode, price)
+}
+
+func ensureFranchiseIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"name", 1}}},
+		{Keys: bson.D{{"apps", -1}}},
+		{Keys: bson.D{{"players_week", -1}}},
+		{Keys: bson.D{{"owners", -1}}},
+		{Keys: bson.D{{"reviews_score", -1}}},
+		{Keys: bson.D{{"app_ids", 1}}},
+		{Keys: bson.D{{"updated_at", 1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionFranchises.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func GetFranchise(id string) (franchise Franchise, err error) {
+
+	err = FindOne(CollectionFranchises, bson.D{{"_id", id}}, nil, nil, &franchise)
+	return franchise, err
+}
+
+func GetFranchises(offset int64, limit int64, filter bson.D, sort bson.D) (franchises []Franchise, err error) {
+
+	cur, ctx, err := find(CollectionFranchises, offset, limit, filter, sort, nil, nil)
+	if err != nil {
+		return franchises, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var franchise Franchise
+		err := cur.Decode(&franchise)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			franchises = append(franchises, franchise)
+		}
+	}
+
+	return franchises, cur.Err()
+}
+
+func GetFranchiseByApp(appID int) (franchise Franchise, err error) {
+
+	err = FindOne(CollectionFranchises, bson.D{{"app_ids", appID}}, nil, nil, &franchise)
+	return franchise, err
+}
+
+// Upserts the franchises and deletes any that no longer exist
+func ReplaceFranchises(franchises []Franchise) (err error) {
+
+	var started = time.Now()
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return err
+	}
+
+	var writes []mongo.WriteModel
+	for _, franchise := range franchises {
+
+		franchise.UpdatedAt = started
+
+		write := mongo.NewReplaceOneModel()
+		write.SetFilter(bson.M{"_id": franchise.ID})
+		write.SetReplacement(franchise.BSON())
+		write.SetUpsert(true)
+
+		writes = append(writes, write)
+	}
+
+	if len(writes) > 0 {
+
+		collection := client.Database(config.C.MongoDatabase).Collection(CollectionFranchises.String())
+		_, err = collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = DeleteMany(CollectionFranchises, bson.D{{"updated_at", bson.M{"$lt": started}}})
+	return err
+}
+
+var (
+	franchiseSubtitle = regexp.MustCompile(`\s*(:|\s-\s|\s–\s|\s—\s).*$`)
+	franchiseSymbols  = regexp.MustCompile(`[™®©]`)
+	franchiseSuffix   = regexp.MustCompile(`(?i)(\s+(\d{1,4}|[ivx]+|remastered|remake|definitive edition|deluxe edition|goty( edition)?|game of the year( edition)?|complete edition|enhanced edition|hd))+$`)
+)
+
+// The name of the series an app belongs to, or blank if the name is too short to group on
+func FranchiseSeriesName(name string) string {
+
+	name = franchiseSymbols.ReplaceAllString(name, "")
+	name = franchiseSubtitle.ReplaceAllString(name, "")
+	name = franchiseSuffix.ReplaceAllString(name, "")
+	name = strings.TrimSpace(strings.Trim(name, " .,!-"))
+
+	if len(name) < 3 {
+		return ""
+	}
+	return name
+}
+
+// Apps need id, name, icon, publishers, developers, player peaks, owners, reviews & prices
+func BuildFranchises(apps []App) (franchises []Franchise) {
+
+	// Group on series name
+	var series = map[string][]App{}
+	for _, app := range apps {
+
+		name := FranchiseSeriesName(app.GetName())
+		if name == "" || (len(app.Publishers) == 0 && len(app.Developers) == 0) {
+			continue
+		}
+
+		key := slug.Make(name)
+		series[key] = append(series[key], app)
+	}
+
+	for key, seriesApps := range series {
+
+		if len(seriesApps) < 2 {
+			continue
+		}
+
+		group := largestFranchiseGroup(seriesApps)
+		if len(group) < 2 {
+			continue
+		}
+
+		franchises = append(franchises, newFranchise(key, group))
+	}
+
+	sort.Slice(franchises, func(i, j int) bool {
+		return franchises[i].ID < franchises[j].ID
+	})
+
+	return franchises
+}
+
+// Apps in a series linked by a shared publisher or developer
+func largestFranchiseGroup(apps []App) (largest []App) {
+
+	var parents = make([]int, len(apps))
+	for k := range parents {
+		parents[k] = k
+	}
+
+	root := func(i int) int {
+		for parents[i] != i {
+			parents[i] = parents[parents[i]]
+			i = parents[i]
+		}
+		return i
+	}
+
+	// Index of the first app seen with each company
+	var seen = map[string]int{}
+	for k, app := range apps {
+
+		var companies []string
+		for _, v := range app.Publishers {
+			companies = append(companies, "p"+strconv.Itoa(v))
+		}
+		for _, v := range app.Developers {
+			companies = append(companies, "d"+strconv.Itoa(v))
+		}
+
+		for _, company := range companies {
+			if first, ok := seen[company]; ok {
+				parents[root(k)] = root(first)
+			} else {
+				seen[company] = k
+			}
+		}
+	}
+
+	var groups = map[int][]App{}
+	for k, app := range apps {
+		groups[root(k)] = append(groups[root(k)], app)
+	}
+
+	for _, group := range groups {
+		if len(group) > len(largest) || (len(group) == len(largest) && group[0].ID < largest[0].ID) {
+			largest = group
+		}
+	}
+
+	return largest
+}
+
+func newFranchise(id string, apps []App) (franchise Franchise) {
+
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].ID < apps[j].ID
+	})
+
+	franchise.ID = id
+	franchise.Name = FranchiseSeriesName(apps[0].GetName())
+	franchise.Apps = len(apps)
+	franchise.Prices = map[steamapi.ProductCC]int{}
+
+	var publishers = map[int]bool{}
+	var developers = map[int]bool{}
+	var mostPlayed = -1
+	var reviewsWeighted float64
+	var reviewsCount int
+
+	for _, app := range apps {
+
+		franchise.AppIDs = append(franchise.AppIDs, app.ID)
+		franchise.PlayersWeek += app.PlayerPeakWeek
+		franchise.PlayersAllTime += app.PlayerPeakAllTime
+		franchise.Owners += app.Owners
+		franchise.ReviewsPositive += app.Reviews.Positive
+		franchise.ReviewsNegative += app.Reviews.Negative
+
+		if app.Reviews.GetTotal() > 0 {
+			reviewsWeighted += app.ReviewsScore * float64(app.Reviews.GetTotal())
+			reviewsCount += app.Reviews.GetTotal()
+		}
+
+		if app.PlayerPeakWeek > mostPlayed {
+			mostPlayed = app.PlayerPeakWeek
+			franchise.IconAppID = app.ID
+			franchise.Icon = app.Icon
+		}
+
+		for _, v := range app.Publishers {
+			publishers[v] = true
+		}
+		for _, v := range app.Developers {
+			developers[v] = true
+		}
+
+		for _, cc := range i18n.GetProdCCs(true) {
+			price := app.Prices.Get(cc.ProductCode)
+			if price.Exists && !price.Free {
+				franchise.Prices[cc.ProductCode] += price.Final
+			}
+		}
+	}
+
+	if reviewsCount > 0 {
+		franchise.ReviewsScore = helpers.RoundFloatTo2DP(reviewsWeighted / float64(reviewsCount))
+	}
+
+	for k := range publishers {
+		franchise.Publishers = append(franchise.Publishers, k)
+	}
+	for k := range developers {
+		franchise.Developers = append(franchise.Developers, k)
+	}
+
+	sort.Ints(franchise.Publishers)
+	sort.Ints(franchise.Developers)
+
+	return franchise
+}
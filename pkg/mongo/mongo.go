@@ -28,9 +28,12 @@ func (c collection) String() string {
 
 const (
 	CollectionAppAchievements     collection = "app_achievements"
+	CollectionAppAchievementStats collection = "app_achievement_stats"
 	CollectionAppArticles         collection = "app_articles"
+	CollectionAppDepotHistory     collection = "app_depot_history"
 	CollectionAppDLC              collection = "app_dlc"
 	CollectionAppItems            collection = "app_items"
+	CollectionAppReviewHistory    collection = "app_review_history"
 	CollectionApps                collection = "apps"
 	CollectionAppSales            collection = "app_offers"
 	CollectionAppSameOwners       collection = "app_same_owners"
@@ -42,6 +45,9 @@ const (
 	CollectionDelayQueue          collection = "delay_queue"
 	CollectionDiscordGuilds       collection = "discord_guilds"
 	CollectionEvents              collection = "events"
+	CollectionExchangeRates       collection = "exchange_rates"
+	CollectionFailedMessages      collection = "failed_messages"
+	CollectionFranchises          collection = "franchises"
 	CollectionGroups              collection = "groups"
 	CollectionPackageApps         collection = "package_apps"
 	CollectionPackages            collection = "packages"
@@ -52,21 +58,26 @@ const (
 	CollectionPlayerAppsRecent    collection = "player_apps_recent"
 	CollectionPlayerBadges        collection = "player_badges"
 	CollectionPlayerBadgesSummary collection = "player_badges_summary"
+	CollectionPlayerExports       collection = "player_exports"
 	CollectionPlayerFriends       collection = "player_friends"
 	CollectionPlayerGroups        collection = "player_groups"
+	CollectionPlayerHistory       collection = "player_history"
 	CollectionPlayers             collection = "players"
 	CollectionPlayerWishlistApps  collection = "player_wishlist_apps"
 	CollectionProductPrices       collection = "product_prices"
+	CollectionProductPriceStats   collection = "product_price_stats"
+	CollectionProductPICSHistory  collection = "product_pics_history"
 	CollectionStats               collection = "stats"
+	CollectionWebhookDeliveries   collection = "webhook_deliveries"
 )
 
 var (
-	mongoClient     *mongo.Client
+	mongoClient     Client
 	mongoCtx        context.Context
 	mongoClientLock sync.Mutex
 )
 
-func getMongo() (client *mongo.Client, ctx context.Context, err error) {
+func getMongo() (client Client, ctx context.Context, err error) {
 
 	mongoClientLock.Lock()
 	defer mongoClientLock.Unlock()
@@ -91,12 +102,13 @@ func getMongo() (client *mongo.Client, ctx context.Context, err error) {
 			ApplyURI(config.MongoDSN()).
 			SetAppName("Global Steam")
 
-		client, err = mongo.NewClient(ops)
-
+		c, err := mongo.NewClient(ops)
 		if err != nil {
-			return client, ctx, err
+			return nil, ctx, err
 		}
 
+		client = driverClient{c}
+
 		err = client.Connect(ctx)
 		if err != nil {
 			return client, ctx, err
@@ -114,7 +126,7 @@ func getMongo() (client *mongo.Client, ctx context.Context, err error) {
 	return mongoClient, mongoCtx, err
 }
 
-func closeCursor(cur *mongo.Cursor, ctx context.Context) {
+func closeCursor(cur Cursor, ctx context.Context) {
 	err := cur.Close(ctx)
 	if err != nil {
 		log.ErrS(err)
@@ -124,6 +136,7 @@ func closeCursor(cur *mongo.Cursor, ctx context.Context) {
 func EnsureIndexes() {
 	log.Info("Starting migrations")
 	ensureAppIndexes()
+	ensureBundleIndexes()
 	ensureGroupIndexes()
 	ensurePackageIndexes()
 	ensurePlayerIndexes()
@@ -133,6 +146,18 @@ func EnsureIndexes() {
 	ensureSaleIndexes()
 	ensureStatIndexes()
 	ensureAppSameOwnersIndexes()
+	ensureFailedMessageIndexes()
+	ensureDelayQueueIndexes()
+	ensureWebhookDeliveryIndexes()
+	ensureAppDepotHistoryIndexes()
+	ensureExchangeRateIndexes()
+	ensureProductPriceStatsIndexes()
+	ensurePlayerExportIndexes()
+	ensureFranchiseIndexes()
+	ensureAppAchievementStatsIndexes()
+	ensurePlayerHistoryIndexes()
+	ensureProductPICSHistoryIndexes()
+	ensureAppReviewHistoryIndexes()
 	log.Info("Finished migrations")
 }
 
@@ -358,7 +383,7 @@ func CountDocuments(collection collection, filter bson.D, ttl uint32) (count int
 }
 
 // Need to close cursor after calling this
-func find(collection collection, offset int64, limit int64, filter bson.D, sort bson.D, projection bson.M, ops *options.FindOptions) (cur *mongo.Cursor, ctx context.Context, err error) {
+func find(collection collection, offset int64, limit int64, filter bson.D, sort bson.D, projection bson.M, ops *options.FindOptions) (cur Cursor, ctx context.Context, err error) {
 
 	if filter == nil {
 		filter = bson.D{}
@@ -396,7 +421,7 @@ func find(collection collection, offset int64, limit int64, filter bson.D, sort
 	return cur, ctx, err
 }
 
-func GetRandomRows(collection collection, count int, filter bson.D, projection bson.M) (cur *mongo.Cursor, ctx context.Context, err error) {
+func GetRandomRows(collection collection, count int, filter bson.D, projection bson.M) (cur Cursor, ctx context.Context, err error) {
 
 	client, ctx, err := getMongo()
 	if err != nil {
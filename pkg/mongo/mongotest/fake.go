@@ -0,0 +1,2124 @@
+package mongotest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mongoHelper "github.com/gamedb/gamedb/pkg/mongo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+var ErrFakeUnsupported = errors.New("not supported by the fake mongo client")
+
+// An in-memory client, for running code that uses this package without a server.
+// Supports the query, update and aggregation operators this repo uses, but not
+// $text searches, collations or unique indexes other than _id.
+type Fake struct {
+	collections map[string][]bson.D
+	mutex       sync.Mutex
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		collections: map[string][]bson.D{},
+	}
+}
+
+// Seeds a collection
+func (f *Fake) Insert(c fmt.Stringer, documents ...mongoHelper.Document) (err error) {
+
+	for _, v := range documents {
+		_, err = f.Database("").Collection(c.String()).InsertOne(context.Background(), v.BSON())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Copies of the documents matching the filter, in insert order
+func (f *Fake) Documents(c fmt.Stringer, filter bson.D) (documents []bson.D, err error) {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	matched, _, err := f.match(c.String(), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range matched {
+		documents = append(documents, copyDoc(v))
+	}
+	return documents, nil
+}
+
+// Decodes the documents matching the filter into a pointer to a slice
+func (f *Fake) Decode(c fmt.Stringer, filter bson.D, results interface{}) error {
+
+	docs, err := f.Documents(c, filter)
+	if err != nil {
+		return err
+	}
+
+	slice := reflect.ValueOf(results)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return errors.New("results must be a pointer to a slice")
+	}
+
+	out := reflect.MakeSlice(slice.Elem().Type(), 0, len(docs))
+	for _, doc := range docs {
+
+		elem := reflect.New(slice.Elem().Type().Elem())
+		err = decode(doc, elem.Interface())
+		if err != nil {
+			return err
+		}
+		out = reflect.Append(out, elem.Elem())
+	}
+
+	slice.Elem().Set(out)
+	return nil
+}
+
+func (f *Fake) Connect(context.Context) error                  { return nil }
+func (f *Fake) Ping(context.Context, *readpref.ReadPref) error { return nil }
+func (f *Fake) Disconnect(context.Context) error               { return nil }
+func (f *Fake) NumberSessionsInProgress() int                  { return 0 }
+
+func (f *Fake) Database(string, ...*options.DatabaseOptions) mongoHelper.Database {
+	return fakeDatabase{fake: f}
+}
+
+type fakeDatabase struct {
+	fake *Fake
+}
+
+func (d fakeDatabase) Collection(name string, _ ...*options.CollectionOptions) mongoHelper.Collection {
+	return fakeCollection{fake: d.fake, name: name}
+}
+
+// Returns the matching documents and their positions, call with the lock held
+func (f *Fake) match(name string, filter interface{}) (docs []bson.D, indexes []int, err error) {
+
+	query, err := normalize(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, doc := range f.collections[name] {
+
+		ok, err := matches(doc, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			docs = append(docs, doc)
+			indexes = append(indexes, k)
+		}
+	}
+
+	return docs, indexes, nil
+}
+
+type fakeCollection struct {
+	fake *Fake
+	name string
+}
+
+func (c fakeCollection) Aggregate(_ context.Context, pipeline interface{}, _ ...*options.AggregateOptions) (mongoHelper.Cursor, error) {
+
+	wrapped, err := normalize(bson.D{{"pipeline", pipeline}})
+	if err != nil {
+		return nil, err
+	}
+
+	stages, _ := wrapped[0].Value.(primitive.A)
+
+	c.fake.mutex.Lock()
+	var docs []bson.D
+	for _, v := range c.fake.collections[c.name] {
+		docs = append(docs, copyDoc(v))
+	}
+	c.fake.mutex.Unlock()
+
+	for _, v := range stages {
+
+		stage, ok := v.(bson.D)
+		if !ok || len(stage) != 1 {
+			return nil, fmt.Errorf("invalid pipeline stage: %v", v)
+		}
+
+		docs, err = aggregateStage(docs, stage[0].Key, stage[0].Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &fakeCursor{docs: docs}, nil
+}
+
+func (c fakeCollection) BulkWrite(ctx context.Context, models []mongo.WriteModel, opts ...*options.BulkWriteOptions) (*mongo.BulkWriteResult, error) {
+
+	var ordered = true
+	for _, v := range opts {
+		if v != nil && v.Ordered != nil {
+			ordered = *v.Ordered
+		}
+	}
+
+	var result = &mongo.BulkWriteResult{UpsertedIDs: map[int64]interface{}{}}
+	var exception mongo.BulkWriteException
+
+	for k, model := range models {
+
+		var err error
+
+		switch m := model.(type) {
+		case *mongo.InsertOneModel:
+			_, err = c.InsertOne(ctx, m.Document)
+			if err == nil {
+				result.InsertedCount++
+			}
+		case *mongo.DeleteOneModel:
+			var resp *mongo.DeleteResult
+			resp, err = c.DeleteOne(ctx, m.Filter)
+			if err == nil {
+				result.DeletedCount += resp.DeletedCount
+			}
+		case *mongo.DeleteManyModel:
+			var resp *mongo.DeleteResult
+			resp, err = c.DeleteMany(ctx, m.Filter)
+			if err == nil {
+				result.DeletedCount += resp.DeletedCount
+			}
+		case *mongo.ReplaceOneModel:
+			var resp *mongo.UpdateResult
+			resp, err = c.ReplaceOne(ctx, m.Filter, m.Replacement, &options.ReplaceOptions{Upsert: m.Upsert})
+			if err == nil {
+				addUpdateResult(result, resp, k)
+			}
+		case *mongo.UpdateOneModel:
+			var resp *mongo.UpdateResult
+			resp, err = c.UpdateOne(ctx, m.Filter, m.Update, &options.UpdateOptions{Upsert: m.Upsert})
+			if err == nil {
+				addUpdateResult(result, resp, k)
+			}
+		case *mongo.UpdateManyModel:
+			var resp *mongo.UpdateResult
+			resp, err = c.UpdateMany(ctx, m.Filter, m.Update, &options.UpdateOptions{Upsert: m.Upsert})
+			if err == nil {
+				addUpdateResult(result, resp, k)
+			}
+		default:
+			err = fmt.Errorf("%w: write model %T", ErrFakeUnsupported, model)
+		}
+
+		if err != nil {
+
+			var writeErr mongo.WriteError
+			var we mongo.WriteException
+			if errors.As(err, &we) && len(we.WriteErrors) > 0 {
+				writeErr = we.WriteErrors[0]
+			} else {
+				return result, err
+			}
+
+			writeErr.Index = k
+			exception.WriteErrors = append(exception.WriteErrors, mongo.BulkWriteError{WriteError: writeErr, Request: model})
+
+			if ordered {
+				break
+			}
+		}
+	}
+
+	if len(exception.WriteErrors) > 0 {
+		return result, exception
+	}
+
+	return result, nil
+}
+
+func addUpdateResult(result *mongo.BulkWriteResult, resp *mongo.UpdateResult, index int) {
+
+	result.MatchedCount += resp.MatchedCount
+	result.ModifiedCount += resp.ModifiedCount
+	result.UpsertedCount += resp.UpsertedCount
+	if resp.UpsertedID != nil {
+		result.UpsertedIDs[int64(index)] = resp.UpsertedID
+	}
+}
+
+func (c fakeCollection) CountDocuments(_ context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error) {
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	docs, _, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	ops := options.MergeCountOptions(opts...)
+	docs = skipAndLimit(docs, ops.Skip, ops.Limit)
+
+	return int64(len(docs)), nil
+}
+
+func (c fakeCollection) DeleteMany(_ context.Context, filter interface{}, _ ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return c.delete(filter, false)
+}
+
+func (c fakeCollection) DeleteOne(_ context.Context, filter interface{}, _ ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	return c.delete(filter, true)
+}
+
+func (c fakeCollection) delete(filter interface{}, one bool) (*mongo.DeleteResult, error) {
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	_, indexes, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if one && len(indexes) > 1 {
+		indexes = indexes[:1]
+	}
+
+	var remove = map[int]bool{}
+	for _, v := range indexes {
+		remove[v] = true
+	}
+
+	var kept []bson.D
+	for k, v := range c.fake.collections[c.name] {
+		if !remove[k] {
+			kept = append(kept, v)
+		}
+	}
+	c.fake.collections[c.name] = kept
+
+	return &mongo.DeleteResult{DeletedCount: int64(len(indexes))}, nil
+}
+
+func (c fakeCollection) Distinct(_ context.Context, fieldName string, filter interface{}, _ ...*options.DistinctOptions) ([]interface{}, error) {
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	docs, _, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	for _, doc := range docs {
+
+		val, found := lookup(doc, fieldName)
+		if !found {
+			continue
+		}
+
+		var candidates = []interface{}{val}
+		if a, ok := val.(primitive.A); ok {
+			candidates = a
+		}
+
+	CandidateLoop:
+		for _, candidate := range candidates {
+			for _, existing := range values {
+				if equal(existing, candidate) {
+					continue CandidateLoop
+				}
+			}
+			values = append(values, candidate)
+		}
+	}
+
+	return values, nil
+}
+
+func (c fakeCollection) EstimatedDocumentCount(_ context.Context, _ ...*options.EstimatedDocumentCountOptions) (int64, error) {
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	return int64(len(c.fake.collections[c.name])), nil
+}
+
+func (c fakeCollection) Find(_ context.Context, filter interface{}, opts ...*options.FindOptions) (mongoHelper.Cursor, error) {
+
+	ops := options.MergeFindOptions(opts...)
+
+	docs, err := c.find(filter, ops.Sort, ops.Projection)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeCursor{docs: skipAndLimit(docs, ops.Skip, ops.Limit)}, nil
+}
+
+func (c fakeCollection) FindOne(_ context.Context, filter interface{}, opts ...*options.FindOneOptions) mongoHelper.SingleResult {
+
+	ops := options.MergeFindOneOptions(opts...)
+
+	docs, err := c.find(filter, ops.Sort, ops.Projection)
+	if err != nil {
+		return fakeSingleResult{err: err}
+	}
+
+	docs = skipAndLimit(docs, ops.Skip, nil)
+	if len(docs) == 0 {
+		return fakeSingleResult{err: mongo.ErrNoDocuments}
+	}
+
+	return fakeSingleResult{doc: docs[0]}
+}
+
+// Sorted and projected copies of matching documents
+func (c fakeCollection) find(filter interface{}, sortBy interface{}, projection interface{}) (docs []bson.D, err error) {
+
+	c.fake.mutex.Lock()
+	matched, _, err := c.fake.match(c.name, filter)
+	for _, v := range matched {
+		docs = append(docs, copyDoc(v))
+	}
+	c.fake.mutex.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if sortBy != nil {
+		docs, err = sortDocs(docs, sortBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if projection != nil {
+		docs, err = projectDocs(docs, projection)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return docs, nil
+}
+
+func (c fakeCollection) FindOneAndUpdate(_ context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) mongoHelper.SingleResult {
+
+	ops := options.MergeFindOneAndUpdateOptions(opts...)
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	docs, indexes, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return fakeSingleResult{err: err}
+	}
+
+	if len(docs) == 0 {
+
+		if ops.Upsert == nil || !*ops.Upsert {
+			return fakeSingleResult{err: mongo.ErrNoDocuments}
+		}
+
+		doc, err := c.upsert(filter, update, true)
+		if err != nil {
+			return fakeSingleResult{err: err}
+		}
+		if ops.ReturnDocument != nil && *ops.ReturnDocument == options.After {
+			return fakeSingleResult{doc: copyDoc(doc)}
+		}
+		return fakeSingleResult{err: mongo.ErrNoDocuments}
+	}
+
+	var position = indexes[0]
+
+	if ops.Sort != nil {
+
+		less, err := docLess(ops.Sort)
+		if err != nil {
+			return fakeSingleResult{err: err}
+		}
+
+		var first = docs[0]
+		for k, v := range docs {
+			if less(v, first) {
+				first = v
+				position = indexes[k]
+			}
+		}
+	}
+
+	var before = copyDoc(c.fake.collections[c.name][position])
+
+	after, err := applyUpdate(copyDoc(before), update, false)
+	if err != nil {
+		return fakeSingleResult{err: err}
+	}
+
+	c.fake.collections[c.name][position] = after
+
+	if ops.ReturnDocument != nil && *ops.ReturnDocument == options.After {
+		return fakeSingleResult{doc: copyDoc(after)}
+	}
+	return fakeSingleResult{doc: before}
+}
+
+func (c fakeCollection) Indexes() mongoHelper.IndexView {
+	return fakeIndexView{}
+}
+
+func (c fakeCollection) InsertMany(ctx context.Context, documents []interface{}, opts ...*options.InsertManyOptions) (*mongo.InsertManyResult, error) {
+
+	var ordered = true
+	for _, v := range opts {
+		if v != nil && v.Ordered != nil {
+			ordered = *v.Ordered
+		}
+	}
+
+	var result = &mongo.InsertManyResult{}
+	var exception mongo.BulkWriteException
+
+	for k, v := range documents {
+
+		resp, err := c.InsertOne(ctx, v)
+		if err != nil {
+
+			var we mongo.WriteException
+			if !errors.As(err, &we) || len(we.WriteErrors) == 0 {
+				return result, err
+			}
+
+			writeErr := we.WriteErrors[0]
+			writeErr.Index = k
+			exception.WriteErrors = append(exception.WriteErrors, mongo.BulkWriteError{WriteError: writeErr})
+
+			if ordered {
+				break
+			}
+			continue
+		}
+
+		result.InsertedIDs = append(result.InsertedIDs, resp.InsertedID)
+	}
+
+	if len(exception.WriteErrors) > 0 {
+		return result, exception
+	}
+
+	return result, nil
+}
+
+func (c fakeCollection) InsertOne(_ context.Context, document interface{}, _ ...*options.InsertOneOptions) (*mongo.InsertOneResult, error) {
+
+	doc, err := normalize(document)
+	if err != nil {
+		return nil, err
+	}
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	id, err := c.insert(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mongo.InsertOneResult{InsertedID: id}, nil
+}
+
+// Call with the lock held
+func (c fakeCollection) insert(doc bson.D) (id interface{}, err error) {
+
+	id, found := lookup(doc, "_id")
+	if !found {
+		id = primitive.NewObjectID()
+		doc = append(bson.D{{"_id", id}}, doc...)
+	}
+
+	for _, v := range c.fake.collections[c.name] {
+		if existing, _ := lookup(v, "_id"); equal(existing, id) {
+			return nil, duplicateKeyError(c.name, id)
+		}
+	}
+
+	c.fake.collections[c.name] = append(c.fake.collections[c.name], doc)
+
+	return id, nil
+}
+
+func duplicateKeyError(name string, id interface{}) error {
+	return mongo.WriteException{WriteErrors: []mongo.WriteError{{
+		Code:    11000,
+		Message: fmt.Sprintf("E11000 duplicate key error collection: %s index: _id_ dup key: { _id: %v }", name, id),
+	}}}
+}
+
+func (c fakeCollection) ReplaceOne(_ context.Context, filter interface{}, replacement interface{}, opts ...*options.ReplaceOptions) (*mongo.UpdateResult, error) {
+
+	ops := options.MergeReplaceOptions(opts...)
+
+	replacementDoc, err := normalize(replacement)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range replacementDoc {
+		if strings.HasPrefix(v.Key, "$") {
+			return nil, errors.New("replacement document cannot contain keys beginning with '$'")
+		}
+	}
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	_, indexes, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indexes) == 0 {
+
+		if ops.Upsert == nil || !*ops.Upsert {
+			return &mongo.UpdateResult{}, nil
+		}
+
+		doc, err := c.upsert(filter, replacementDoc, false)
+		if err != nil {
+			return nil, err
+		}
+
+		id, _ := lookup(doc, "_id")
+		return &mongo.UpdateResult{UpsertedCount: 1, UpsertedID: id}, nil
+	}
+
+	var position = indexes[0]
+	var existing = c.fake.collections[c.name][position]
+
+	id, _ := lookup(existing, "_id")
+	doc := bson.D{{"_id", id}}
+	for _, v := range replacementDoc {
+		if v.Key != "_id" {
+			doc = append(doc, v)
+		}
+	}
+
+	c.fake.collections[c.name][position] = doc
+
+	return &mongo.UpdateResult{MatchedCount: 1, ModifiedCount: modifiedCount(existing, doc)}, nil
+}
+
+func (c fakeCollection) UpdateMany(_ context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return c.update(filter, update, false, opts...)
+}
+
+func (c fakeCollection) UpdateOne(_ context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	return c.update(filter, update, true, opts...)
+}
+
+func (c fakeCollection) update(filter interface{}, update interface{}, one bool, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+
+	ops := options.MergeUpdateOptions(opts...)
+
+	c.fake.mutex.Lock()
+	defer c.fake.mutex.Unlock()
+
+	_, indexes, err := c.fake.match(c.name, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(indexes) == 0 {
+
+		if ops.Upsert == nil || !*ops.Upsert {
+			return &mongo.UpdateResult{}, nil
+		}
+
+		doc, err := c.upsert(filter, update, true)
+		if err != nil {
+			return nil, err
+		}
+
+		id, _ := lookup(doc, "_id")
+		return &mongo.UpdateResult{UpsertedCount: 1, UpsertedID: id}, nil
+	}
+
+	if one {
+		indexes = indexes[:1]
+	}
+
+	var result = &mongo.UpdateResult{}
+
+	for _, position := range indexes {
+
+		existing := c.fake.collections[c.name][position]
+
+		doc, err := applyUpdate(copyDoc(existing), update, false)
+		if err != nil {
+			return nil, err
+		}
+
+		c.fake.collections[c.name][position] = doc
+
+		result.MatchedCount++
+		result.ModifiedCount += modifiedCount(existing, doc)
+	}
+
+	return result, nil
+}
+
+// Inserts a document built from the filter's equality fields, call with the lock held
+func (c fakeCollection) upsert(filter interface{}, update interface{}, operators bool) (doc bson.D, err error) {
+
+	query, err := normalize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range query {
+
+		if strings.HasPrefix(v.Key, "$") {
+			continue
+		}
+
+		if d, ok := v.Value.(bson.D); ok && len(d) > 0 && strings.HasPrefix(d[0].Key, "$") {
+			if len(d) == 1 && d[0].Key == "$eq" {
+				doc = setPath(doc, v.Key, d[0].Value)
+			}
+			continue
+		}
+
+		doc = setPath(doc, v.Key, v.Value)
+	}
+
+	if operators {
+
+		doc, err = applyUpdate(doc, update, true)
+		if err != nil {
+			return nil, err
+		}
+
+	} else {
+
+		replacement, err := normalize(update)
+		if err != nil {
+			return nil, err
+		}
+
+		id, found := lookup(doc, "_id")
+		doc = replacement
+		if _, ok := lookup(doc, "_id"); !ok && found {
+			doc = append(bson.D{{"_id", id}}, doc...)
+		}
+	}
+
+	_, err = c.insert(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.fake.collections[c.name][len(c.fake.collections[c.name])-1], nil
+}
+
+func modifiedCount(before, after bson.D) int64 {
+	if reflect.DeepEqual(before, after) {
+		return 0
+	}
+	return 1
+}
+
+type fakeIndexView struct{}
+
+func (fakeIndexView) CreateMany(_ context.Context, models []mongo.IndexModel, _ ...*options.CreateIndexesOptions) (names []string, err error) {
+
+	for k := range models {
+		names = append(names, "index_"+strconv.Itoa(k))
+	}
+	return names, nil
+}
+
+type fakeCursor struct {
+	docs    []bson.D
+	current bson.D
+}
+
+func (c *fakeCursor) Next(context.Context) bool {
+
+	if len(c.docs) == 0 {
+		c.current = nil
+		return false
+	}
+
+	c.current = c.docs[0]
+	c.docs = c.docs[1:]
+	return true
+}
+
+func (c *fakeCursor) Decode(val interface{}) error {
+	return decode(c.current, val)
+}
+
+func (c *fakeCursor) Current() bson.Raw {
+
+	b, err := bson.Marshal(c.current)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+func (c *fakeCursor) Err() error {
+	return nil
+}
+
+func (c *fakeCursor) Close(context.Context) error {
+	c.docs = nil
+	return nil
+}
+
+type fakeSingleResult struct {
+	doc bson.D
+	err error
+}
+
+func (r fakeSingleResult) Decode(v interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	return decode(r.doc, v)
+}
+
+func (r fakeSingleResult) Err() error {
+	return r.err
+}
+
+func decode(doc bson.D, val interface{}) error {
+
+	b, err := bson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return bson.Unmarshal(b, val)
+}
+
+// Round trips through BSON, so values compare the same way they are stored
+func normalize(v interface{}) (doc bson.D, err error) {
+
+	if v == nil {
+		return bson.D{}, nil
+	}
+
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr:
+		if rv.IsNil() {
+			return bson.D{}, nil
+		}
+	}
+
+	b, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bson.Unmarshal(b, &doc)
+	return doc, err
+}
+
+func copyDoc(doc bson.D) bson.D {
+
+	var out = make(bson.D, 0, len(doc))
+	for _, v := range doc {
+		out = append(out, bson.E{Key: v.Key, Value: copyValue(v.Value)})
+	}
+	return out
+}
+
+func copyValue(v interface{}) interface{} {
+
+	switch val := v.(type) {
+	case bson.D:
+		return copyDoc(val)
+	case primitive.A:
+		var out = make(primitive.A, 0, len(val))
+		for _, vv := range val {
+			out = append(out, copyValue(vv))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func skipAndLimit(docs []bson.D, skip, limit *int64) []bson.D {
+
+	if skip != nil && *skip > 0 {
+		if int(*skip) >= len(docs) {
+			return nil
+		}
+		docs = docs[*skip:]
+	}
+
+	if limit != nil && *limit > 0 && int(*limit) < len(docs) {
+		docs = docs[:*limit]
+	}
+
+	return docs
+}
+
+// Filters
+
+func matches(doc bson.D, query bson.D) (bool, error) {
+
+	for _, condition := range query {
+
+		var ok bool
+		var err error
+
+		switch condition.Key {
+		case "$and", "$or", "$nor":
+
+			clauses, isArray := condition.Value.(primitive.A)
+			if !isArray {
+				return false, fmt.Errorf("%s must be an array", condition.Key)
+			}
+
+			var matched int
+			for _, v := range clauses {
+
+				clause, isDoc := v.(bson.D)
+				if !isDoc {
+					return false, fmt.Errorf("%s entries must be documents", condition.Key)
+				}
+
+				m, err := matches(doc, clause)
+				if err != nil {
+					return false, err
+				}
+				if m {
+					matched++
+				}
+			}
+
+			switch condition.Key {
+			case "$and":
+				ok = matched == len(clauses)
+			case "$or":
+				ok = matched > 0
+			case "$nor":
+				ok = matched == 0
+			}
+
+		case "$text", "$where", "$expr":
+			return false, fmt.Errorf("%w: %s", ErrFakeUnsupported, condition.Key)
+
+		default:
+			ok, err = matchesField(doc, condition.Key, condition.Value)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func matchesField(doc bson.D, path string, condition interface{}) (bool, error) {
+
+	values, found := lookupAll(doc, path)
+
+	operators, ok := condition.(bson.D)
+	if !ok || len(operators) == 0 || !strings.HasPrefix(operators[0].Key, "$") {
+		return matchesEqual(values, found, condition), nil
+	}
+
+	for _, operator := range operators {
+
+		var ok bool
+
+		switch operator.Key {
+		case "$eq":
+			ok = matchesEqual(values, found, operator.Value)
+		case "$ne":
+			ok = !matchesEqual(values, found, operator.Value)
+		case "$gt", "$gte", "$lt", "$lte":
+			ok = matchesCompare(values, operator.Key, operator.Value)
+		case "$in", "$nin":
+
+			list, isArray := operator.Value.(primitive.A)
+			if !isArray {
+				return false, fmt.Errorf("%s needs an array", operator.Key)
+			}
+
+			for _, v := range list {
+				if matchesEqual(values, found, v) {
+					ok = true
+					break
+				}
+			}
+
+			if operator.Key == "$nin" {
+				ok = !ok
+			}
+
+		case "$exists":
+			ok = found == truthy(operator.Value)
+		case "$regex":
+
+			var options string
+			for _, v := range operators {
+				if v.Key == "$options" {
+					options, _ = v.Value.(string)
+				}
+			}
+
+			var err error
+			ok, err = matchesRegex(values, operator.Value, options)
+			if err != nil {
+				return false, err
+			}
+
+		case "$options":
+			ok = true
+		case "$not":
+
+			var err error
+			ok, err = matchesField(doc, path, operator.Value)
+			if err != nil {
+				return false, err
+			}
+			ok = !ok
+
+		case "$type":
+			ok = matchesType(values, found, operator.Value)
+		case "$size":
+
+			for _, v := range values {
+				if a, isArray := v.(primitive.A); isArray && equal(int64(len(a)), operator.Value) {
+					ok = true
+				}
+			}
+
+		case "$elemMatch":
+
+			sub, isDoc := operator.Value.(bson.D)
+			if !isDoc {
+				return false, errors.New("$elemMatch needs a document")
+			}
+
+			for _, v := range values {
+
+				a, isArray := v.(primitive.A)
+				if !isArray {
+					continue
+				}
+
+				for _, elem := range a {
+
+					var m bool
+					var err error
+					if d, isDoc := elem.(bson.D); isDoc && !strings.HasPrefix(sub[0].Key, "$") {
+						m, err = matches(d, sub)
+					} else {
+						m, err = matchesField(bson.D{{"v", elem}}, "v", sub)
+					}
+					if err != nil {
+						return false, err
+					}
+					if m {
+						ok = true
+					}
+				}
+			}
+
+		default:
+			return false, fmt.Errorf("%w: %s", ErrFakeUnsupported, operator.Key)
+		}
+
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Arrays match if any element matches, nulls match missing fields
+func matchesEqual(values []interface{}, found bool, condition interface{}) bool {
+
+	if condition == nil && !found {
+		return true
+	}
+
+	if re, ok := condition.(primitive.Regex); ok {
+		m, _ := matchesRegex(values, re, "")
+		return m
+	}
+
+	for _, v := range candidates(values) {
+		if equal(v, condition) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesCompare(values []interface{}, operator string, condition interface{}) bool {
+
+	for _, v := range candidates(values) {
+
+		if typeRank(v) != typeRank(condition) {
+			continue
+		}
+
+		c := compare(v, condition)
+
+		switch operator {
+		case "$gt":
+			if c > 0 {
+				return true
+			}
+		case "$gte":
+			if c >= 0 {
+				return true
+			}
+		case "$lt":
+			if c < 0 {
+				return true
+			}
+		case "$lte":
+			if c <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesRegex(values []interface{}, pattern interface{}, options string) (bool, error) {
+
+	var expr string
+
+	switch p := pattern.(type) {
+	case string:
+		expr = p
+	case primitive.Regex:
+		expr = p.Pattern
+		options += p.Options
+	default:
+		return false, errors.New("$regex needs a string")
+	}
+
+	var flags string
+	for _, v := range options {
+		if strings.ContainsRune("ims", v) {
+			flags += string(v)
+		}
+	}
+	if flags != "" {
+		expr = "(?" + flags + ")" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return false, err
+	}
+
+	for _, v := range candidates(values) {
+		if s, ok := v.(string); ok && re.MatchString(s) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matchesType(values []interface{}, found bool, t interface{}) bool {
+
+	if !found {
+		return false
+	}
+
+	var names = map[string]func(interface{}) bool{
+		"double": func(v interface{}) bool { _, ok := v.(float64); return ok },
+		"string": func(v interface{}) bool { _, ok := v.(string); return ok },
+		"object": func(v interface{}) bool { _, ok := v.(bson.D); return ok },
+		"array":  func(v interface{}) bool { _, ok := v.(primitive.A); return ok },
+		"bool":   func(v interface{}) bool { _, ok := v.(bool); return ok },
+		"date":   func(v interface{}) bool { _, ok := v.(primitive.DateTime); return ok },
+		"null":   func(v interface{}) bool { return v == nil },
+		"int":    func(v interface{}) bool { _, ok := v.(int32); return ok },
+		"long":   func(v interface{}) bool { _, ok := v.(int64); return ok },
+		"number": func(v interface{}) bool { _, ok := toFloat(v); return ok },
+	}
+
+	var numbers = map[float64]string{1: "double", 2: "string", 3: "object", 4: "array", 8: "bool", 9: "date", 10: "null", 16: "int", 18: "long"}
+
+	var name string
+	if f, ok := toFloat(t); ok {
+		name = numbers[f]
+	} else {
+		name, _ = t.(string)
+	}
+
+	check, ok := names[name]
+	if !ok {
+		return false
+	}
+
+	// Arrays match on the array itself and their elements
+	for _, v := range values {
+		if check(v) {
+			return true
+		}
+		if a, isArray := v.(primitive.A); isArray {
+			for _, elem := range a {
+				if check(elem) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// Values plus the elements of any arrays
+func candidates(values []interface{}) (out []interface{}) {
+
+	for _, v := range values {
+		out = append(out, v)
+		if a, ok := v.(primitive.A); ok {
+			out = append(out, a...)
+		}
+	}
+	return out
+}
+
+// Paths
+
+func lookup(doc bson.D, path string) (interface{}, bool) {
+
+	values, found := lookupAll(doc, path)
+	if !found || len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// Dotted paths through documents, and through every element of arrays
+func lookupAll(doc bson.D, path string) (values []interface{}, found bool) {
+
+	var parts = strings.Split(path, ".")
+	var current = []interface{}{doc}
+
+	for _, part := range parts {
+
+		var next []interface{}
+
+		for _, v := range current {
+
+			switch val := v.(type) {
+			case bson.D:
+				for _, e := range val {
+					if e.Key == part {
+						next = append(next, e.Value)
+						break
+					}
+				}
+			case primitive.A:
+				if i, err := strconv.Atoi(part); err == nil {
+					if i >= 0 && i < len(val) {
+						next = append(next, val[i])
+					}
+					continue
+				}
+				for _, elem := range val {
+					if d, ok := elem.(bson.D); ok {
+						for _, e := range d {
+							if e.Key == part {
+								next = append(next, e.Value)
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+
+		current = next
+	}
+
+	return current, len(current) > 0
+}
+
+func setPath(doc bson.D, path string, value interface{}) bson.D {
+
+	parts := strings.SplitN(path, ".", 2)
+
+	for k, e := range doc {
+		if e.Key == parts[0] {
+			if len(parts) == 1 {
+				doc[k].Value = value
+			} else {
+				sub, _ := e.Value.(bson.D)
+				doc[k].Value = setPath(sub, parts[1], value)
+			}
+			return doc
+		}
+	}
+
+	if len(parts) == 1 {
+		return append(doc, bson.E{Key: parts[0], Value: value})
+	}
+	return append(doc, bson.E{Key: parts[0], Value: setPath(bson.D{}, parts[1], value)})
+}
+
+func unsetPath(doc bson.D, path string) bson.D {
+
+	parts := strings.SplitN(path, ".", 2)
+
+	for k, e := range doc {
+		if e.Key == parts[0] {
+			if len(parts) == 1 {
+				return append(doc[:k:k], doc[k+1:]...)
+			}
+			if sub, ok := e.Value.(bson.D); ok {
+				doc[k].Value = unsetPath(sub, parts[1])
+			}
+			return doc
+		}
+	}
+	return doc
+}
+
+// Updates
+
+func applyUpdate(doc bson.D, update interface{}, inserting bool) (bson.D, error) {
+
+	updateDoc, err := normalize(update)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operator := range updateDoc {
+
+		fields, ok := operator.Value.(bson.D)
+		if !ok {
+			return nil, fmt.Errorf("%s needs a document", operator.Key)
+		}
+
+		for _, field := range fields {
+
+			if field.Key == "_id" && !inserting && operator.Key != "$setOnInsert" {
+				if existing, _ := lookup(doc, "_id"); !equal(existing, field.Value) {
+					return nil, errors.New("the (immutable) field '_id' was found to have been altered")
+				}
+			}
+
+			switch operator.Key {
+			case "$set":
+				doc = setPath(doc, field.Key, field.Value)
+			case "$setOnInsert":
+				if inserting {
+					doc = setPath(doc, field.Key, field.Value)
+				}
+			case "$unset":
+				doc = unsetPath(doc, field.Key)
+			case "$inc":
+
+				existing, _ := lookup(doc, field.Key)
+				sum, err := arithmetic("$add", []interface{}{existing, field.Value})
+				if err != nil {
+					return nil, err
+				}
+				doc = setPath(doc, field.Key, sum)
+
+			default:
+				return nil, fmt.Errorf("%w: %s", ErrFakeUnsupported, operator.Key)
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// Sorting
+
+func sortDocs(docs []bson.D, sortBy interface{}) ([]bson.D, error) {
+
+	less, err := docLess(sortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(docs, func(i, j int) bool {
+		return less(docs[i], docs[j])
+	})
+
+	return docs, nil
+}
+
+func docLess(sortBy interface{}) (func(a, b bson.D) bool, error) {
+
+	keys, err := normalize(sortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(a, b bson.D) bool {
+
+		for _, key := range keys {
+
+			va, _ := lookup(a, key.Key)
+			vb, _ := lookup(b, key.Key)
+
+			c := compare(va, vb)
+			if c == 0 {
+				continue
+			}
+
+			if f, _ := toFloat(key.Value); f < 0 {
+				return c > 0
+			}
+			return c < 0
+		}
+		return false
+	}, nil
+}
+
+// Projections
+
+func projectDocs(docs []bson.D, projection interface{}) (out []bson.D, err error) {
+
+	fields, err := normalize(projection)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return docs, nil
+	}
+
+	for _, doc := range docs {
+
+		projected, err := project(doc, fields, false)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, projected)
+	}
+
+	return out, nil
+}
+
+// Inclusions, exclusions and, in aggregations, computed fields
+func project(doc bson.D, fields bson.D, expressions bool) (bson.D, error) {
+
+	var flag = func(v interface{}) bool {
+		return isNumber(v) || isBool(v)
+	}
+
+	var inclusion bool
+	var includeID = true
+
+	for _, v := range fields {
+		if v.Key == "_id" && flag(v.Value) {
+			includeID = truthy(v.Value)
+		} else if !flag(v.Value) || truthy(v.Value) {
+			inclusion = true
+		}
+	}
+
+	if !inclusion {
+
+		var out = copyDoc(doc)
+		for _, v := range fields {
+			if v.Key != "_id" || !includeID {
+				out = unsetPath(out, v.Key)
+			}
+		}
+		return out, nil
+	}
+
+	var out = bson.D{}
+
+	if includeID {
+		if id, found := lookup(doc, "_id"); found {
+			out = append(out, bson.E{Key: "_id", Value: id})
+		}
+	}
+
+	for _, v := range fields {
+
+		if flag(v.Value) {
+			if v.Key == "_id" || !truthy(v.Value) {
+				continue
+			}
+			if val, found := lookup(doc, v.Key); found {
+				out = setPath(out, v.Key, copyValue(val))
+			}
+			continue
+		}
+
+		if !expressions {
+			return nil, fmt.Errorf("%w: projection expressions in find", ErrFakeUnsupported)
+		}
+
+		val, err := evaluate(doc, v.Value)
+		if err != nil {
+			return nil, err
+		}
+		out = setPath(out, v.Key, val)
+	}
+
+	return out, nil
+}
+
+// Aggregations
+
+func aggregateStage(docs []bson.D, stage string, value interface{}) (out []bson.D, err error) {
+
+	switch stage {
+	case "$match":
+
+		query, ok := value.(bson.D)
+		if !ok {
+			return nil, errors.New("$match needs a document")
+		}
+
+		for _, doc := range docs {
+			m, err := matches(doc, query)
+			if err != nil {
+				return nil, err
+			}
+			if m {
+				out = append(out, doc)
+			}
+		}
+		return out, nil
+
+	case "$sort":
+		return sortDocs(docs, value)
+
+	case "$skip":
+		n, _ := toFloat(value)
+		skip := int64(n)
+		return skipAndLimit(docs, &skip, nil), nil
+
+	case "$limit":
+		n, _ := toFloat(value)
+		limit := int64(n)
+		return skipAndLimit(docs, nil, &limit), nil
+
+	case "$sample":
+
+		size, _ := lookup(asDoc(value), "size")
+		n, _ := toFloat(size)
+
+		rand.Shuffle(len(docs), func(i, j int) { docs[i], docs[j] = docs[j], docs[i] })
+		limit := int64(n)
+		return skipAndLimit(docs, nil, &limit), nil
+
+	case "$project":
+
+		for _, doc := range docs {
+			projected, err := project(doc, asDoc(value), true)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, projected)
+		}
+		return out, nil
+
+	case "$group":
+		return group(docs, asDoc(value))
+
+	case "$count":
+
+		name, _ := value.(string)
+		if len(docs) == 0 {
+			return nil, nil
+		}
+		return []bson.D{{{Key: name, Value: int32(len(docs))}}}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrFakeUnsupported, stage)
+	}
+}
+
+func group(docs []bson.D, spec bson.D) (out []bson.D, err error) {
+
+	type bucket struct {
+		id   interface{}
+		docs []bson.D
+	}
+
+	var buckets []*bucket
+	var idExpr interface{}
+
+	for _, v := range spec {
+		if v.Key == "_id" {
+			idExpr = v.Value
+		}
+	}
+
+	for _, doc := range docs {
+
+		id, err := evaluate(doc, idExpr)
+		if err != nil {
+			return nil, err
+		}
+
+		var found *bucket
+		for _, b := range buckets {
+			if equal(b.id, id) {
+				found = b
+				break
+			}
+		}
+
+		if found == nil {
+			found = &bucket{id: id}
+			buckets = append(buckets, found)
+		}
+
+		found.docs = append(found.docs, doc)
+	}
+
+	for _, b := range buckets {
+
+		var row = bson.D{{"_id", b.id}}
+
+		for _, field := range spec {
+
+			if field.Key == "_id" {
+				continue
+			}
+
+			accumulator := asDoc(field.Value)
+			if len(accumulator) != 1 {
+				return nil, fmt.Errorf("invalid accumulator for %s", field.Key)
+			}
+
+			var values []interface{}
+			for _, doc := range b.docs {
+				v, err := evaluate(doc, accumulator[0].Value)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, v)
+			}
+
+			result, err := accumulate(accumulator[0].Key, values)
+			if err != nil {
+				return nil, err
+			}
+
+			row = append(row, bson.E{Key: field.Key, Value: result})
+		}
+
+		out = append(out, row)
+	}
+
+	return out, nil
+}
+
+func accumulate(operator string, values []interface{}) (interface{}, error) {
+
+	switch operator {
+	case "$sum":
+
+		var numbers []interface{}
+		for _, v := range values {
+			if isNumber(v) {
+				numbers = append(numbers, v)
+			}
+		}
+		if len(numbers) == 0 {
+			return int32(0), nil
+		}
+		return arithmetic("$add", numbers)
+
+	case "$avg":
+
+		var total float64
+		var count float64
+		for _, v := range values {
+			if f, ok := toFloat(v); ok {
+				total += f
+				count++
+			}
+		}
+		if count == 0 {
+			return nil, nil
+		}
+		return total / count, nil
+
+	case "$min", "$max":
+
+		var result interface{}
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+			if result == nil || (operator == "$min" && compare(v, result) < 0) || (operator == "$max" && compare(v, result) > 0) {
+				result = v
+			}
+		}
+		return result, nil
+
+	case "$first":
+		if len(values) == 0 {
+			return nil, nil
+		}
+		return values[0], nil
+
+	case "$last":
+		if len(values) == 0 {
+			return nil, nil
+		}
+		return values[len(values)-1], nil
+
+	case "$push":
+		return primitive.A(values), nil
+
+	case "$addToSet":
+
+		var set primitive.A
+	ValueLoop:
+		for _, v := range values {
+			for _, existing := range set {
+				if equal(existing, v) {
+					continue ValueLoop
+				}
+			}
+			set = append(set, v)
+		}
+		return set, nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrFakeUnsupported, operator)
+	}
+}
+
+// Field paths, literals and the expression operators this repo uses
+func evaluate(doc bson.D, expr interface{}) (interface{}, error) {
+
+	switch val := expr.(type) {
+	case string:
+
+		if strings.HasPrefix(val, "$$") {
+			if val == "$$ROOT" {
+				return copyDoc(doc), nil
+			}
+			return nil, fmt.Errorf("%w: %s", ErrFakeUnsupported, val)
+		}
+
+		if strings.HasPrefix(val, "$") {
+			v, _ := lookup(doc, val[1:])
+			return v, nil
+		}
+
+		return val, nil
+
+	case primitive.A:
+
+		var out primitive.A
+		for _, v := range val {
+			e, err := evaluate(doc, v)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, e)
+		}
+		return out, nil
+
+	case bson.D:
+
+		if len(val) == 1 && strings.HasPrefix(val[0].Key, "$") {
+			return evaluateOperator(doc, val[0].Key, val[0].Value)
+		}
+
+		var out = bson.D{}
+		for _, v := range val {
+			e, err := evaluate(doc, v.Value)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, bson.E{Key: v.Key, Value: e})
+		}
+		return out, nil
+
+	default:
+		return expr, nil
+	}
+}
+
+func evaluateOperator(doc bson.D, operator string, args interface{}) (interface{}, error) {
+
+	switch operator {
+	case "$add", "$subtract", "$multiply", "$divide":
+
+		evaluated, err := evaluate(doc, args)
+		if err != nil {
+			return nil, err
+		}
+
+		list, ok := evaluated.(primitive.A)
+		if !ok {
+			return nil, fmt.Errorf("%s needs an array", operator)
+		}
+		return arithmetic(operator, list)
+
+	case "$floor", "$trunc":
+
+		v, err := evaluateArg(doc, args)
+		if err != nil || v == nil {
+			return nil, err
+		}
+
+		f, ok := toFloat(v)
+		if !ok {
+			return nil, fmt.Errorf("%s needs a number", operator)
+		}
+
+		if operator == "$floor" {
+			f = math.Floor(f)
+		} else {
+			f = math.Trunc(f)
+		}
+
+		if _, isFloat := v.(float64); isFloat {
+			return f, nil
+		}
+		return int64(f), nil
+
+	case "$toDate":
+
+		v, err := evaluateArg(doc, args)
+		if err != nil || v == nil {
+			return nil, err
+		}
+
+		if d, ok := v.(primitive.DateTime); ok {
+			return d, nil
+		}
+		if f, ok := toFloat(v); ok {
+			return primitive.DateTime(int64(f)), nil
+		}
+		if oid, ok := v.(primitive.ObjectID); ok {
+			return primitive.NewDateTimeFromTime(oid.Timestamp()), nil
+		}
+		return nil, fmt.Errorf("$toDate can not convert %T", v)
+
+	case "$dateToString":
+
+		var format = "%Y-%m-%dT%H:%M:%S.%LZ"
+		var date interface{}
+
+		for _, v := range asDoc(args) {
+			switch v.Key {
+			case "format":
+				format, _ = v.Value.(string)
+			case "date":
+				var err error
+				date, err = evaluate(doc, v.Value)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		d, ok := date.(primitive.DateTime)
+		if !ok {
+			return nil, nil
+		}
+
+		return formatDate(d.Time().UTC(), format), nil
+
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrFakeUnsupported, operator)
+	}
+}
+
+// Operators take either a value or an array of one value
+func evaluateArg(doc bson.D, args interface{}) (interface{}, error) {
+
+	if a, ok := args.(primitive.A); ok {
+		if len(a) != 1 {
+			return nil, errors.New("expected one argument")
+		}
+		args = a[0]
+	}
+	return evaluate(doc, args)
+}
+
+func formatDate(t time.Time, format string) string {
+
+	replacer := strings.NewReplacer(
+		"%Y", fmt.Sprintf("%04d", t.Year()),
+		"%m", fmt.Sprintf("%02d", int(t.Month())),
+		"%d", fmt.Sprintf("%02d", t.Day()),
+		"%H", fmt.Sprintf("%02d", t.Hour()),
+		"%M", fmt.Sprintf("%02d", t.Minute()),
+		"%S", fmt.Sprintf("%02d", t.Second()),
+		"%L", fmt.Sprintf("%03d", t.Nanosecond()/int(time.Millisecond)),
+		"%%", "%",
+	)
+	return replacer.Replace(format)
+}
+
+// Keeps integers as integers, like the server does
+func arithmetic(operator string, values []interface{}) (interface{}, error) {
+
+	var floats bool
+	var ints []int64
+	var fs []float64
+
+	for _, v := range values {
+
+		switch n := v.(type) {
+		case nil:
+			if operator == "$add" {
+				continue
+			}
+			return nil, nil
+		case int32:
+			ints = append(ints, int64(n))
+			fs = append(fs, float64(n))
+		case int64:
+			ints = append(ints, n)
+			fs = append(fs, float64(n))
+		case float64:
+			floats = true
+			fs = append(fs, n)
+		default:
+			return nil, fmt.Errorf("%s can not use %T", operator, v)
+		}
+	}
+
+	if len(fs) == 0 {
+		return int32(0), nil
+	}
+
+	if operator == "$divide" {
+		floats = true
+	}
+
+	if floats {
+
+		var result = fs[0]
+		for _, f := range fs[1:] {
+			switch operator {
+			case "$add":
+				result += f
+			case "$subtract":
+				result -= f
+			case "$multiply":
+				result *= f
+			case "$divide":
+				if f == 0 {
+					return nil, errors.New("can not divide by zero")
+				}
+				result /= f
+			}
+		}
+		return result, nil
+	}
+
+	var result = ints[0]
+	for _, i := range ints[1:] {
+		switch operator {
+		case "$add":
+			result += i
+		case "$subtract":
+			result -= i
+		case "$multiply":
+			result *= i
+		}
+	}
+
+	if result >= math.MinInt32 && result <= math.MaxInt32 {
+		return int32(result), nil
+	}
+	return result, nil
+}
+
+// Comparisons
+
+func asDoc(v interface{}) bson.D {
+	d, _ := v.(bson.D)
+	return d
+}
+
+func isNumber(v interface{}) bool {
+	_, ok := toFloat(v)
+	return ok
+}
+
+func isBool(v interface{}) bool {
+	_, ok := v.(bool)
+	return ok
+}
+
+func truthy(v interface{}) bool {
+
+	switch val := v.(type) {
+	case bool:
+		return val
+	case nil:
+		return false
+	}
+
+	if f, ok := toFloat(v); ok {
+		return f != 0
+	}
+	return true
+}
+
+func toFloat(v interface{}) (float64, bool) {
+
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// The BSON sort order of types
+func typeRank(v interface{}) int {
+
+	switch v.(type) {
+	case nil, primitive.Null, primitive.Undefined:
+		return 1
+	case int, int32, int64, float32, float64:
+		return 2
+	case string, primitive.Symbol:
+		return 3
+	case bson.D:
+		return 4
+	case primitive.A:
+		return 5
+	case primitive.Binary:
+		return 6
+	case primitive.ObjectID:
+		return 7
+	case bool:
+		return 8
+	case primitive.DateTime:
+		return 9
+	case primitive.Timestamp:
+		return 10
+	case primitive.Regex:
+		return 11
+	default:
+		return 12
+	}
+}
+
+func equal(a, b interface{}) bool {
+	return typeRank(a) == typeRank(b) && compare(a, b) == 0
+}
+
+func compare(a, b interface{}) int {
+
+	ra, rb := typeRank(a), typeRank(b)
+	if ra != rb {
+		return sign(float64(ra - rb))
+	}
+
+	switch av := a.(type) {
+	case string:
+		return strings.Compare(av, b.(string))
+	case bool:
+		bv := b.(bool)
+		if av == bv {
+			return 0
+		}
+		if !av {
+			return -1
+		}
+		return 1
+	case primitive.DateTime:
+		return sign(float64(av - b.(primitive.DateTime)))
+	case primitive.ObjectID:
+		bv := b.(primitive.ObjectID)
+		return strings.Compare(av.Hex(), bv.Hex())
+	case bson.D:
+		bv := b.(bson.D)
+		for k := 0; k < len(av) && k < len(bv); k++ {
+			if c := strings.Compare(av[k].Key, bv[k].Key); c != 0 {
+				return c
+			}
+			if c := compare(av[k].Value, bv[k].Value); c != 0 {
+				return c
+			}
+		}
+		return sign(float64(len(av) - len(bv)))
+	case primitive.A:
+		bv := b.(primitive.A)
+		for k := 0; k < len(av) && k < len(bv); k++ {
+			if c := compare(av[k], bv[k]); c != 0 {
+				return c
+			}
+		}
+		return sign(float64(len(av) - len(bv)))
+	}
+
+	if fa, ok := toFloat(a); ok {
+		fb, _ := toFloat(b)
+		return sign(fa - fb)
+	}
+
+	if ra == 1 {
+		return 0
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return 0
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func sign(f float64) int {
+	switch {
+	case f < 0:
+		return -1
+	case f > 0:
+		return 1
+	default:
+		return 0
+	}
+}
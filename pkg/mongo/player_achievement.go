@@ -137,6 +137,12 @@ func GetPlayerAchievementsByPlayer(playerID int64, offset int64, sort bson.D) (a
 	return getPlayerAchievements(offset, 100, filter, sort, nil)
 }
 
+// Every achievement, oldest first
+func GetAllPlayerAchievementsByPlayer(playerID int64) (achievements []PlayerAchievement, err error) {
+
+	return getPlayerAchievements(0, 0, bson.D{{"player_id", playerID}}, bson.D{{"achievement_date", 1}}, nil)
+}
+
 func GetPlayerAchievementsByPlayerAndApp(playerID int64, appID int) (achievements []PlayerAchievement, err error) {
 
 	if playerID == 0 || appID == 0 {
@@ -123,3 +123,35 @@ func GetRecentApps(playerID int64, offset int64, limit int64, sort bson.D) (apps
 
 	return apps, cur.Err()
 }
+
+func GetRecentAppsByPlayers(playerIDs []int64, projection bson.M) (apps []PlayerRecentApp, err error) {
+
+	if len(playerIDs) < 1 {
+		return apps, nil
+	}
+
+	a := bson.A{}
+	for _, v := range playerIDs {
+		a = append(a, v)
+	}
+
+	cur, ctx, err := find(CollectionPlayerAppsRecent, 0, 0, bson.D{{"player_id", bson.M{"$in": a}}}, nil, projection, nil)
+	if err != nil {
+		return apps, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var app PlayerRecentApp
+		err := cur.Decode(&app)
+		if err != nil {
+			log.ErrS(err, app.getKey())
+		} else {
+			apps = append(apps, app)
+		}
+	}
+
+	return apps, cur.Err()
+}
@@ -163,6 +163,10 @@ func GetPlayerBadges(offset int64, filter bson.D, sort bson.D) (badges []PlayerB
 	return getPlayerBadges(offset, 100, filter, sort, nil)
 }
 
+func GetAllPlayerBadgesByPlayer(playerID int64) (badges []PlayerBadge, err error) {
+	return getPlayerBadges(0, 0, bson.D{{"player_id", playerID}}, bson.D{{"badge_completion_time", 1}}, nil)
+}
+
 // Get the first PlayerBadge for an app ID
 func GetAppBadge(appID int) (badge PlayerBadge, err error) {
 
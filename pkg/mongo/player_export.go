@@ -0,0 +1,189 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/satori/go.uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// How long finished exports can be downloaded for
+const playerExportTTL = 60 * 60 * 24 * 7
+
+// Mongo documents max out at 16MB
+const PlayerExportMaxSize = 15 * 1024 * 1024
+
+type PlayerExportStatus string
+
+const (
+	PlayerExportQueued     PlayerExportStatus = "queued"
+	PlayerExportProcessing PlayerExportStatus = "processing"
+	PlayerExportComplete   PlayerExportStatus = "complete"
+	PlayerExportFailed     PlayerExportStatus = "failed"
+)
+
+// A zip of a player's library, requested by a user
+type PlayerExport struct {
+	ID          string             `bson:"_id"`
+	UserID      int                `bson:"user_id"`
+	PlayerID    int64              `bson:"player_id"`
+	PlayerName  string             `bson:"player_name"`
+	ProdCC      steamapi.ProductCC `bson:"prod_cc"` // For wishlist prices
+	Status      PlayerExportStatus `bson:"status"`
+	Error       string             `bson:"error"`
+	Size        int                `bson:"size"`
+	Archive     []byte             `bson:"archive"`
+	CreatedAt   time.Time          `bson:"created_at"`
+	CompletedAt time.Time          `bson:"completed_at"`
+}
+
+func (export PlayerExport) BSON() bson.D {
+
+	return bson.D{
+		{"_id", export.ID},
+		{"user_id", export.UserID},
+		{"player_id", export.PlayerID},
+		{"player_name", export.PlayerName},
+		{"prod_cc", export.ProdCC},
+		{"status", export.Status},
+		{"error", export.Error},
+		{"size", export.Size},
+		{"archive", export.Archive},
+		{"created_at", export.CreatedAt},
+		{"completed_at", export.CompletedAt},
+	}
+}
+
+func (export PlayerExport) GetPath() string {
+	return "/settings/exports/" + export.ID + ".zip"
+}
+
+func (export PlayerExport) GetFilename() string {
+	return "player-" + export.CreatedAt.Format("2006-01-02") + "-" + export.ID[0:8] + ".zip"
+}
+
+// For the bytes template function
+func (export PlayerExport) GetSize() uint64 {
+	return uint64(export.Size)
+}
+
+func (export PlayerExport) IsFinished() bool {
+	return export.Status == PlayerExportComplete || export.Status == PlayerExportFailed
+}
+
+func ensurePlayerExportIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"user_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"created_at", 1}}, Options: options.Index().SetExpireAfterSeconds(playerExportTTL)},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionPlayerExports.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func NewPlayerExport(userID int, playerID int64, playerName string, cc steamapi.ProductCC) (export PlayerExport, err error) {
+
+	export = PlayerExport{
+		ID:         uuid.NewV4().String(),
+		UserID:     userID,
+		PlayerID:   playerID,
+		PlayerName: playerName,
+		ProdCC:     cc,
+		Status:     PlayerExportQueued,
+		CreatedAt:  time.Now(),
+	}
+
+	_, err = InsertOne(CollectionPlayerExports, export)
+	return export, err
+}
+
+// Includes the archive
+func GetPlayerExport(id string) (export PlayerExport, err error) {
+
+	err = FindOne(CollectionPlayerExports, bson.D{{"_id", id}}, nil, nil, &export)
+	return export, err
+}
+
+// Without the archives
+func GetPlayerExports(userID int) (exports []PlayerExport, err error) {
+
+	cur, ctx, err := find(CollectionPlayerExports, 0, 20, bson.D{{"user_id", userID}}, bson.D{{"created_at", -1}}, bson.M{"archive": 0}, nil)
+	if err != nil {
+		return exports, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var export PlayerExport
+		err := cur.Decode(&export)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			exports = append(exports, export)
+		}
+	}
+
+	return exports, cur.Err()
+}
+
+// If an export is still being made for a user
+func HasPendingPlayerExport(userID int) (pending bool, err error) {
+
+	var filter = bson.D{
+		{"user_id", userID},
+		{"status", bson.M{"$in": bson.A{PlayerExportQueued, PlayerExportProcessing}}},
+	}
+
+	var export PlayerExport
+	err = FindOne(CollectionPlayerExports, filter, nil, bson.M{"_id": 1}, &export)
+	if err == ErrNoDocuments {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func SetPlayerExportStatus(id string, status PlayerExportStatus, errorMessage string) (err error) {
+
+	var update = bson.D{
+		{"status", status},
+		{"error", errorMessage},
+	}
+
+	if status == PlayerExportFailed {
+		update = append(update, bson.E{Key: "completed_at", Value: time.Now()})
+	}
+
+	_, err = UpdateOne(CollectionPlayerExports, bson.D{{"_id", id}}, update)
+	return err
+}
+
+func SetPlayerExportArchive(id string, archive []byte) (err error) {
+
+	var update = bson.D{
+		{"status", PlayerExportComplete},
+		{"error", ""},
+		{"size", len(archive)},
+		{"archive", archive},
+		{"completed_at", time.Now()},
+	}
+
+	_, err = UpdateOne(CollectionPlayerExports, bson.D{{"_id", id}}, update)
+	return err
+}
@@ -0,0 +1,258 @@
+package mongo
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// More new items than this in one update is probably a profile going public, not activity
+const PlayerHistoryMaxItems = 20
+
+type PlayerHistoryType string
+
+const (
+	PlayerHistoryLevel         PlayerHistoryType = "level"
+	PlayerHistoryBadge         PlayerHistoryType = "badge"
+	PlayerHistoryGame          PlayerHistoryType = "game"
+	PlayerHistoryCompleted     PlayerHistoryType = "completed"
+	PlayerHistoryName          PlayerHistoryType = "name"
+	PlayerHistoryVACBan        PlayerHistoryType = "vac_ban"
+	PlayerHistoryGameBan       PlayerHistoryType = "game_ban"
+	PlayerHistoryFriendAdded   PlayerHistoryType = "friend_added"
+	PlayerHistoryFriendRemoved PlayerHistoryType = "friend_removed"
+)
+
+var PlayerHistoryTypes = []PlayerHistoryType{
+	PlayerHistoryLevel,
+	PlayerHistoryBadge,
+	PlayerHistoryGame,
+	PlayerHistoryCompleted,
+	PlayerHistoryName,
+	PlayerHistoryVACBan,
+	PlayerHistoryGameBan,
+	PlayerHistoryFriendAdded,
+	PlayerHistoryFriendRemoved,
+}
+
+func (t PlayerHistoryType) IsValid() bool {
+	for _, v := range PlayerHistoryTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// A discrete change to a player's profile
+type PlayerHistory struct {
+	PlayerID  int64             `bson:"player_id" json:"player_id"`
+	Type      PlayerHistoryType `bson:"type" json:"type"`
+	ItemID    int64             `bson:"item_id" json:"item_id"` // App, badge or friend
+	ItemName  string            `bson:"item_name" json:"item_name"`
+	ItemIcon  string            `bson:"item_icon" json:"item_icon"`
+	ItemPath  string            `bson:"item_path" json:"item_path"`
+	Before    string            `bson:"before" json:"before"`
+	After     string            `bson:"after" json:"after"`
+	CreatedAt time.Time         `bson:"created_at" json:"created_at"`
+}
+
+func (event PlayerHistory) BSON() bson.D {
+
+	return bson.D{
+		{"_id", event.getKey()},
+		{"player_id", event.PlayerID},
+		{"type", event.Type},
+		{"item_id", event.ItemID},
+		{"item_name", event.ItemName},
+		{"item_icon", event.ItemIcon},
+		{"item_path", event.ItemPath},
+		{"before", event.Before},
+		{"after", event.After},
+		{"created_at", event.CreatedAt},
+	}
+}
+
+func (event PlayerHistory) getKey() string {
+	return strconv.FormatInt(event.PlayerID, 10) + "-" + string(event.Type) + "-" + strconv.FormatInt(event.ItemID, 10) + "-" + strconv.FormatInt(event.CreatedAt.Unix(), 10)
+}
+
+func (event PlayerHistory) GetText() string {
+
+	switch event.Type {
+	case PlayerHistoryLevel:
+		return "Levelled up from " + event.Before + " to " + event.After
+	case PlayerHistoryBadge:
+		return "Earned the " + event.ItemName + " badge"
+	case PlayerHistoryGame:
+		return "Added " + event.ItemName + " to their library"
+	case PlayerHistoryCompleted:
+		return "Unlocked every achievement in " + event.ItemName
+	case PlayerHistoryName:
+		return "Changed name from " + event.Before + " to " + event.After
+	case PlayerHistoryVACBan:
+		return "Received a VAC ban (" + event.After + " total)"
+	case PlayerHistoryGameBan:
+		return "Received a game ban (" + event.After + " total)"
+	case PlayerHistoryFriendAdded:
+		return "Became friends with " + event.ItemName
+	case PlayerHistoryFriendRemoved:
+		return "Is no longer friends with " + event.ItemName
+	default:
+		return string(event.Type)
+	}
+}
+
+func (event PlayerHistory) GetIcon() string {
+
+	if event.ItemIcon != "" {
+		return event.ItemIcon
+	}
+
+	switch event.Type {
+	case PlayerHistoryLevel:
+		return "fas fa-level-up-alt"
+	case PlayerHistoryName:
+		return "fas fa-signature"
+	case PlayerHistoryVACBan, PlayerHistoryGameBan:
+		return "fas fa-ban"
+	default:
+		return ""
+	}
+}
+
+func (event PlayerHistory) GetCreatedNice() string {
+	return event.CreatedAt.Format(helpers.DateYearTime)
+}
+
+// Changes visible on the player row, other events are made in the sub queues
+func DiffPlayers(before Player, after Player) (events []PlayerHistory) {
+
+	var now = time.Now()
+
+	if after.Level > before.Level {
+		events = append(events, PlayerHistory{
+			PlayerID:  after.ID,
+			Type:      PlayerHistoryLevel,
+			ItemPath:  "/experience/" + strconv.Itoa(after.Level),
+			Before:    strconv.Itoa(before.Level),
+			After:     strconv.Itoa(after.Level),
+			CreatedAt: now,
+		})
+	}
+
+	if after.NumberOfVACBans > before.NumberOfVACBans {
+		events = append(events, PlayerHistory{
+			PlayerID:  after.ID,
+			Type:      PlayerHistoryVACBan,
+			Before:    strconv.Itoa(before.NumberOfVACBans),
+			After:     strconv.Itoa(after.NumberOfVACBans),
+			CreatedAt: now,
+		})
+	}
+
+	if after.NumberOfGameBans > before.NumberOfGameBans {
+		events = append(events, PlayerHistory{
+			PlayerID:  after.ID,
+			Type:      PlayerHistoryGameBan,
+			Before:    strconv.Itoa(before.NumberOfGameBans),
+			After:     strconv.Itoa(after.NumberOfGameBans),
+			CreatedAt: now,
+		})
+	}
+
+	return events
+}
+
+func ensurePlayerHistoryIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"player_id", 1}, {"type", 1}, {"created_at", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionPlayerHistory.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func ReplacePlayerHistory(events []PlayerHistory) (err error) {
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		return err
+	}
+
+	var writes []mongo.WriteModel
+	for _, v := range events {
+
+		write := mongo.NewReplaceOneModel()
+		write.SetFilter(bson.M{"_id": v.getKey()})
+		write.SetReplacement(v.BSON())
+		write.SetUpsert(true)
+
+		writes = append(writes, write)
+	}
+
+	c := client.Database(config.C.MongoDatabase).Collection(CollectionPlayerHistory.String())
+
+	_, err = c.BulkWrite(ctx, writes, options.BulkWrite())
+
+	return err
+}
+
+// Newest first
+func GetPlayerHistory(playerID int64, eventType PlayerHistoryType, offset int64, limit int64) (events []PlayerHistory, err error) {
+
+	var filter = bson.D{{"player_id", playerID}}
+	if eventType != "" {
+		filter = append(filter, bson.E{Key: "type", Value: eventType})
+	}
+
+	cur, ctx, err := find(CollectionPlayerHistory, offset, limit, filter, bson.D{{"created_at", -1}}, nil, nil)
+	if err != nil {
+		return events, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		event := PlayerHistory{}
+		err := cur.Decode(&event)
+		if err != nil {
+			log.ErrS(err, playerID)
+		} else {
+			events = append(events, event)
+		}
+	}
+
+	return events, cur.Err()
+}
+
+func CountPlayerHistory(playerID int64, eventType PlayerHistoryType) (count int64, err error) {
+
+	var filter = bson.D{{"player_id", playerID}}
+	if eventType != "" {
+		filter = append(filter, bson.E{Key: "type", Value: eventType})
+	}
+
+	return CountDocuments(CollectionPlayerHistory, filter, 0)
+}
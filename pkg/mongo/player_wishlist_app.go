@@ -127,6 +127,20 @@ func GetPlayerWishlistAppsByApp(appID int) (apps []PlayerWishlistApp, err error)
 	return getPlayerWishlistApps(0, 0, bson.D{{"app_id", appID}}, nil, bson.M{"order": 1})
 }
 
+func GetPlayerIDsWithWishlistApp(appID int) (playerIDs []int64, err error) {
+
+	apps, err := getPlayerWishlistApps(0, 0, bson.D{{"app_id", appID}}, nil, bson.M{"_id": 0, "player_id": 1})
+	if err != nil {
+		return playerIDs, err
+	}
+
+	for _, app := range apps {
+		playerIDs = append(playerIDs, app.PlayerID)
+	}
+
+	return playerIDs, nil
+}
+
 func GetPlayerWishlistAppsByPlayer(playerID int64, offset int64, limit int64, order bson.D, projection bson.M) (apps []PlayerWishlistApp, err error) {
 
 	return getPlayerWishlistApps(offset, limit, bson.D{{"player_id", playerID}}, order, projection)
@@ -0,0 +1,171 @@
+package mongo
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/log"
+	"github.com/gamedb/gamedb/pkg/mysql/pics"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	PICSHistoryFieldPackage = "package" // Packages have top level keys as well as extended ones
+	PICSHistoryFieldDepots  = "depots"  // Flattened to depot ID and branch keys
+	PICSHistoryFieldLaunch  = "launch"  // Flattened to launch option keys
+)
+
+// A key that changed between two PICS change numbers
+type ProductPICSHistory struct {
+	AppID        int                     `bson:"app_id"`
+	PackageID    int                     `bson:"package_id"`
+	Name         string                  `bson:"name"`
+	ChangeNumber int                     `bson:"change_number"`
+	CreatedAt    time.Time               `bson:"created_at"`
+	Field        string                  `bson:"field"` // common, extended, config, ufs, depots, launch or package
+	Key          string                  `bson:"key"`
+	Action       pics.KeyValueDiffAction `bson:"action"`
+	Before       string                  `bson:"before"` // Empty if added
+	After        string                  `bson:"after"`  // Empty if removed
+}
+
+func (history ProductPICSHistory) BSON() bson.D {
+
+	return bson.D{
+		{"_id", history.getKey()},
+		{"app_id", history.AppID},
+		{"package_id", history.PackageID},
+		{"name", history.Name},
+		{"change_number", history.ChangeNumber},
+		{"created_at", history.CreatedAt},
+		{"field", history.Field},
+		{"key", history.Key},
+		{"action", history.Action},
+		{"before", history.Before},
+		{"after", history.After},
+	}
+}
+
+func (history ProductPICSHistory) getKey() string {
+	return strconv.Itoa(history.AppID) + "-" + strconv.Itoa(history.PackageID) + "-" + strconv.Itoa(history.ChangeNumber) + "-" + history.Field + "-" + history.Key
+}
+
+func (history ProductPICSHistory) GetProductType() helpers.ProductType {
+
+	if history.PackageID > 0 {
+		return helpers.ProductTypePackage
+	}
+	return helpers.ProductTypeApp
+}
+
+func (history ProductPICSHistory) GetName() string {
+
+	if history.PackageID > 0 {
+		return helpers.GetPackageName(history.PackageID, history.Name)
+	}
+	return helpers.GetAppName(history.AppID, history.Name)
+}
+
+func (history ProductPICSHistory) GetPath() string {
+
+	if history.PackageID > 0 {
+		return helpers.GetPackagePath(history.PackageID, history.Name) + "#history"
+	}
+	return helpers.GetAppPath(history.AppID, history.Name) + "#dev-history"
+}
+
+func (history ProductPICSHistory) GetChangePath() string {
+	return "/changes/" + strconv.Itoa(history.ChangeNumber)
+}
+
+func (history ProductPICSHistory) GetFieldTitle() string {
+
+	if history.Field == "ufs" {
+		return strings.ToUpper(history.Field)
+	}
+	return strings.Title(history.Field)
+}
+
+func (history ProductPICSHistory) OutputForJSON() (output []interface{}) {
+
+	return []interface{}{
+		history.AppID,
+		history.PackageID,
+		history.GetName(),
+		history.GetPath(),
+		history.Field,
+		history.GetFieldTitle(),
+		history.Key,
+		history.Action,
+		history.Before,
+		history.After,
+		history.CreatedAt.Unix(),
+		history.CreatedAt.Format(helpers.DateYearTime),
+		history.ChangeNumber,
+		history.GetChangePath(),
+	}
+}
+
+func ensureProductPICSHistoryIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"app_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"package_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"change_number", 1}}},
+		{Keys: bson.D{{"field", 1}, {"key", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"key", 1}, {"created_at", -1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionProductPICSHistory.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func SaveProductPICSHistories(histories []ProductPICSHistory) (err error) {
+
+	if len(histories) == 0 {
+		return nil
+	}
+
+	var documents []Document
+	for _, v := range histories {
+		documents = append(documents, v)
+	}
+
+	_, err = InsertMany(CollectionProductPICSHistory, documents)
+	return err
+}
+
+func GetProductPICSHistories(offset int64, limit int64, filter bson.D) (histories []ProductPICSHistory, err error) {
+
+	cur, ctx, err := find(CollectionProductPICSHistory, offset, limit, filter, bson.D{{"created_at", -1}, {"field", 1}, {"key", 1}}, nil, nil)
+	if err != nil {
+		return histories, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var history ProductPICSHistory
+		err := cur.Decode(&history)
+		if err != nil {
+			log.ErrS(err, history.getKey())
+		} else {
+			histories = append(histories, history)
+		}
+	}
+
+	return histories, cur.Err()
+}
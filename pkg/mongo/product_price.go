@@ -131,6 +131,30 @@ func GetPricesForProduct(productID int, productType helpers.ProductType, cc stea
 	return getProductPrices(filter, 0, 0, bson.D{{"created_at", 1}})
 }
 
+// Ignores free prices, returns false if there is no history
+func GetLowestPrice(productID int, productType helpers.ProductType, cc steamapi.ProductCC) (price ProductPrice, found bool, err error) {
+
+	var filter = bson.D{
+		{"prod_cc", string(cc)},
+		{"price_after", bson.M{"$gt": 0}},
+	}
+
+	if productType == helpers.ProductTypeApp {
+		filter = append(filter, bson.E{Key: "app_id", Value: productID})
+	} else if productType == helpers.ProductTypePackage {
+		filter = append(filter, bson.E{Key: "package_id", Value: productID})
+	} else {
+		return price, false, errors.New("invalid product type")
+	}
+
+	err = FindOne(CollectionProductPrices, filter, bson.D{{"price_after", 1}}, nil, &price)
+	if err == ErrNoDocuments {
+		return price, false, nil
+	}
+
+	return price, err == nil, err
+}
+
 func GetPricesForApps(appIDs []int, cc steamapi.ProductCC) (prices []ProductPrice, err error) {
 
 	if len(appIDs) > 10 {
@@ -0,0 +1,362 @@
+package mongo
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/helpers"
+	"github.com/gamedb/gamedb/pkg/i18n"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const priceStatsRecentDays = 90
+
+// Price statistics for a product in a region, kept up to date as prices change
+type ProductPriceStats struct {
+	AppID            int                   `bson:"app_id"`
+	PackageID        int                   `bson:"package_id"`
+	ProdCC           steamapi.ProductCC    `bson:"prod_cc"`
+	Currency         steamapi.CurrencyCode `bson:"currency"`
+	UpdatedAt        time.Time             `bson:"updated_at"`
+	CurrentPrice     int                   `bson:"current_price"`
+	RegularPrice     int                   `bson:"regular_price"` // Highest price seen
+	AllTimeLow       int                   `bson:"all_time_low"`
+	AllTimeLowAt     time.Time             `bson:"all_time_low_at"`
+	RecentLow        int                   `bson:"recent_low"`    // Lowest in the last 90 days, as of the last change
+	RecentLowAt      time.Time             `bson:"recent_low_at"` // When the recent low was last changed to or from
+	DiscountCount    int                   `bson:"discount_count"`
+	DiscountTotal    float64               `bson:"discount_total"` // Sum of discount percents, for the average
+	DiscountDaysSum  float64               `bson:"discount_days_sum"`
+	LastDiscountAt   time.Time             `bson:"last_discount_at"`
+	LastChangeBefore int                   `bson:"last_change_before"`
+}
+
+func (stats ProductPriceStats) BSON() bson.D {
+
+	return bson.D{
+		{"_id", stats.getKey()},
+		{"app_id", stats.AppID},
+		{"package_id", stats.PackageID},
+		{"prod_cc", stats.ProdCC},
+		{"currency", stats.Currency},
+		{"updated_at", stats.UpdatedAt},
+		{"current_price", stats.CurrentPrice},
+		{"regular_price", stats.RegularPrice},
+		{"all_time_low", stats.AllTimeLow},
+		{"all_time_low_at", stats.AllTimeLowAt},
+		{"recent_low", stats.RecentLow},
+		{"recent_low_at", stats.RecentLowAt},
+		{"discount_count", stats.DiscountCount},
+		{"discount_total", stats.DiscountTotal},
+		{"discount_days_sum", stats.DiscountDaysSum},
+		{"last_discount_at", stats.LastDiscountAt},
+		{"last_change_before", stats.LastChangeBefore},
+	}
+}
+
+func (stats ProductPriceStats) getKey() string {
+	return priceStatsKey(stats.AppID, stats.PackageID, stats.ProdCC)
+}
+
+func priceStatsKey(appID int, packageID int, cc steamapi.ProductCC) string {
+
+	if appID > 0 {
+		return "app-" + strconv.Itoa(appID) + "-" + string(cc)
+	}
+	return "package-" + strconv.Itoa(packageID) + "-" + string(cc)
+}
+
+// Applies a price change, changes must be added in order
+func (stats *ProductPriceStats) add(price ProductPrice) {
+
+	if stats.Currency == "" {
+		stats.Currency = price.Currency
+	}
+
+	// The price before the first change we know about still counts
+	for _, v := range []int{price.PriceBefore, price.PriceAfter} {
+
+		if v > stats.RegularPrice {
+			stats.RegularPrice = v
+		}
+
+		if v > 0 && (stats.AllTimeLow == 0 || v < stats.AllTimeLow) {
+			stats.AllTimeLow = v
+			stats.AllTimeLowAt = price.CreatedAt
+		}
+	}
+
+	// A drop from the regular price starts a discount
+	if price.PriceAfter > 0 && price.PriceAfter < price.PriceBefore && price.PriceBefore >= stats.RegularPrice {
+
+		if !stats.LastDiscountAt.IsZero() {
+			stats.DiscountDaysSum += price.CreatedAt.Sub(stats.LastDiscountAt).Hours() / 24
+		}
+
+		stats.DiscountCount++
+		stats.DiscountTotal += float64(stats.RegularPrice-price.PriceAfter) / float64(stats.RegularPrice) * 100
+		stats.LastDiscountAt = price.CreatedAt
+	}
+
+	stats.CurrentPrice = price.PriceAfter
+	stats.LastChangeBefore = price.PriceBefore
+	stats.UpdatedAt = price.CreatedAt
+}
+
+// A price can only change away from the current price, so a change matching the last one is a repeat of it
+func (stats ProductPriceStats) applied(price ProductPrice) bool {
+
+	if price.CreatedAt.Before(stats.UpdatedAt) {
+		return true
+	}
+
+	return stats.CurrentPrice == price.PriceAfter && stats.LastChangeBefore == price.PriceBefore
+}
+
+func (stats ProductPriceStats) GetAverageDiscount() float64 {
+
+	if stats.DiscountCount == 0 {
+		return 0
+	}
+	return helpers.RoundFloatTo2DP(stats.DiscountTotal / float64(stats.DiscountCount))
+}
+
+// Average days between discounts starting
+func (stats ProductPriceStats) GetDiscountCadence() float64 {
+
+	if stats.DiscountCount < 2 {
+		return 0
+	}
+	return math.Round(stats.DiscountDaysSum / float64(stats.DiscountCount-1))
+}
+
+// The recent low is only refreshed when the price changes, so may have left the window since
+func (stats ProductPriceStats) GetRecentLow() int {
+
+	if stats.RecentLowAt.Before(time.Now().AddDate(0, 0, -priceStatsRecentDays)) {
+		return stats.CurrentPrice
+	}
+	return stats.RecentLow
+}
+
+// 0 at the regular price, 100 at or below the all time low
+func (stats ProductPriceStats) DealScore(current int) int {
+
+	if current <= 0 || stats.RegularPrice <= 0 || stats.AllTimeLow <= 0 {
+		return 0
+	}
+
+	if current <= stats.AllTimeLow {
+		return 100
+	}
+
+	if current >= stats.RegularPrice || stats.RegularPrice <= stats.AllTimeLow {
+		return 0
+	}
+
+	return int(math.Round(float64(stats.RegularPrice-current) / float64(stats.RegularPrice-stats.AllTimeLow) * 100))
+}
+
+func (stats ProductPriceStats) DealRating(current int) string {
+
+	var score = stats.DealScore(current)
+
+	switch {
+	case score >= 100:
+		return "Historical Low"
+	case score >= 80:
+		return "Great Deal"
+	case score >= 50:
+		return "Good Deal"
+	case score > 0:
+		return "Okay Deal"
+	default:
+		return "Full Price"
+	}
+}
+
+func (stats ProductPriceStats) Format(value int) string {
+	return i18n.FormatPrice(stats.Currency, value, true)
+}
+
+func ensureProductPriceStatsIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"app_id", 1}, {"prod_cc", 1}}},
+		{Keys: bson.D{{"package_id", 1}, {"prod_cc", 1}}},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionProductPriceStats.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func GetProductPriceStats(productType helpers.ProductType, productID int, cc steamapi.ProductCC) (stats ProductPriceStats, err error) {
+
+	var key string
+	switch productType {
+	case helpers.ProductTypeApp:
+		key = priceStatsKey(productID, 0, cc)
+	case helpers.ProductTypePackage:
+		key = priceStatsKey(0, productID, cc)
+	default:
+		return stats, errors.New("invalid product type")
+	}
+
+	err = FindOne(CollectionProductPriceStats, bson.D{{"_id", key}}, nil, nil, &stats)
+	return stats, err
+}
+
+// Every region's stats for a product
+func GetProductPriceStatsForProduct(productType helpers.ProductType, productID int) (stats []ProductPriceStats, err error) {
+
+	var filter bson.D
+	switch productType {
+	case helpers.ProductTypeApp:
+		filter = bson.D{{"app_id", productID}, {"package_id", 0}}
+	case helpers.ProductTypePackage:
+		filter = bson.D{{"package_id", productID}}
+	default:
+		return stats, errors.New("invalid product type")
+	}
+
+	cur, ctx, err := find(CollectionProductPriceStats, 0, 0, filter, nil, nil, nil)
+	if err != nil {
+		return stats, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var stat ProductPriceStats
+		err := cur.Decode(&stat)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			stats = append(stats, stat)
+		}
+	}
+
+	return stats, cur.Err()
+}
+
+func GetProductPriceStatsForApps(appIDs []int, cc steamapi.ProductCC) (stats map[int]ProductPriceStats, err error) {
+
+	stats = map[int]ProductPriceStats{}
+
+	if len(appIDs) == 0 {
+		return stats, nil
+	}
+
+	var ids = bson.A{}
+	for _, v := range appIDs {
+		ids = append(ids, priceStatsKey(v, 0, cc))
+	}
+
+	cur, ctx, err := find(CollectionProductPriceStats, 0, 0, bson.D{{"_id", bson.M{"$in": ids}}}, nil, nil, nil)
+	if err != nil {
+		return stats, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var stat ProductPriceStats
+		err := cur.Decode(&stat)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			stats[stat.AppID] = stat
+		}
+	}
+
+	return stats, cur.Err()
+}
+
+// Call after the price change has been saved
+func UpdateProductPriceStats(price ProductPrice) (err error) {
+
+	var productType = helpers.ProductTypeApp
+	var productID = price.AppID
+	if price.PackageID > 0 {
+		productType = helpers.ProductTypePackage
+		productID = price.PackageID
+	}
+
+	// Only replace the stats we read, so concurrent changes error instead of being lost
+	var filter = bson.D{{"_id", priceStatsKey(price.AppID, price.PackageID, price.ProdCC)}}
+
+	stats, err := GetProductPriceStats(productType, productID, price.ProdCC)
+	if err == ErrNoDocuments {
+
+		// First time, build from the full history, which includes this change
+		stats = ProductPriceStats{AppID: price.AppID, PackageID: price.PackageID, ProdCC: price.ProdCC}
+
+		history, err := GetPricesForProduct(productID, productType, price.ProdCC)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range history {
+			stats.add(v)
+		}
+
+		if len(history) == 0 {
+			stats.add(price)
+		}
+
+	} else if err != nil {
+		return err
+	} else {
+
+		// Already applied by a retried message, or older than the last change
+		if stats.applied(price) {
+			return nil
+		}
+
+		filter = append(filter, bson.E{Key: "updated_at", Value: stats.UpdatedAt})
+
+		stats.add(price)
+	}
+
+	// Recent low
+	recent, err := getProductPrices(bson.D{
+		{"prod_cc", string(price.ProdCC)},
+		{"app_id", price.AppID},
+		{"package_id", price.PackageID},
+		{"created_at", bson.M{"$gte": time.Now().AddDate(0, 0, -priceStatsRecentDays)}},
+	}, 0, 0, bson.D{{"created_at", 1}})
+	if err != nil {
+		return err
+	}
+
+	stats.RecentLow = price.PriceAfter
+	stats.RecentLowAt = price.CreatedAt
+
+	for _, change := range recent {
+		for _, v := range []int{change.PriceBefore, change.PriceAfter} {
+			if v > 0 && (stats.RecentLow == 0 || v < stats.RecentLow) {
+				stats.RecentLow = v
+				stats.RecentLowAt = change.CreatedAt
+			}
+		}
+	}
+
+	_, err = ReplaceOne(CollectionProductPriceStats, filter, stats)
+	return err
+}
@@ -0,0 +1,98 @@
+package mongo
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/config"
+	"github.com/gamedb/gamedb/pkg/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// How long outbound webhook deliveries are kept for
+const webhookDeliveryTTL = 60 * 60 * 24 * 30
+
+// An attempt at sending an outbound webhook to a user
+type WebhookDelivery struct {
+	DeliveryID string    `bson:"delivery_id"` // Shared by retries of the same event
+	CreatedAt  time.Time `bson:"created_at"`
+	UserID     int       `bson:"user_id"`
+	WebhookID  int       `bson:"webhook_id"`
+	Event      string    `bson:"event"`
+	URL        string    `bson:"url"`
+	Attempt    int       `bson:"attempt"`
+	StatusCode int       `bson:"status_code"`
+	Error      string    `bson:"error"`
+	Duration   int64     `bson:"duration"` // Milliseconds
+	Retrying   bool      `bson:"retrying"`
+}
+
+func (delivery WebhookDelivery) BSON() bson.D {
+
+	return bson.D{
+		{"delivery_id", delivery.DeliveryID},
+		{"created_at", delivery.CreatedAt},
+		{"user_id", delivery.UserID},
+		{"webhook_id", delivery.WebhookID},
+		{"event", delivery.Event},
+		{"url", delivery.URL},
+		{"attempt", delivery.Attempt},
+		{"status_code", delivery.StatusCode},
+		{"error", delivery.Error},
+		{"duration", delivery.Duration},
+		{"retrying", delivery.Retrying},
+	}
+}
+
+func (delivery WebhookDelivery) Success() bool {
+	return delivery.Error == ""
+}
+
+func ensureWebhookDeliveryIndexes() {
+
+	var indexModels = []mongo.IndexModel{
+		{Keys: bson.D{{"user_id", 1}, {"created_at", -1}}},
+		{Keys: bson.D{{"created_at", 1}}, Options: options.Index().SetExpireAfterSeconds(webhookDeliveryTTL)},
+	}
+
+	client, ctx, err := getMongo()
+	if err != nil {
+		log.ErrS(err)
+		return
+	}
+
+	_, err = client.Database(config.C.MongoDatabase).Collection(CollectionWebhookDeliveries.String()).Indexes().CreateMany(ctx, indexModels)
+	if err != nil {
+		log.ErrS(err)
+	}
+}
+
+func NewWebhookDelivery(delivery WebhookDelivery) (err error) {
+
+	_, err = InsertOne(CollectionWebhookDeliveries, delivery)
+	return err
+}
+
+func GetWebhookDeliveries(userID int, offset int64) (deliveries []WebhookDelivery, err error) {
+
+	cur, ctx, err := find(CollectionWebhookDeliveries, offset, 100, bson.D{{"user_id", userID}}, bson.D{{"created_at", -1}}, nil, nil)
+	if err != nil {
+		return deliveries, err
+	}
+
+	defer closeCursor(cur, ctx)
+
+	for cur.Next(ctx) {
+
+		var delivery WebhookDelivery
+		err := cur.Decode(&delivery)
+		if err != nil {
+			log.ErrS(err)
+		} else {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries, cur.Err()
+}
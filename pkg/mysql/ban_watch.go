@@ -0,0 +1,155 @@
+package mysql
+
+import (
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/helpers"
+)
+
+// Per user
+const BanWatchLimit = 1000
+
+type BanWatch struct {
+	ID            int        `gorm:"not null;column:id;primary_key"`
+	CreatedAt     time.Time  `gorm:"not null;column:created_at"`
+	UpdatedAt     time.Time  `gorm:"not null;column:updated_at"`
+	UserID        int        `gorm:"not null;column:user_id;unique_index:user_player"`
+	PlayerID      int64      `gorm:"not null;column:player_id;unique_index:user_player;index:player_id"`
+	NotifyEmail   bool       `gorm:"not null;column:notify_email"`
+	NotifyDiscord bool       `gorm:"not null;column:notify_discord"`
+	NotifiedAt    *time.Time `gorm:"column:notified_at;type:datetime"`
+}
+
+func (watch BanWatch) GetPath() string {
+	return helpers.GetPlayerPath(watch.PlayerID, "")
+}
+
+func (watch BanWatch) SetNotified() error {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"notified_at": time.Now(),
+	}
+
+	return db.Model(&watch).Updates(update).Error
+}
+
+// Skips players already on the list, returns how many were added
+func NewBanWatches(userID int, playerIDs []int64, notifyEmail bool, notifyDiscord bool) (added int, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return added, err
+	}
+
+	var existing []int64
+	db2 := db.Model(&BanWatch{}).Where("user_id = ?", userID).Pluck("player_id", &existing)
+	if db2.Error != nil {
+		return added, db2.Error
+	}
+
+	var have = map[int64]bool{}
+	for _, v := range existing {
+		have[v] = true
+	}
+
+	for _, playerID := range helpers.UniqueInt64(playerIDs) {
+
+		if have[playerID] {
+			continue
+		}
+
+		if len(have) >= BanWatchLimit {
+			break
+		}
+
+		watch := BanWatch{
+			UserID:        userID,
+			PlayerID:      playerID,
+			NotifyEmail:   notifyEmail,
+			NotifyDiscord: notifyDiscord,
+		}
+
+		db2 = db.Create(&watch)
+		if db2.Error != nil {
+			return added, db2.Error
+		}
+
+		have[playerID] = true
+		added++
+	}
+
+	return added, nil
+}
+
+func DeleteBanWatch(userID int, watchID int) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	db = db.Where("id = ?", watchID)
+	db = db.Where("user_id = ?", userID)
+	db = db.Delete(&BanWatch{})
+
+	return db.Error
+}
+
+func GetBanWatchesByUser(userID int, offset int) (watches []BanWatch, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return watches, err
+	}
+
+	db = db.Where("user_id = ?", userID)
+	db = db.Order("created_at DESC")
+	db = db.Limit(100)
+	db = db.Offset(offset)
+	db = db.Find(&watches)
+
+	return watches, db.Error
+}
+
+func CountBanWatchesByUser(userID int) (count int, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return count, err
+	}
+
+	db = db.Model(&BanWatch{}).Where("user_id = ?", userID).Count(&count)
+
+	return count, db.Error
+}
+
+func GetBanWatchesForPlayer(playerID int64) (watches []BanWatch, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return watches, err
+	}
+
+	db = db.Where("player_id = ?", playerID)
+	db = db.Find(&watches)
+
+	return watches, db.Error
+}
+
+// Every player on any watch list
+func GetBanWatchPlayerIDs() (playerIDs []int64, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return playerIDs, err
+	}
+
+	db = db.Model(&BanWatch{}).Pluck("DISTINCT player_id", &playerIDs)
+
+	return playerIDs, db.Error
+}
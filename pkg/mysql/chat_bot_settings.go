@@ -13,6 +13,7 @@ type ChatBotSetting struct {
 	DeletedAt   *time.Time         `gorm:""`
 	DiscordID   string             `gorm:"not null;column:discord_id;primary_key"`
 	ProductCode steamapi.ProductCC `gorm:"not null;column:product_cc;index:name"`
+	Language    string             `gorm:"not null;column:language"` // Blank to use the Discord locale
 }
 
 func GetChatBotSettings(discordID string) (settings ChatBotSetting, err error) {
@@ -65,3 +66,57 @@ func SetChatBotSettings(discordID string, callback func(s *ChatBotSetting)) (err
 
 	return memcache.Client().Delete(memcache.ItemChatBotSettings(discordID).Key)
 }
+
+type ChatBotGuildSetting struct {
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+	GuildID   string    `gorm:"not null;column:guild_id;primary_key"`
+	Language  string    `gorm:"not null;column:language"`
+}
+
+func GetChatBotGuildSettings(guildID string) (settings ChatBotGuildSetting, err error) {
+
+	item := memcache.ItemChatBotGuildSettings(guildID)
+	err = memcache.Client().GetSet(item.Key, item.Expiration, &settings, func() (interface{}, error) {
+
+		db, err := GetMySQLClient()
+		if err != nil {
+			return settings, err
+		}
+
+		db = db.Where("guild_id = ?", guildID).First(&settings)
+		if db.Error != nil && db.Error != ErrRecordNotFound {
+			return settings, db.Error
+		}
+
+		return settings, nil
+	})
+
+	return settings, err
+}
+
+func SetChatBotGuildSettings(guildID string, callback func(s *ChatBotGuildSetting)) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	var settings = ChatBotGuildSetting{
+		GuildID: guildID,
+	}
+
+	db = db.Where(settings).FirstOrInit(&settings)
+	if db.Error != nil {
+		return db.Error
+	}
+
+	callback(&settings)
+
+	db = db.Save(&settings)
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return memcache.Client().Delete(memcache.ItemChatBotGuildSettings(guildID).Key)
+}
@@ -0,0 +1,208 @@
+package mysql
+
+import (
+	"errors"
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/memcache"
+	mysql2 "github.com/go-sql-driver/mysql"
+)
+
+const MaxChatBotSubscriptionsPerGuild = 25
+
+type ChatBotFeed string
+
+const (
+	ChatBotFeedReleases ChatBotFeed = "releases" // A game gets released
+	ChatBotFeedPrices   ChatBotFeed = "prices"   // An app's price drops, in one region
+	ChatBotFeedPICS     ChatBotFeed = "pics"     // An app gets a new PICS change number
+	ChatBotFeedNews     ChatBotFeed = "news"     // An app posts a news article
+	ChatBotFeedTrending ChatBotFeed = "trending" // The trending games, daily
+)
+
+var ChatBotFeeds = []ChatBotFeed{
+	ChatBotFeedReleases,
+	ChatBotFeedPrices,
+	ChatBotFeedPICS,
+	ChatBotFeedNews,
+	ChatBotFeedTrending,
+}
+
+func (f ChatBotFeed) IsValid() bool {
+
+	for _, v := range ChatBotFeeds {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+
+// Feeds that are subscribed to per app
+func (f ChatBotFeed) NeedsApp() bool {
+	return f == ChatBotFeedPrices || f == ChatBotFeedPICS || f == ChatBotFeedNews
+}
+
+func (f ChatBotFeed) String() string {
+
+	switch f {
+	case ChatBotFeedReleases:
+		return "New Releases"
+	case ChatBotFeedPrices:
+		return "Price Drops"
+	case ChatBotFeedPICS:
+		return "PICS Updates"
+	case ChatBotFeedNews:
+		return "News Articles"
+	case ChatBotFeedTrending:
+		return "Trending Games"
+	default:
+		return "?"
+	}
+}
+
+var ErrChatBotSubscriptionExists = errors.New("chat bot subscription already exists")
+
+type ChatBotSubscription struct {
+	ID        int                `gorm:"not null;column:id;primary_key"`
+	CreatedAt time.Time          `gorm:"not null;column:created_at"`
+	UpdatedAt time.Time          `gorm:"not null;column:updated_at"`
+	GuildID   string             `gorm:"not null;column:guild_id;index:guild_id"`
+	ChannelID string             `gorm:"not null;column:channel_id;unique_index:subscription"`
+	Feed      ChatBotFeed        `gorm:"not null;column:feed;unique_index:subscription"`
+	AppID     int                `gorm:"not null;column:app_id;unique_index:subscription"`
+	AppName   string             `gorm:"not null;column:app_name"`
+	ProductCC steamapi.ProductCC `gorm:"not null;column:product_cc;unique_index:subscription"` // Only for price drops
+	CreatedBy string             `gorm:"not null;column:created_by"`                           // Discord user ID
+}
+
+func NewChatBotSubscription(subscription ChatBotSubscription) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	subscription.ID = 0
+
+	db = db.Create(&subscription)
+	if val, ok := db.Error.(*mysql2.MySQLError); ok && val.Number == 1062 {
+		return ErrChatBotSubscriptionExists
+	}
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return clearChatBotSubscriptionCaches()
+}
+
+func DeleteChatBotSubscription(guildID string, subscriptionID int) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	db = db.Where("id = ?", subscriptionID)
+	db = db.Where("guild_id = ?", guildID)
+	db = db.Delete(&ChatBotSubscription{})
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return clearChatBotSubscriptionCaches()
+}
+
+// For when the bot can no longer post in a channel
+func DeleteChatBotSubscriptionsByChannel(channelID string) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	db = db.Where("channel_id = ?", channelID)
+	db = db.Delete(&ChatBotSubscription{})
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return clearChatBotSubscriptionCaches()
+}
+
+func GetChatBotSubscription(subscriptionID int) (subscription ChatBotSubscription, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return subscription, err
+	}
+
+	db = db.Where("id = ?", subscriptionID).First(&subscription)
+	return subscription, db.Error
+}
+
+func GetChatBotSubscriptionsByGuild(guildID string) (subscriptions []ChatBotSubscription, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return subscriptions, err
+	}
+
+	db = db.Where("guild_id = ?", guildID)
+	db = db.Order("created_at ASC")
+	db = db.Limit(MaxChatBotSubscriptionsPerGuild)
+	db = db.Find(&subscriptions)
+
+	return subscriptions, db.Error
+}
+
+func CountChatBotSubscriptionsByGuild(guildID string) (count int, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return count, err
+	}
+
+	db = db.Model(&ChatBotSubscription{}).Where("guild_id = ?", guildID).Count(&count)
+
+	return count, db.Error
+}
+
+// Called for every event, so is cached
+func GetChatBotSubscriptionsForFeed(feed ChatBotFeed, appID int, cc steamapi.ProductCC) (subscriptions []ChatBotSubscription, err error) {
+
+	var all []ChatBotSubscription
+
+	item := memcache.ItemChatBotSubscriptions(string(feed))
+	err = memcache.Client().GetSet(item.Key, item.Expiration, &all, func() (interface{}, error) {
+
+		db, err := GetMySQLClient()
+		if err != nil {
+			return all, err
+		}
+
+		db = db.Where("feed = ?", feed)
+		db = db.Find(&all)
+
+		return all, db.Error
+	})
+
+	for _, v := range all {
+		if v.AppID == appID && (feed != ChatBotFeedPrices || v.ProductCC == cc) {
+			subscriptions = append(subscriptions, v)
+		}
+	}
+
+	return subscriptions, err
+}
+
+func clearChatBotSubscriptionCaches() error {
+
+	var items []string
+	for _, v := range ChatBotFeeds {
+		items = append(items, memcache.ItemChatBotSubscriptions(string(v)).Key)
+	}
+
+	return memcache.Client().Delete(items...)
+}
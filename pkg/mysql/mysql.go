@@ -41,12 +41,7 @@ func GetMySQLClient() (conn *gorm.DB, err error) {
 			if err != nil {
 				return err
 			}
-			conn = conn.Set("gorm:association_autoupdate", false)
-			conn = conn.Set("gorm:association_autocreate", false)
-			conn = conn.Set("gorm:association_save_reference", false)
-			conn = conn.Set("gorm:save_associations", false)
-			conn = conn.LogMode(false)
-			conn.SetLogger(mySQLLogger{})
+			conn = configure(conn)
 
 			// test ping
 			conn = conn.Exec("SELECT VERSION()")
@@ -70,6 +65,36 @@ func GetMySQLClient() (conn *gorm.DB, err error) {
 	return gormConnection, err
 }
 
+func configure(conn *gorm.DB) *gorm.DB {
+
+	conn = conn.Set("gorm:association_autoupdate", false)
+	conn = conn.Set("gorm:association_autocreate", false)
+	conn = conn.Set("gorm:association_save_reference", false)
+	conn = conn.Set("gorm:save_associations", false)
+	conn = conn.LogMode(false)
+	conn.SetLogger(mySQLLogger{})
+
+	return conn
+}
+
+// Replaces the connection in tests, nil will connect to the real server again.
+// Panics outside of tests, so the live connection can't be swapped.
+func SetTestClient(conn *gorm.DB) {
+
+	if !config.IsTest() {
+		panic("mysql.SetTestClient called outside of a test")
+	}
+
+	if conn != nil {
+		conn = configure(conn)
+	}
+
+	gormConnectionMutex.Lock()
+	defer gormConnectionMutex.Unlock()
+
+	gormConnection = conn
+}
+
 type mySQLLogger struct {
 }
 
@@ -1,5 +1,11 @@
 package pics
 
+import (
+	"strings"
+
+	"github.com/gamedb/gamedb/pkg/helpers"
+)
+
 type Depots struct {
 	Depots   []AppDepotItem
 	Branches []AppDepotBranches
@@ -21,6 +27,32 @@ type AppDepotItem struct {
 	LVCache                    bool              `json:"lvcache"`
 	AllowAddRemoveWhileRunning bool              `json:"allowaddremovewhilerunning"`
 }
+
+// Manifest IDs by branch. Newer PICS data has an object with a gid instead of the ID.
+func (depot AppDepotItem) GetManifestIDs() (ids map[string]string) {
+
+	ids = map[string]string{}
+
+	for branch, manifest := range depot.Manifests {
+
+		if strings.HasPrefix(manifest, "{") {
+
+			var m DepotManifestInfo
+			err := helpers.Unmarshal([]byte(manifest), &m)
+			if err != nil || m.GID == "" {
+				continue
+			}
+			manifest = m.GID
+		}
+
+		if manifest != "" {
+			ids[branch] = manifest
+		}
+	}
+
+	return ids
+}
+
 type AppDepotBranches struct {
 	Name             string `json:"name"`
 	Description      string `json:"description"`
@@ -45,3 +77,9 @@ type DepotManifest struct {
 	Local  string `json:"local"`
 	Public string `json:"public"`
 }
+
+type DepotManifestInfo struct {
+	GID      string `json:"gid"`
+	Size     string `json:"size"`
+	Download string `json:"download"`
+}
@@ -58,3 +58,44 @@ func (kv KeyValue) TDClass() string {
 		return ""
 	}
 }
+
+type KeyValueDiffAction string
+
+const (
+	KeyValueDiffAdded    KeyValueDiffAction = "added"
+	KeyValueDiffRemoved  KeyValueDiffAction = "removed"
+	KeyValueDiffModified KeyValueDiffAction = "modified"
+)
+
+type KeyValueDiff struct {
+	Key    string
+	Action KeyValueDiffAction
+	Before string
+	After  string
+}
+
+// Returns the keys that have been added, removed or modified, sorted by key
+func (kv PICSKeyValues) Diff(after PICSKeyValues) (diffs []KeyValueDiff) {
+
+	for k, v := range kv {
+
+		newVal, ok := after[k]
+		if !ok {
+			diffs = append(diffs, KeyValueDiff{Key: k, Action: KeyValueDiffRemoved, Before: v})
+		} else if newVal != v {
+			diffs = append(diffs, KeyValueDiff{Key: k, Action: KeyValueDiffModified, Before: v, After: newVal})
+		}
+	}
+
+	for k, v := range after {
+		if _, ok := kv[k]; !ok {
+			diffs = append(diffs, KeyValueDiff{Key: k, Action: KeyValueDiffAdded, After: v})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+
+	return diffs
+}
@@ -0,0 +1,158 @@
+package mysql
+
+import (
+	"time"
+
+	"github.com/Jleagle/steam-go/steamapi"
+	"github.com/gamedb/gamedb/pkg/helpers"
+)
+
+type PriceAlertRule string
+
+const (
+	PriceAlertRuleTarget PriceAlertRule = "target" // Price drops to or below the target
+	PriceAlertRuleLowest PriceAlertRule = "lowest" // Price drops to a new all-time low
+)
+
+func (r PriceAlertRule) IsValid() bool {
+	return r == PriceAlertRuleTarget || r == PriceAlertRuleLowest
+}
+
+func (r PriceAlertRule) String() string {
+
+	switch r {
+	case PriceAlertRuleTarget:
+		return "Target Price"
+	case PriceAlertRuleLowest:
+		return "All-Time Low"
+	default:
+		return "?"
+	}
+}
+
+type PriceAlert struct {
+	ID            int                 `gorm:"not null;column:id;primary_key"`
+	CreatedAt     time.Time           `gorm:"not null;column:created_at"`
+	UpdatedAt     time.Time           `gorm:"not null;column:updated_at"`
+	UserID        int                 `gorm:"not null;column:user_id;index:user_id"`
+	ProductType   helpers.ProductType `gorm:"not null;column:product_type;index:product"`
+	ProductID     int                 `gorm:"not null;column:product_id;index:product"`
+	ProductCC     steamapi.ProductCC  `gorm:"not null;column:prod_cc;index:product"`
+	Rule          PriceAlertRule      `gorm:"not null;column:rule"`
+	TargetPrice   int                 `gorm:"not null;column:target_price"`
+	NotifyEmail   bool                `gorm:"not null;column:notify_email"`
+	NotifyDiscord bool                `gorm:"not null;column:notify_discord"`
+	NotifiedAt    *time.Time          `gorm:"column:notified_at;type:datetime"`
+	NotifiedPrice int                 `gorm:"not null;column:notified_price"`
+}
+
+// Matches returns true if a price change from oldPrice to newPrice should trigger this alert
+func (alert PriceAlert) Matches(oldPrice, newPrice int, lowestPrice int, lowestExists bool) bool {
+
+	// Only alert on drops, free games are usually just removed from the store
+	if newPrice >= oldPrice || newPrice <= 0 {
+		return false
+	}
+
+	switch alert.Rule {
+	case PriceAlertRuleTarget:
+		return newPrice <= alert.TargetPrice && oldPrice > alert.TargetPrice
+	case PriceAlertRuleLowest:
+		return !lowestExists || newPrice < lowestPrice
+	default:
+		return false
+	}
+}
+
+func (alert PriceAlert) GetPath() string {
+
+	if alert.ProductType == helpers.ProductTypePackage {
+		return helpers.GetPackagePath(alert.ProductID, "") + "#prices"
+	}
+	return helpers.GetAppPath(alert.ProductID, "") + "#prices"
+}
+
+func (alert PriceAlert) SetNotified(price int) error {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	update := map[string]interface{}{
+		"notified_at":    time.Now(),
+		"notified_price": price,
+	}
+
+	return db.Model(&alert).Updates(update).Error
+}
+
+func NewPriceAlert(alert PriceAlert) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	alert.ID = 0
+
+	db = db.Create(&alert)
+	return db.Error
+}
+
+func DeletePriceAlert(userID int, alertID int) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	db = db.Where("id = ?", alertID)
+	db = db.Where("user_id = ?", userID)
+	db = db.Delete(&PriceAlert{})
+
+	return db.Error
+}
+
+func GetPriceAlertsByUser(userID int, offset int) (alerts []PriceAlert, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return alerts, err
+	}
+
+	db = db.Where("user_id = ?", userID)
+	db = db.Order("created_at DESC")
+	db = db.Limit(100)
+	db = db.Offset(offset)
+	db = db.Find(&alerts)
+
+	return alerts, db.Error
+}
+
+func CountPriceAlertsByUser(userID int) (count int, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return count, err
+	}
+
+	db = db.Model(&PriceAlert{}).Where("user_id = ?", userID).Count(&count)
+
+	return count, db.Error
+}
+
+func GetPriceAlertsForProduct(productType helpers.ProductType, productID int, cc steamapi.ProductCC) (alerts []PriceAlert, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return alerts, err
+	}
+
+	db = db.Where("product_type = ?", productType)
+	db = db.Where("product_id = ?", productID)
+	db = db.Where("prod_cc = ?", cc)
+	db = db.Find(&alerts)
+
+	return alerts, db.Error
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/Jleagle/steam-go/steamapi"
@@ -71,6 +72,10 @@ type User struct {
 	ProductCC      steamapi.ProductCC `gorm:"not null;column:country_code"`
 	APIKey         string             `gorm:"not null;column:api_key"`
 	DonatedPatreon int                `gorm:"not null;column:donated_patreon"`
+	ShowAlerts     bool               `gorm:"not null;column:show_alerts"`
+	DiscordHistory bool               `gorm:"not null;column:discord_history"`
+	WishlistAlerts bool               `gorm:"not null;column:wishlist_alerts"`
+	WishlistDigest bool               `gorm:"not null;column:wishlist_digest"`
 }
 
 func (user *User) SetAPIKey() {
@@ -304,3 +309,70 @@ func CountUsers() (count int, err error) {
 
 	return count, db.Error
 }
+
+// Users to email when an app on their wishlist drops in price in their region
+// User IDs keyed by Steam ID, for the players that want wishlist alerts in the region
+func GetWishlistAlertUserIDs(cc steamapi.ProductCC, playerIDs []int64) (userIDs map[int64]int, err error) {
+
+	userIDs = map[int64]int{}
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return userIDs, err
+	}
+
+	for _, chunk := range helpers.ChunkInt64s(playerIDs, 1_000) {
+
+		var ids []string
+		for _, v := range chunk {
+			ids = append(ids, strconv.FormatInt(v, 10))
+		}
+
+		var rows []struct {
+			UserID   int
+			PlayerID string
+		}
+
+		db2 := db.Table("user_providers").
+			Select("user_providers.user_id, user_providers.id as player_id").
+			Joins("JOIN users ON users.id = user_providers.user_id").
+			Where("user_providers.provider = ?", oauth.ProviderSteam).
+			Where("user_providers.id IN (?)", ids).
+			Where("user_providers.deleted_at IS NULL").
+			Where("users.wishlist_alerts = ?", true).
+			Where("users.country_code = ?", cc).
+			Scan(&rows)
+
+		if db2.Error != nil {
+			return userIDs, db2.Error
+		}
+
+		for _, row := range rows {
+
+			i, err := strconv.ParseInt(row.PlayerID, 10, 64)
+			if err != nil {
+				log.ErrS(err)
+				continue
+			}
+
+			userIDs[i] = row.UserID
+		}
+	}
+
+	return userIDs, nil
+}
+
+func GetUsersWithWishlistDigest() (users []User, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return users, err
+	}
+
+	db = db.Select([]string{"id"})
+	db = db.Where("wishlist_digest = ?", true)
+	db = db.Where("email_verified = ?", true)
+	db = db.Find(&users)
+
+	return users, db.Error
+}
@@ -0,0 +1,218 @@
+package mysql
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/gamedb/gamedb/pkg/memcache"
+)
+
+const MaxWebhooksPerUser = 10
+
+type WebhookEvent string
+
+const (
+	WebhookEventAppPrice      WebhookEvent = "app.price"      // An app's price changes in any region
+	WebhookEventAppNew        WebhookEvent = "app.new"        // A new app is found
+	WebhookEventAppChange     WebhookEvent = "app.change"     // An app gets a new PICS change number
+	WebhookEventPlayerUpdated WebhookEvent = "player.updated" // A player finishes updating
+	WebhookEventArticleNew    WebhookEvent = "article.new"    // A new news article is found
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookEventAppPrice,
+	WebhookEventAppNew,
+	WebhookEventAppChange,
+	WebhookEventPlayerUpdated,
+	WebhookEventArticleNew,
+}
+
+func (e WebhookEvent) IsValid() bool {
+
+	for _, v := range WebhookEvents {
+		if v == e {
+			return true
+		}
+	}
+	return false
+}
+
+func (e WebhookEvent) String() string {
+
+	switch e {
+	case WebhookEventAppPrice:
+		return "App Price Change"
+	case WebhookEventAppNew:
+		return "New App"
+	case WebhookEventAppChange:
+		return "App Change Number"
+	case WebhookEventPlayerUpdated:
+		return "Player Updated"
+	case WebhookEventArticleNew:
+		return "New News Article"
+	default:
+		return "?"
+	}
+}
+
+type UserWebhook struct {
+	ID        int       `gorm:"not null;column:id;primary_key"`
+	CreatedAt time.Time `gorm:"not null;column:created_at"`
+	UpdatedAt time.Time `gorm:"not null;column:updated_at"`
+	UserID    int       `gorm:"not null;column:user_id;index:user_id"`
+	URL       string    `gorm:"not null;column:url"`
+	Secret    string    `gorm:"not null;column:secret"`
+	Events    string    `gorm:"not null;column:events"`    // Comma separated WebhookEvents
+	AppID     int       `gorm:"not null;column:app_id"`    // Only send events for this app, 0 for all
+	PlayerID  int64     `gorm:"not null;column:player_id"` // Only send events for this player, 0 for all
+}
+
+// Whether an event about this app or player should be sent, a filter on one type excludes events about the other
+func (webhook UserWebhook) Matches(appID int, playerID int64) bool {
+
+	if webhook.AppID > 0 && webhook.AppID != appID {
+		return false
+	}
+	if webhook.PlayerID > 0 && webhook.PlayerID != playerID {
+		return false
+	}
+	return true
+}
+
+func (webhook UserWebhook) GetEvents() (events []WebhookEvent) {
+
+	for _, v := range strings.Split(webhook.Events, ",") {
+		if event := WebhookEvent(v); event.IsValid() {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (webhook *UserWebhook) SetEvents(events []WebhookEvent) {
+
+	var eventStrings []string
+	for _, v := range events {
+		if v.IsValid() {
+			eventStrings = append(eventStrings, string(v))
+		}
+	}
+
+	webhook.Events = strings.Join(eventStrings, ",")
+}
+
+func (webhook *UserWebhook) SetSecret() error {
+
+	b := make([]byte, 20)
+	_, err := rand.Read(b)
+	if err != nil {
+		return err
+	}
+
+	webhook.Secret = hex.EncodeToString(b)
+	return nil
+}
+
+func NewUserWebhook(webhook UserWebhook) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	webhook.ID = 0
+
+	db = db.Create(&webhook)
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return clearUserWebhookCaches()
+}
+
+func DeleteUserWebhook(userID int, webhookID int) (err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return err
+	}
+
+	db = db.Where("id = ?", webhookID)
+	db = db.Where("user_id = ?", userID)
+	db = db.Delete(&UserWebhook{})
+	if db.Error != nil {
+		return db.Error
+	}
+
+	return clearUserWebhookCaches()
+}
+
+func GetUserWebhook(webhookID int) (webhook UserWebhook, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return webhook, err
+	}
+
+	db = db.Where("id = ?", webhookID).First(&webhook)
+	return webhook, db.Error
+}
+
+func GetUserWebhooksByUser(userID int) (webhooks []UserWebhook, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return webhooks, err
+	}
+
+	db = db.Where("user_id = ?", userID)
+	db = db.Order("created_at DESC")
+	db = db.Limit(MaxWebhooksPerUser)
+	db = db.Find(&webhooks)
+
+	return webhooks, db.Error
+}
+
+func CountUserWebhooksByUser(userID int) (count int, err error) {
+
+	db, err := GetMySQLClient()
+	if err != nil {
+		return count, err
+	}
+
+	db = db.Model(&UserWebhook{}).Where("user_id = ?", userID).Count(&count)
+
+	return count, db.Error
+}
+
+// Called for every event, so is cached
+func GetUserWebhooksForEvent(event WebhookEvent) (webhooks []UserWebhook, err error) {
+
+	item := memcache.ItemUserWebhooks(string(event))
+	err = memcache.Client().GetSet(item.Key, item.Expiration, &webhooks, func() (interface{}, error) {
+
+		db, err := GetMySQLClient()
+		if err != nil {
+			return webhooks, err
+		}
+
+		db = db.Where("FIND_IN_SET(?, events) > 0", string(event))
+		db = db.Find(&webhooks)
+
+		return webhooks, db.Error
+	})
+
+	return webhooks, err
+}
+
+func clearUserWebhookCaches() error {
+
+	var items []string
+	for _, v := range WebhookEvents {
+		items = append(items, memcache.ItemUserWebhooks(string(v)).Key)
+	}
+
+	return memcache.Client().Delete(items...)
+}
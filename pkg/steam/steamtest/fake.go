@@ -0,0 +1,166 @@
+package steamtest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Query params left out of fixture names, so keys never end up in the repo
+var fakeIgnoredParams = map[string]bool{
+	"key":    true,
+	"format": true,
+}
+
+var fakeUnsafeChars = regexp.MustCompile(`[^a-zA-Z0-9._=,-]+`)
+
+// Serves recorded responses from fixture files instead of calling Steam.
+// It replaces the default transport, which the Steam clients and Colly scrapers use.
+type Fake struct {
+	Dir     string // Fixture directory
+	Record  bool   // Call Steam for missing fixtures and save the responses
+	missing []string
+	real    http.RoundTripper
+	mutex   sync.Mutex
+}
+
+func NewFake(dir string) *Fake {
+	return &Fake{Dir: dir}
+}
+
+// Installs the fake, returns a function that puts the real transport back.
+// The Steam clients need a key to make calls, tests without one should set config.C.SteamAPIKey.
+func (f *Fake) Install() (restore func()) {
+
+	f.real = http.DefaultTransport
+	http.DefaultTransport = f
+
+	return func() {
+		http.DefaultTransport = f.real
+	}
+}
+
+// URLs that were requested without a fixture
+func (f *Fake) Missing() []string {
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return append([]string(nil), f.missing...)
+}
+
+// Fixture files are host/path_query.json, or .html for scraped pages
+func (f *Fake) fixturePath(req *http.Request) string {
+
+	var query = req.URL.Query()
+	var keys []string
+	for k := range query {
+		if !fakeIgnoredParams[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var name = strings.Trim(req.URL.Path, "/")
+	if name == "" {
+		name = "index"
+	}
+
+	for _, k := range keys {
+		name += "_" + k + "=" + strings.Join(query[k], ",")
+	}
+
+	name = fakeUnsafeChars.ReplaceAllString(name, "_")
+
+	return filepath.Join(f.Dir, req.URL.Host, name)
+}
+
+func (f *Fake) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	var file = f.fixturePath(req)
+
+	b, err := ioutil.ReadFile(file + ".json")
+	if os.IsNotExist(err) {
+		b, err = ioutil.ReadFile(file + ".html")
+	}
+	if os.IsNotExist(err) {
+
+		if f.Record {
+			return f.record(req, file)
+		}
+
+		f.mutex.Lock()
+		f.missing = append(f.missing, req.URL.String())
+		f.mutex.Unlock()
+
+		return fakeResponse(req, http.StatusNotFound, nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return fakeResponse(req, http.StatusOK, b), nil
+}
+
+func (f *Fake) record(req *http.Request, file string) (*http.Response, error) {
+
+	resp, err := f.real.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+
+		if strings.Contains(resp.Header.Get("Content-Type"), "html") {
+			file += ".html"
+		} else {
+			file += ".json"
+		}
+
+		err = os.MkdirAll(filepath.Dir(file), 0755)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ioutil.WriteFile(file, b, 0644)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(b))
+	return resp, nil
+}
+
+func fakeResponse(req *http.Request, code int, body []byte) *http.Response {
+
+	var contentType = "application/json"
+	if len(body) > 0 && body[0] == '<' {
+		contentType = "text/html; charset=utf-8"
+	}
+
+	return &http.Response{
+		Status:        http.StatusText(code),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{contentType}},
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}